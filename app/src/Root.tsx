@@ -5,6 +5,7 @@ import { useAuthorizer } from '@authorizerdev/authorizer-react';
 const ResetPassword = lazy(() => import('./pages/rest-password'));
 const Login = lazy(() => import('./pages/login'));
 const Dashboard = lazy(() => import('./pages/dashboard'));
+const Device = lazy(() => import('./pages/device'));
 
 export default function Root() {
 	const { token, loading, config } = useAuthorizer();
@@ -12,7 +13,11 @@ export default function Root() {
 	useEffect(() => {
 		if (token) {
 			const url = new URL(config.redirectURL || '/app');
-			if (url.origin !== window.location.origin) {
+			// redirect to other origins and same origin routes other than app (e.g. /authorize)
+			if (
+				url.origin !== window.location.origin ||
+				!url.pathname.startsWith('/app')
+			) {
 				window.location.href = config.redirectURL || '/app';
 			}
 		}
@@ -30,6 +35,9 @@ export default function Root() {
 					<Route path="/app" exact>
 						<Dashboard />
 					</Route>
+					<Route path="/app/device">
+						<Device />
+					</Route>
 				</Switch>
 			</Suspense>
 		);
@@ -44,6 +52,9 @@ export default function Root() {
 				<Route path="/app/reset-password">
 					<ResetPassword />
 				</Route>
+				<Route path="/app/device">
+					<Login />
+				</Route>
 			</Switch>
 		</Suspense>
 	);
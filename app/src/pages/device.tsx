@@ -0,0 +1,103 @@
+import React from 'react';
+import { useLocation } from 'react-router-dom';
+import { useAuthorizer } from '@authorizerdev/authorizer-react';
+
+const verifyDeviceCodeMutation = `
+	mutation verifyDeviceCode($params: VerifyDeviceCodeInput!) {
+		verify_device_code(params: $params) {
+			message
+		}
+	}
+`;
+
+export default function Device() {
+	const { search } = useLocation();
+	const { token } = useAuthorizer();
+	const [userCode, setUserCode] = React.useState(
+		new URLSearchParams(search).get('user_code') || ''
+	);
+	const [loading, setLoading] = React.useState(false);
+	const [message, setMessage] = React.useState('');
+	const [error, setError] = React.useState('');
+
+	const verifyDeviceCode = async (deny: boolean) => {
+		setLoading(true);
+		setError('');
+		try {
+			const res = await fetch(`${window.location.origin}/graphql`, {
+				method: 'POST',
+				credentials: 'include',
+				headers: {
+					'Content-Type': 'application/json',
+					Authorization: `Bearer ${token?.access_token}`,
+				},
+				body: JSON.stringify({
+					query: verifyDeviceCodeMutation,
+					variables: { params: { user_code: userCode, deny } },
+				}),
+			});
+			const json = await res.json();
+			if (json.errors && json.errors.length) {
+				setError(json.errors[0].message);
+			} else {
+				setMessage(json.data.verify_device_code.message);
+			}
+		} catch (err) {
+			setError(`${err}`);
+		}
+		setLoading(false);
+	};
+
+	if (message) {
+		return (
+			<div>
+				<h3>{message}</h3>
+				<p>You can close this window and return to your device.</p>
+			</div>
+		);
+	}
+
+	return (
+		<div>
+			<h1 style={{ textAlign: 'center' }}>Connect a device</h1>
+			<p>Enter the code displayed on your device.</p>
+			<input
+				type="text"
+				value={userCode}
+				placeholder="XXXX-XXXX"
+				onChange={(e) => setUserCode(e.target.value)}
+				style={{
+					width: '100%',
+					padding: 10,
+					fontSize: 18,
+					letterSpacing: 2,
+					textTransform: 'uppercase',
+					boxSizing: 'border-box',
+				}}
+			/>
+			{error && <p style={{ color: '#EF4444' }}>{error}</p>}
+			<br />
+			<br />
+			{loading ? (
+				<h3>Processing....</h3>
+			) : (
+				<div style={{ display: 'flex', justifyContent: 'space-between' }}>
+					<button
+						type="button"
+						disabled={!userCode}
+						onClick={() => verifyDeviceCode(false)}
+					>
+						Approve
+					</button>
+					<button
+						type="button"
+						disabled={!userCode}
+						onClick={() => verifyDeviceCode(true)}
+					>
+						Deny
+					</button>
+				</div>
+			)}
+		</div>
+	);
+}
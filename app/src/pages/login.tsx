@@ -1,10 +1,137 @@
 import React, { Fragment } from 'react';
 import { Authorizer } from '@authorizerdev/authorizer-react';
 
+const metaQuery = `
+	query {
+		meta {
+			is_webauthn_enabled
+		}
+	}
+`;
+
+const webauthnLoginOptionsMutation = `
+	mutation webauthnLoginOptions($params: WebauthnLoginOptionsInput) {
+		webauthn_login_options(params: $params) {
+			options
+		}
+	}
+`;
+
+const webauthnLoginMutation = `
+	mutation webauthnLogin($params: WebauthnLoginInput!) {
+		webauthn_login(params: $params) {
+			message
+		}
+	}
+`;
+
+const graphql = async (query: string, variables?: Record<string, any>) => {
+	const res = await fetch(`${window.location.origin}/graphql`, {
+		method: 'POST',
+		credentials: 'include',
+		headers: {
+			'Content-Type': 'application/json',
+		},
+		body: JSON.stringify({ query, variables }),
+	});
+	const json = await res.json();
+	if (json.errors && json.errors.length) {
+		throw new Error(json.errors[0].message);
+	}
+	return json.data;
+};
+
+const base64URLToBuffer = (value: string): ArrayBuffer => {
+	const base64 = value.replace(/-/g, '+').replace(/_/g, '/');
+	const padded = base64.padEnd(base64.length + ((4 - (base64.length % 4)) % 4), '=');
+	return Uint8Array.from(atob(padded), (c) => c.charCodeAt(0)).buffer;
+};
+
+const bufferToBase64URL = (value: ArrayBuffer): string =>
+	btoa(String.fromCharCode(...Array.from(new Uint8Array(value))))
+		.replace(/\+/g, '-')
+		.replace(/\//g, '_')
+		.replace(/=+$/, '');
+
+function PasskeyLogin() {
+	const [enabled, setEnabled] = React.useState(false);
+	const [loading, setLoading] = React.useState(false);
+	const [error, setError] = React.useState('');
+
+	React.useEffect(() => {
+		if (!window.PublicKeyCredential) {
+			return;
+		}
+		graphql(metaQuery)
+			.then((data) => setEnabled(data.meta.is_webauthn_enabled))
+			.catch(() => setEnabled(false));
+	}, []);
+
+	const login = async () => {
+		setLoading(true);
+		setError('');
+		try {
+			const data = await graphql(webauthnLoginOptionsMutation);
+			const { options } = data.webauthn_login_options;
+			const credential = (await navigator.credentials.get({
+				publicKey: {
+					...options,
+					challenge: base64URLToBuffer(options.challenge),
+					allowCredentials: (options.allowCredentials || []).map(
+						(allowCredential: { type: 'public-key'; id: string }) => ({
+							...allowCredential,
+							id: base64URLToBuffer(allowCredential.id),
+						})
+					),
+				},
+			})) as PublicKeyCredential | null;
+			if (!credential) {
+				throw new Error('passkey login cancelled');
+			}
+			const response = credential.response as AuthenticatorAssertionResponse;
+			await graphql(webauthnLoginMutation, {
+				params: {
+					id: credential.id,
+					client_data_json: bufferToBase64URL(response.clientDataJSON),
+					authenticator_data: bufferToBase64URL(response.authenticatorData),
+					signature: bufferToBase64URL(response.signature),
+					user_handle: response.userHandle
+						? bufferToBase64URL(response.userHandle)
+						: null,
+				},
+			});
+			// session cookie is set, reload to let the provider pick it up
+			window.location.reload();
+		} catch (err) {
+			setError(`${err}`);
+		}
+		setLoading(false);
+	};
+
+	if (!enabled) {
+		return null;
+	}
+
+	return (
+		<div style={{ marginTop: 20 }}>
+			<button
+				type="button"
+				disabled={loading}
+				onClick={login}
+				style={{ width: '100%', padding: 10 }}
+			>
+				{loading ? 'Processing....' : 'Sign in with passkey'}
+			</button>
+			{error && <p style={{ color: '#EF4444' }}>{error}</p>}
+		</div>
+	);
+}
+
 export default function Login() {
 	return (
 		<Fragment>
 			<Authorizer />
+			<PasskeyLogin />
 		</Fragment>
 	);
 }
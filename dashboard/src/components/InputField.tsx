@@ -51,12 +51,18 @@ const InputField = ({
 		DEFAULT_ROLES: false,
 		PROTECTED_ROLES: false,
 		ALLOWED_ORIGINS: false,
+		CLIENT_REDIRECT_URIS: false,
+		ROLE_ACCESS_TOKEN_EXPIRY_TIMES: false,
+		ROLE_REFRESH_TOKEN_EXPIRY_TIMES: false,
 	});
 	const [inputData, setInputData] = React.useState<Record<string, string>>({
 		ROLES: '',
 		DEFAULT_ROLES: '',
 		PROTECTED_ROLES: '',
 		ALLOWED_ORIGINS: '',
+		CLIENT_REDIRECT_URIS: '',
+		ROLE_ACCESS_TOKEN_EXPIRY_TIMES: '',
+		ROLE_REFRESH_TOKEN_EXPIRY_TIMES: '',
 	});
 	const updateInputHandler = (
 		type: string,
@@ -26,6 +26,8 @@ import {
 	FiUser,
 	FiUsers,
 	FiChevronDown,
+	FiSend,
+	FiKey,
 } from 'react-icons/fi';
 import { IconType } from 'react-icons';
 import { ReactText } from 'react';
@@ -43,6 +45,8 @@ const LinkItems: Array<LinkItemProps> = [
 	{ name: 'Home', icon: FiHome, route: '/' },
 	{ name: 'Users', icon: FiUsers, route: '/users' },
 	{ name: 'Environment Variables', icon: FiSettings, route: '/environment' },
+	{ name: 'Webhooks', icon: FiSend, route: '/webhooks' },
+	{ name: 'OIDC Providers', icon: FiKey, route: '/oidc-providers' },
 ];
 
 interface SidebarProps extends BoxProps {
@@ -5,12 +5,33 @@ export const TextInputType = {
 	GOOGLE_CLIENT_ID: 'GOOGLE_CLIENT_ID',
 	GITHUB_CLIENT_ID: 'GITHUB_CLIENT_ID',
 	FACEBOOK_CLIENT_ID: 'FACEBOOK_CLIENT_ID',
+	APPLE_CLIENT_ID: 'APPLE_CLIENT_ID',
+	APPLE_TEAM_ID: 'APPLE_TEAM_ID',
+	APPLE_KEY_ID: 'APPLE_KEY_ID',
+	MICROSOFT_CLIENT_ID: 'MICROSOFT_CLIENT_ID',
+	MICROSOFT_TENANT_ID: 'MICROSOFT_TENANT_ID',
+	GITLAB_CLIENT_ID: 'GITLAB_CLIENT_ID',
+	LINKEDIN_CLIENT_ID: 'LINKEDIN_CLIENT_ID',
+	DISCORD_CLIENT_ID: 'DISCORD_CLIENT_ID',
+	TWITTER_CLIENT_ID: 'TWITTER_CLIENT_ID',
 	JWT_ROLE_CLAIM: 'JWT_ROLE_CLAIM',
+	ACCESS_TOKEN_EXPIRY_TIME: 'ACCESS_TOKEN_EXPIRY_TIME',
+	REFRESH_TOKEN_EXPIRY_TIME: 'REFRESH_TOKEN_EXPIRY_TIME',
+	VERIFICATION_TOKEN_EXPIRY_TIME: 'VERIFICATION_TOKEN_EXPIRY_TIME',
+	SESSION_INACTIVITY_TIMEOUT: 'SESSION_INACTIVITY_TIMEOUT',
+	ACCOUNT_LOCKOUT_THRESHOLD: 'ACCOUNT_LOCKOUT_THRESHOLD',
+	ACCOUNT_LOCKOUT_DURATION: 'ACCOUNT_LOCKOUT_DURATION',
+	PASSWORD_MIN_LENGTH: 'PASSWORD_MIN_LENGTH',
+	PASSWORD_HISTORY_SIZE: 'PASSWORD_HISTORY_SIZE',
+	BREACHED_PASSWORDS_FILE: 'BREACHED_PASSWORDS_FILE',
+	PASSWORD_HASH_ALGORITHM: 'PASSWORD_HASH_ALGORITHM',
+	AUDIT_LOG_RETENTION_DAYS: 'AUDIT_LOG_RETENTION_DAYS',
 	REDIS_URL: 'REDIS_URL',
 	SMTP_HOST: 'SMTP_HOST',
 	SMTP_PORT: 'SMTP_PORT',
 	SMTP_USERNAME: 'SMTP_USERNAME',
 	SENDER_EMAIL: 'SENDER_EMAIL',
+	SMS_WEBHOOK_URL: 'SMS_WEBHOOK_URL',
 	ORGANIZATION_NAME: 'ORGANIZATION_NAME',
 	ORGANIZATION_LOGO: 'ORGANIZATION_LOGO',
 	DATABASE_NAME: 'DATABASE_NAME',
@@ -22,8 +43,15 @@ export const HiddenInputType = {
 	GOOGLE_CLIENT_SECRET: 'GOOGLE_CLIENT_SECRET',
 	GITHUB_CLIENT_SECRET: 'GITHUB_CLIENT_SECRET',
 	FACEBOOK_CLIENT_SECRET: 'FACEBOOK_CLIENT_SECRET',
+	APPLE_PRIVATE_KEY: 'APPLE_PRIVATE_KEY',
+	MICROSOFT_CLIENT_SECRET: 'MICROSOFT_CLIENT_SECRET',
+	GITLAB_CLIENT_SECRET: 'GITLAB_CLIENT_SECRET',
+	LINKEDIN_CLIENT_SECRET: 'LINKEDIN_CLIENT_SECRET',
+	DISCORD_CLIENT_SECRET: 'DISCORD_CLIENT_SECRET',
+	TWITTER_CLIENT_SECRET: 'TWITTER_CLIENT_SECRET',
 	JWT_SECRET: 'JWT_SECRET',
 	SMTP_PASSWORD: 'SMTP_PASSWORD',
+	SMS_WEBHOOK_AUTHORIZATION: 'SMS_WEBHOOK_AUTHORIZATION',
 	ADMIN_SECRET: 'ADMIN_SECRET',
 	OLD_ADMIN_SECRET: 'OLD_ADMIN_SECRET',
 };
@@ -33,6 +61,11 @@ export const ArrayInputType = {
 	DEFAULT_ROLES: 'DEFAULT_ROLES',
 	PROTECTED_ROLES: 'PROTECTED_ROLES',
 	ALLOWED_ORIGINS: 'ALLOWED_ORIGINS',
+	CLIENT_REDIRECT_URIS: 'CLIENT_REDIRECT_URIS',
+	ROLE_ACCESS_TOKEN_EXPIRY_TIMES: 'ROLE_ACCESS_TOKEN_EXPIRY_TIMES',
+	ROLE_REFRESH_TOKEN_EXPIRY_TIMES: 'ROLE_REFRESH_TOKEN_EXPIRY_TIMES',
+	RATE_LIMITS: 'RATE_LIMITS',
+	PASSWORD_CHARACTER_CLASSES: 'PASSWORD_CHARACTER_CLASSES',
 };
 
 export const SelectInputType = {
@@ -48,6 +81,10 @@ export const SwitchInputType = {
 	DISABLE_MAGIC_LINK_LOGIN: 'DISABLE_MAGIC_LINK_LOGIN',
 	DISABLE_EMAIL_VERIFICATION: 'DISABLE_EMAIL_VERIFICATION',
 	DISABLE_BASIC_AUTHENTICATION: 'DISABLE_BASIC_AUTHENTICATION',
+	DISABLE_SECURITY_ALERT_EMAIL: 'DISABLE_SECURITY_ALERT_EMAIL',
+	DISABLE_WEBAUTHN: 'DISABLE_WEBAUTHN',
+	DISABLE_PASSWORD_EMAIL_CHECK: 'DISABLE_PASSWORD_EMAIL_CHECK',
+	DISABLE_PHONE_LOGIN: 'DISABLE_PHONE_LOGIN',
 };
 
 export const ArrayInputOperations = {
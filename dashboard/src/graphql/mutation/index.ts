@@ -31,3 +31,52 @@ export const UpdateEnvVariables = `
     }
   }
 `;
+
+export const AddWebhook = `
+  mutation addWebhook($params: AddWebhookInput!) {
+    _add_webhook(params: $params) {
+      id
+      secret
+    }
+  }
+`;
+
+export const UpdateWebhook = `
+  mutation updateWebhook($params: UpdateWebhookInput!) {
+    _update_webhook(params: $params) {
+      id
+    }
+  }
+`;
+
+export const DeleteWebhook = `
+  mutation deleteWebhook($params: WebhookInput!) {
+    _delete_webhook(params: $params) {
+      message
+    }
+  }
+`;
+
+export const AddOIDCProvider = `
+  mutation addOIDCProvider($params: AddOIDCProviderInput!) {
+    _add_oidc_provider(params: $params) {
+      id
+    }
+  }
+`;
+
+export const UpdateOIDCProvider = `
+  mutation updateOIDCProvider($params: UpdateOIDCProviderInput!) {
+    _update_oidc_provider(params: $params) {
+      id
+    }
+  }
+`;
+
+export const DeleteOIDCProvider = `
+  mutation deleteOIDCProvider($params: OIDCProviderInput!) {
+    _delete_oidc_provider(params: $params) {
+      message
+    }
+  }
+`;
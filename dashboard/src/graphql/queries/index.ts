@@ -15,19 +15,52 @@ export const EnvVariablesQuery = `
       GITHUB_CLIENT_SECRET,
       FACEBOOK_CLIENT_ID,
       FACEBOOK_CLIENT_SECRET,
+      APPLE_CLIENT_ID,
+      APPLE_TEAM_ID,
+      APPLE_KEY_ID,
+      APPLE_PRIVATE_KEY,
+      MICROSOFT_CLIENT_ID,
+      MICROSOFT_CLIENT_SECRET,
+      MICROSOFT_TENANT_ID,
+      GITLAB_CLIENT_ID,
+      GITLAB_CLIENT_SECRET,
+      LINKEDIN_CLIENT_ID,
+      LINKEDIN_CLIENT_SECRET,
+      DISCORD_CLIENT_ID,
+      DISCORD_CLIENT_SECRET,
+      TWITTER_CLIENT_ID,
+      TWITTER_CLIENT_SECRET,
       ROLES,
       DEFAULT_ROLES,
       PROTECTED_ROLES,
       JWT_TYPE,
       JWT_SECRET,
       JWT_ROLE_CLAIM,
+      ACCESS_TOKEN_EXPIRY_TIME,
+      REFRESH_TOKEN_EXPIRY_TIME,
+      VERIFICATION_TOKEN_EXPIRY_TIME,
+      SESSION_INACTIVITY_TIMEOUT,
+      ROLE_ACCESS_TOKEN_EXPIRY_TIMES,
+      ROLE_REFRESH_TOKEN_EXPIRY_TIMES,
+      RATE_LIMITS,
+      ACCOUNT_LOCKOUT_THRESHOLD,
+      ACCOUNT_LOCKOUT_DURATION,
+      PASSWORD_MIN_LENGTH,
+      PASSWORD_CHARACTER_CLASSES,
+      PASSWORD_HISTORY_SIZE,
+      BREACHED_PASSWORDS_FILE,
+      PASSWORD_HASH_ALGORITHM,
+      AUDIT_LOG_RETENTION_DAYS,
       REDIS_URL,
       SMTP_HOST,
       SMTP_PORT,
       SMTP_USERNAME,
       SMTP_PASSWORD,
       SENDER_EMAIL,
+      SMS_WEBHOOK_URL,
+      SMS_WEBHOOK_AUTHORIZATION,
       ALLOWED_ORIGINS,
+      CLIENT_REDIRECT_URIS,
       ORGANIZATION_NAME,
       ORGANIZATION_LOGO,
       ADMIN_SECRET,
@@ -35,6 +68,10 @@ export const EnvVariablesQuery = `
       DISABLE_MAGIC_LINK_LOGIN,
       DISABLE_EMAIL_VERIFICATION,
       DISABLE_BASIC_AUTHENTICATION,
+      DISABLE_SECURITY_ALERT_EMAIL,
+      DISABLE_WEBAUTHN,
+      DISABLE_PASSWORD_EMAIL_CHECK,
+      DISABLE_PHONE_LOGIN,
       CUSTOM_ACCESS_TOKEN_SCRIPT,
       DATABASE_NAME,
       DATABASE_TYPE,
@@ -42,3 +79,63 @@ export const EnvVariablesQuery = `
     }
   }
 `;
+
+export const WebhooksQuery = `
+  query {
+    _webhooks {
+      pagination {
+        total
+      }
+      webhooks {
+        id
+        event_name
+        endpoint
+        enabled
+        created_at
+      }
+    }
+  }
+`;
+
+export const WebhookDeliveriesQuery = `
+  query webhookDeliveries($params: WebhookDeliveriesInput) {
+    _webhook_deliveries(params: $params) {
+      pagination {
+        total
+      }
+      webhook_deliveries {
+        id
+        webhook_id
+        event_name
+        endpoint
+        status
+        attempts
+        response_status
+        error
+        next_attempt_at
+        created_at
+      }
+    }
+  }
+`;
+
+export const OIDCProvidersQuery = `
+  query {
+    _oidc_providers {
+      pagination {
+        total
+      }
+      oidc_providers {
+        id
+        name
+        display_name
+        issuer_url
+        client_id
+        scopes
+        trust_email
+        enabled
+        created_at
+      }
+    }
+  }
+`;
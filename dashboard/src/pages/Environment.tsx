@@ -17,6 +17,12 @@ import {
 	FaGoogle,
 	FaGithub,
 	FaFacebookF,
+	FaApple,
+	FaMicrosoft,
+	FaGitlab,
+	FaLinkedin,
+	FaDiscord,
+	FaTwitter,
 	FaSave,
 	FaRegEyeSlash,
 	FaRegEye,
@@ -42,25 +48,62 @@ interface envVarTypes {
 	GITHUB_CLIENT_SECRET: string;
 	FACEBOOK_CLIENT_ID: string;
 	FACEBOOK_CLIENT_SECRET: string;
+	APPLE_CLIENT_ID: string;
+	APPLE_TEAM_ID: string;
+	APPLE_KEY_ID: string;
+	APPLE_PRIVATE_KEY: string;
+	MICROSOFT_CLIENT_ID: string;
+	MICROSOFT_CLIENT_SECRET: string;
+	MICROSOFT_TENANT_ID: string;
+	GITLAB_CLIENT_ID: string;
+	GITLAB_CLIENT_SECRET: string;
+	LINKEDIN_CLIENT_ID: string;
+	LINKEDIN_CLIENT_SECRET: string;
+	DISCORD_CLIENT_ID: string;
+	DISCORD_CLIENT_SECRET: string;
+	TWITTER_CLIENT_ID: string;
+	TWITTER_CLIENT_SECRET: string;
 	ROLES: [string] | [];
 	DEFAULT_ROLES: [string] | [];
 	PROTECTED_ROLES: [string] | [];
 	JWT_TYPE: string;
 	JWT_SECRET: string;
 	JWT_ROLE_CLAIM: string;
+	ACCESS_TOKEN_EXPIRY_TIME: string;
+	REFRESH_TOKEN_EXPIRY_TIME: string;
+	VERIFICATION_TOKEN_EXPIRY_TIME: string;
+	SESSION_INACTIVITY_TIMEOUT: string;
+	ROLE_ACCESS_TOKEN_EXPIRY_TIMES: [string] | [];
+	ROLE_REFRESH_TOKEN_EXPIRY_TIMES: [string] | [];
+	RATE_LIMITS: [string] | [];
+	ACCOUNT_LOCKOUT_THRESHOLD: string;
+	ACCOUNT_LOCKOUT_DURATION: string;
+	PASSWORD_MIN_LENGTH: string;
+	PASSWORD_CHARACTER_CLASSES: [string] | [];
+	PASSWORD_HISTORY_SIZE: string;
+	BREACHED_PASSWORDS_FILE: string;
+	PASSWORD_HASH_ALGORITHM: string;
+	AUDIT_LOG_RETENTION_DAYS: string;
 	REDIS_URL: string;
 	SMTP_HOST: string;
 	SMTP_PORT: string;
 	SMTP_USERNAME: string;
 	SMTP_PASSWORD: string;
 	SENDER_EMAIL: string;
+	SMS_WEBHOOK_URL: string;
+	SMS_WEBHOOK_AUTHORIZATION: string;
 	ALLOWED_ORIGINS: [string] | [];
+	CLIENT_REDIRECT_URIS: [string] | [];
 	ORGANIZATION_NAME: string;
 	ORGANIZATION_LOGO: string;
 	CUSTOM_ACCESS_TOKEN_SCRIPT: string;
 	ADMIN_SECRET: string;
 	DISABLE_LOGIN_PAGE: boolean;
 	DISABLE_MAGIC_LINK_LOGIN: boolean;
+	DISABLE_SECURITY_ALERT_EMAIL: boolean;
+	DISABLE_WEBAUTHN: boolean;
+	DISABLE_PASSWORD_EMAIL_CHECK: boolean;
+	DISABLE_PHONE_LOGIN: boolean;
 	DISABLE_EMAIL_VERIFICATION: boolean;
 	DISABLE_BASIC_AUTHENTICATION: boolean;
 	OLD_ADMIN_SECRET: string;
@@ -86,25 +129,62 @@ export default function Environment() {
 		GITHUB_CLIENT_SECRET: '',
 		FACEBOOK_CLIENT_ID: '',
 		FACEBOOK_CLIENT_SECRET: '',
+		APPLE_CLIENT_ID: '',
+		APPLE_TEAM_ID: '',
+		APPLE_KEY_ID: '',
+		APPLE_PRIVATE_KEY: '',
+		MICROSOFT_CLIENT_ID: '',
+		MICROSOFT_CLIENT_SECRET: '',
+		MICROSOFT_TENANT_ID: '',
+		GITLAB_CLIENT_ID: '',
+		GITLAB_CLIENT_SECRET: '',
+		LINKEDIN_CLIENT_ID: '',
+		LINKEDIN_CLIENT_SECRET: '',
+		DISCORD_CLIENT_ID: '',
+		DISCORD_CLIENT_SECRET: '',
+		TWITTER_CLIENT_ID: '',
+		TWITTER_CLIENT_SECRET: '',
 		ROLES: [],
 		DEFAULT_ROLES: [],
 		PROTECTED_ROLES: [],
 		JWT_TYPE: '',
 		JWT_SECRET: '',
 		JWT_ROLE_CLAIM: '',
+		ACCESS_TOKEN_EXPIRY_TIME: '',
+		REFRESH_TOKEN_EXPIRY_TIME: '',
+		VERIFICATION_TOKEN_EXPIRY_TIME: '',
+		SESSION_INACTIVITY_TIMEOUT: '',
+		ROLE_ACCESS_TOKEN_EXPIRY_TIMES: [],
+		ROLE_REFRESH_TOKEN_EXPIRY_TIMES: [],
+		RATE_LIMITS: [],
+		ACCOUNT_LOCKOUT_THRESHOLD: '',
+		ACCOUNT_LOCKOUT_DURATION: '',
+		PASSWORD_MIN_LENGTH: '',
+		PASSWORD_CHARACTER_CLASSES: [],
+		PASSWORD_HISTORY_SIZE: '',
+		BREACHED_PASSWORDS_FILE: '',
+		PASSWORD_HASH_ALGORITHM: '',
+		AUDIT_LOG_RETENTION_DAYS: '',
 		REDIS_URL: '',
 		SMTP_HOST: '',
 		SMTP_PORT: '',
 		SMTP_USERNAME: '',
 		SMTP_PASSWORD: '',
 		SENDER_EMAIL: '',
+		SMS_WEBHOOK_URL: '',
+		SMS_WEBHOOK_AUTHORIZATION: '',
 		ALLOWED_ORIGINS: [],
+		CLIENT_REDIRECT_URIS: [],
 		ORGANIZATION_NAME: '',
 		ORGANIZATION_LOGO: '',
 		CUSTOM_ACCESS_TOKEN_SCRIPT: '',
 		ADMIN_SECRET: '',
 		DISABLE_LOGIN_PAGE: false,
 		DISABLE_MAGIC_LINK_LOGIN: false,
+		DISABLE_SECURITY_ALERT_EMAIL: false,
+		DISABLE_WEBAUTHN: false,
+		DISABLE_PASSWORD_EMAIL_CHECK: false,
+		DISABLE_PHONE_LOGIN: false,
 		DISABLE_EMAIL_VERIFICATION: false,
 		DISABLE_BASIC_AUTHENTICATION: false,
 		OLD_ADMIN_SECRET: '',
@@ -119,8 +199,15 @@ export default function Environment() {
 		GOOGLE_CLIENT_SECRET: false,
 		GITHUB_CLIENT_SECRET: false,
 		FACEBOOK_CLIENT_SECRET: false,
+		APPLE_PRIVATE_KEY: false,
+		MICROSOFT_CLIENT_SECRET: false,
+		GITLAB_CLIENT_SECRET: false,
+		LINKEDIN_CLIENT_SECRET: false,
+		DISCORD_CLIENT_SECRET: false,
+		TWITTER_CLIENT_SECRET: false,
 		JWT_SECRET: false,
 		SMTP_PASSWORD: false,
+		SMS_WEBHOOK_AUTHORIZATION: false,
 		ADMIN_SECRET: false,
 		OLD_ADMIN_SECRET: false,
 	});
@@ -322,6 +409,218 @@ export default function Environment() {
 						/>
 					</Center>
 				</Flex>
+				<Flex>
+					<Center
+						w="50px"
+						marginRight="1.5%"
+						border="1px solid #e2e8f0"
+						borderRadius="5px"
+					>
+						<FaApple style={{ color: '#8c8c8c' }} />
+					</Center>
+					<Center w="45%" marginRight="1.5%">
+						<InputField
+							envVariables={envVariables}
+							setEnvVariables={setEnvVariables}
+							inputType={TextInputType.APPLE_CLIENT_ID}
+							placeholder="Apple Services ID"
+						/>
+					</Center>
+					<Center w="45%">
+						<InputField
+							envVariables={envVariables}
+							setEnvVariables={setEnvVariables}
+							fieldVisibility={fieldVisibility}
+							setFieldVisibility={setFieldVisibility}
+							inputType={HiddenInputType.APPLE_PRIVATE_KEY}
+							placeholder="Apple Private Key"
+						/>
+					</Center>
+				</Flex>
+				<Flex>
+					<Center
+						w="50px"
+						marginRight="1.5%"
+						border="1px solid #e2e8f0"
+						borderRadius="5px"
+					>
+						<FaApple style={{ color: '#8c8c8c' }} />
+					</Center>
+					<Center w="45%" marginRight="1.5%">
+						<InputField
+							envVariables={envVariables}
+							setEnvVariables={setEnvVariables}
+							inputType={TextInputType.APPLE_TEAM_ID}
+							placeholder="Apple Team ID"
+						/>
+					</Center>
+					<Center w="45%">
+						<InputField
+							envVariables={envVariables}
+							setEnvVariables={setEnvVariables}
+							inputType={TextInputType.APPLE_KEY_ID}
+							placeholder="Apple Key ID"
+						/>
+					</Center>
+				</Flex>
+				<Flex>
+					<Center
+						w="50px"
+						marginRight="1.5%"
+						border="1px solid #e2e8f0"
+						borderRadius="5px"
+					>
+						<FaMicrosoft style={{ color: '#8c8c8c' }} />
+					</Center>
+					<Center w="45%" marginRight="1.5%">
+						<InputField
+							envVariables={envVariables}
+							setEnvVariables={setEnvVariables}
+							inputType={TextInputType.MICROSOFT_CLIENT_ID}
+							placeholder="Microsoft Client ID"
+						/>
+					</Center>
+					<Center w="45%">
+						<InputField
+							envVariables={envVariables}
+							setEnvVariables={setEnvVariables}
+							fieldVisibility={fieldVisibility}
+							setFieldVisibility={setFieldVisibility}
+							inputType={HiddenInputType.MICROSOFT_CLIENT_SECRET}
+							placeholder="Microsoft Secret"
+						/>
+					</Center>
+				</Flex>
+				<Flex>
+					<Center
+						w="50px"
+						marginRight="1.5%"
+						border="1px solid #e2e8f0"
+						borderRadius="5px"
+					>
+						<FaMicrosoft style={{ color: '#8c8c8c' }} />
+					</Center>
+					<Center w="45%" marginRight="1.5%">
+						<InputField
+							envVariables={envVariables}
+							setEnvVariables={setEnvVariables}
+							inputType={TextInputType.MICROSOFT_TENANT_ID}
+							placeholder="Microsoft Directory (Tenant) ID"
+						/>
+					</Center>
+				</Flex>
+				<Flex>
+					<Center
+						w="50px"
+						marginRight="1.5%"
+						border="1px solid #e2e8f0"
+						borderRadius="5px"
+					>
+						<FaGitlab style={{ color: '#8c8c8c' }} />
+					</Center>
+					<Center w="45%" marginRight="1.5%">
+						<InputField
+							envVariables={envVariables}
+							setEnvVariables={setEnvVariables}
+							inputType={TextInputType.GITLAB_CLIENT_ID}
+							placeholder="Gitlab Client ID"
+						/>
+					</Center>
+					<Center w="45%">
+						<InputField
+							envVariables={envVariables}
+							setEnvVariables={setEnvVariables}
+							fieldVisibility={fieldVisibility}
+							setFieldVisibility={setFieldVisibility}
+							inputType={HiddenInputType.GITLAB_CLIENT_SECRET}
+							placeholder="Gitlab Secret"
+						/>
+					</Center>
+				</Flex>
+				<Flex>
+					<Center
+						w="50px"
+						marginRight="1.5%"
+						border="1px solid #e2e8f0"
+						borderRadius="5px"
+					>
+						<FaLinkedin style={{ color: '#8c8c8c' }} />
+					</Center>
+					<Center w="45%" marginRight="1.5%">
+						<InputField
+							envVariables={envVariables}
+							setEnvVariables={setEnvVariables}
+							inputType={TextInputType.LINKEDIN_CLIENT_ID}
+							placeholder="LinkedIn Client ID"
+						/>
+					</Center>
+					<Center w="45%">
+						<InputField
+							envVariables={envVariables}
+							setEnvVariables={setEnvVariables}
+							fieldVisibility={fieldVisibility}
+							setFieldVisibility={setFieldVisibility}
+							inputType={HiddenInputType.LINKEDIN_CLIENT_SECRET}
+							placeholder="LinkedIn Secret"
+						/>
+					</Center>
+				</Flex>
+				<Flex>
+					<Center
+						w="50px"
+						marginRight="1.5%"
+						border="1px solid #e2e8f0"
+						borderRadius="5px"
+					>
+						<FaDiscord style={{ color: '#8c8c8c' }} />
+					</Center>
+					<Center w="45%" marginRight="1.5%">
+						<InputField
+							envVariables={envVariables}
+							setEnvVariables={setEnvVariables}
+							inputType={TextInputType.DISCORD_CLIENT_ID}
+							placeholder="Discord Client ID"
+						/>
+					</Center>
+					<Center w="45%">
+						<InputField
+							envVariables={envVariables}
+							setEnvVariables={setEnvVariables}
+							fieldVisibility={fieldVisibility}
+							setFieldVisibility={setFieldVisibility}
+							inputType={HiddenInputType.DISCORD_CLIENT_SECRET}
+							placeholder="Discord Secret"
+						/>
+					</Center>
+				</Flex>
+				<Flex>
+					<Center
+						w="50px"
+						marginRight="1.5%"
+						border="1px solid #e2e8f0"
+						borderRadius="5px"
+					>
+						<FaTwitter style={{ color: '#8c8c8c' }} />
+					</Center>
+					<Center w="45%" marginRight="1.5%">
+						<InputField
+							envVariables={envVariables}
+							setEnvVariables={setEnvVariables}
+							inputType={TextInputType.TWITTER_CLIENT_ID}
+							placeholder="Twitter Client ID"
+						/>
+					</Center>
+					<Center w="45%">
+						<InputField
+							envVariables={envVariables}
+							setEnvVariables={setEnvVariables}
+							fieldVisibility={fieldVisibility}
+							setFieldVisibility={setFieldVisibility}
+							inputType={HiddenInputType.TWITTER_CLIENT_SECRET}
+							placeholder="Twitter Secret"
+						/>
+					</Center>
+				</Flex>
 			</Stack>
 			<Divider marginTop="2%" marginBottom="2%" />
 			<Text fontSize="md" paddingTop="2%" fontWeight="bold">
@@ -421,6 +720,216 @@ export default function Environment() {
 				</Flex>
 			</Stack>
 			<Divider marginTop="2%" marginBottom="2%" />
+			<Text fontSize="md" paddingTop="2%" fontWeight="bold">
+				Token Lifetimes
+			</Text>
+			<Stack spacing={6} padding="2% 0%">
+				<Flex>
+					<Flex w="30%" justifyContent="start" alignItems="center">
+						<Text fontSize="sm">Access Token Lifetime:</Text>
+					</Flex>
+					<Center w="70%">
+						<InputField
+							envVariables={envVariables}
+							setEnvVariables={setEnvVariables}
+							inputType={TextInputType.ACCESS_TOKEN_EXPIRY_TIME}
+						/>
+					</Center>
+				</Flex>
+				<Flex>
+					<Flex w="30%" justifyContent="start" alignItems="center">
+						<Text fontSize="sm">Refresh Token Lifetime:</Text>
+					</Flex>
+					<Center w="70%">
+						<InputField
+							envVariables={envVariables}
+							setEnvVariables={setEnvVariables}
+							inputType={TextInputType.REFRESH_TOKEN_EXPIRY_TIME}
+						/>
+					</Center>
+				</Flex>
+				<Flex>
+					<Flex w="30%" justifyContent="start" alignItems="center">
+						<Text fontSize="sm">Verification Token Lifetime:</Text>
+					</Flex>
+					<Center w="70%">
+						<InputField
+							envVariables={envVariables}
+							setEnvVariables={setEnvVariables}
+							inputType={TextInputType.VERIFICATION_TOKEN_EXPIRY_TIME}
+						/>
+					</Center>
+				</Flex>
+				<Flex>
+					<Flex w="30%" justifyContent="start" alignItems="center">
+						<Text fontSize="sm">Session Inactivity Timeout:</Text>
+					</Flex>
+					<Center w="70%">
+						<InputField
+							envVariables={envVariables}
+							setEnvVariables={setEnvVariables}
+							inputType={TextInputType.SESSION_INACTIVITY_TIMEOUT}
+						/>
+					</Center>
+				</Flex>
+				<Flex>
+					<Flex w="30%" justifyContent="start" alignItems="center">
+						<Text fontSize="sm">Role Access Token Lifetimes:</Text>
+					</Flex>
+					<Center w="70%">
+						<InputField
+							envVariables={envVariables}
+							setEnvVariables={setEnvVariables}
+							inputType={ArrayInputType.ROLE_ACCESS_TOKEN_EXPIRY_TIMES}
+						/>
+					</Center>
+				</Flex>
+				<Flex>
+					<Flex w="30%" justifyContent="start" alignItems="center">
+						<Text fontSize="sm">Role Refresh Token Lifetimes:</Text>
+					</Flex>
+					<Center w="70%">
+						<InputField
+							envVariables={envVariables}
+							setEnvVariables={setEnvVariables}
+							inputType={ArrayInputType.ROLE_REFRESH_TOKEN_EXPIRY_TIMES}
+						/>
+					</Center>
+				</Flex>
+			</Stack>
+			<Divider marginTop="2%" marginBottom="2%" />
+			<Text fontSize="md" paddingTop="2%" fontWeight="bold">
+				Brute-force Protection
+			</Text>
+			<Stack spacing={6} padding="2% 0%">
+				<Flex>
+					<Flex w="30%" justifyContent="start" alignItems="center">
+						<Text fontSize="sm">Rate Limits:</Text>
+					</Flex>
+					<Center w="70%">
+						<InputField
+							envVariables={envVariables}
+							setEnvVariables={setEnvVariables}
+							inputType={ArrayInputType.RATE_LIMITS}
+						/>
+					</Center>
+				</Flex>
+				<Flex>
+					<Flex w="30%" justifyContent="start" alignItems="center">
+						<Text fontSize="sm">Account Lockout Threshold:</Text>
+					</Flex>
+					<Center w="70%">
+						<InputField
+							envVariables={envVariables}
+							setEnvVariables={setEnvVariables}
+							inputType={TextInputType.ACCOUNT_LOCKOUT_THRESHOLD}
+						/>
+					</Center>
+				</Flex>
+				<Flex>
+					<Flex w="30%" justifyContent="start" alignItems="center">
+						<Text fontSize="sm">Account Lockout Duration:</Text>
+					</Flex>
+					<Center w="70%">
+						<InputField
+							envVariables={envVariables}
+							setEnvVariables={setEnvVariables}
+							inputType={TextInputType.ACCOUNT_LOCKOUT_DURATION}
+						/>
+					</Center>
+				</Flex>
+			</Stack>
+			<Divider marginTop="2%" marginBottom="2%" />
+			<Text fontSize="md" paddingTop="2%" fontWeight="bold">
+				Password Policy
+			</Text>
+			<Stack spacing={6} padding="2% 0%">
+				<Flex>
+					<Flex w="30%" justifyContent="start" alignItems="center">
+						<Text fontSize="sm">Minimum Length:</Text>
+					</Flex>
+					<Center w="70%">
+						<InputField
+							envVariables={envVariables}
+							setEnvVariables={setEnvVariables}
+							inputType={TextInputType.PASSWORD_MIN_LENGTH}
+						/>
+					</Center>
+				</Flex>
+				<Flex>
+					<Flex w="30%" justifyContent="start" alignItems="center">
+						<Text fontSize="sm">Required Character Classes:</Text>
+					</Flex>
+					<Center w="70%">
+						<InputField
+							envVariables={envVariables}
+							setEnvVariables={setEnvVariables}
+							inputType={ArrayInputType.PASSWORD_CHARACTER_CLASSES}
+						/>
+					</Center>
+				</Flex>
+				<Flex>
+					<Flex w="30%" justifyContent="start" alignItems="center">
+						<Text fontSize="sm">Password History Size:</Text>
+					</Flex>
+					<Center w="70%">
+						<InputField
+							envVariables={envVariables}
+							setEnvVariables={setEnvVariables}
+							inputType={TextInputType.PASSWORD_HISTORY_SIZE}
+						/>
+					</Center>
+				</Flex>
+				<Flex>
+					<Flex w="30%" justifyContent="start" alignItems="center">
+						<Text fontSize="sm">Breached Passwords File:</Text>
+					</Flex>
+					<Center w="70%">
+						<InputField
+							envVariables={envVariables}
+							setEnvVariables={setEnvVariables}
+							inputType={TextInputType.BREACHED_PASSWORDS_FILE}
+						/>
+					</Center>
+				</Flex>
+				<Flex>
+					<Flex w="30%" justifyContent="start" alignItems="center">
+						<Text fontSize="sm">Hash Algorithm:</Text>
+					</Flex>
+					<Center w="70%">
+						<InputField
+							envVariables={envVariables}
+							setEnvVariables={setEnvVariables}
+							inputType={TextInputType.PASSWORD_HASH_ALGORITHM}
+						/>
+					</Center>
+				</Flex>
+				<Flex>
+					<Flex w="30%" justifyContent="start" alignItems="center">
+						<Text fontSize="sm">Allow Email In Password:</Text>
+					</Flex>
+					<Flex justifyContent="start" w="70%">
+						<InputField
+							envVariables={envVariables}
+							setEnvVariables={setEnvVariables}
+							inputType={SwitchInputType.DISABLE_PASSWORD_EMAIL_CHECK}
+						/>
+					</Flex>
+				</Flex>
+				<Flex>
+					<Flex w="30%" justifyContent="start" alignItems="center">
+						<Text fontSize="sm">Audit Log Retention Days:</Text>
+					</Flex>
+					<Center w="70%">
+						<InputField
+							envVariables={envVariables}
+							setEnvVariables={setEnvVariables}
+							inputType={TextInputType.AUDIT_LOG_RETENTION_DAYS}
+						/>
+					</Center>
+				</Flex>
+			</Stack>
+			<Divider marginTop="2%" marginBottom="2%" />
 			<Text fontSize="md" paddingTop="2%" fontWeight="bold">
 				Session Storage
 			</Text>
@@ -507,6 +1016,38 @@ export default function Environment() {
 				</Flex>
 			</Stack>
 			<Divider marginTop="2%" marginBottom="2%" />
+			<Text fontSize="md" paddingTop="2%" fontWeight="bold">
+				SMS Configurations
+			</Text>
+			<Stack spacing={6} padding="2% 0%">
+				<Flex>
+					<Flex w="30%" justifyContent="start" alignItems="center">
+						<Text fontSize="sm">Webhook URL:</Text>
+					</Flex>
+					<Center w="70%">
+						<InputField
+							envVariables={envVariables}
+							setEnvVariables={setEnvVariables}
+							inputType={TextInputType.SMS_WEBHOOK_URL}
+						/>
+					</Center>
+				</Flex>
+				<Flex>
+					<Flex w="30%" justifyContent="start" alignItems="center">
+						<Text fontSize="sm">Authorization Header:</Text>
+					</Flex>
+					<Center w="70%">
+						<InputField
+							envVariables={envVariables}
+							setEnvVariables={setEnvVariables}
+							fieldVisibility={fieldVisibility}
+							setFieldVisibility={setFieldVisibility}
+							inputType={HiddenInputType.SMS_WEBHOOK_AUTHORIZATION}
+						/>
+					</Center>
+				</Flex>
+			</Stack>
+			<Divider marginTop="2%" marginBottom="2%" />
 			<Text fontSize="md" paddingTop="2%" fontWeight="bold">
 				White Listing
 			</Text>
@@ -523,6 +1064,18 @@ export default function Environment() {
 						/>
 					</Center>
 				</Flex>
+				<Flex>
+					<Flex w="30%" justifyContent="start" alignItems="center">
+						<Text fontSize="sm">Client Redirect URIs:</Text>
+					</Flex>
+					<Center w="70%">
+						<InputField
+							envVariables={envVariables}
+							setEnvVariables={setEnvVariables}
+							inputType={ArrayInputType.CLIENT_REDIRECT_URIS}
+						/>
+					</Center>
+				</Flex>
 			</Stack>
 			<Divider marginTop="2%" marginBottom="2%" />
 			<Text fontSize="md" paddingTop="2%" fontWeight="bold">
@@ -624,6 +1177,42 @@ export default function Environment() {
 						/>
 					</Flex>
 				</Flex>
+				<Flex>
+					<Flex w="30%" justifyContent="start" alignItems="center">
+						<Text fontSize="sm">Disable Security Alert Email:</Text>
+					</Flex>
+					<Flex justifyContent="start" w="70%">
+						<InputField
+							envVariables={envVariables}
+							setEnvVariables={setEnvVariables}
+							inputType={SwitchInputType.DISABLE_SECURITY_ALERT_EMAIL}
+						/>
+					</Flex>
+				</Flex>
+				<Flex>
+					<Flex w="30%" justifyContent="start" alignItems="center">
+						<Text fontSize="sm">Disable Passkeys (WebAuthn):</Text>
+					</Flex>
+					<Flex justifyContent="start" w="70%">
+						<InputField
+							envVariables={envVariables}
+							setEnvVariables={setEnvVariables}
+							inputType={SwitchInputType.DISABLE_WEBAUTHN}
+						/>
+					</Flex>
+				</Flex>
+				<Flex>
+					<Flex w="30%" justifyContent="start" alignItems="center">
+						<Text fontSize="sm">Disable Phone Login:</Text>
+					</Flex>
+					<Flex justifyContent="start" w="70%">
+						<InputField
+							envVariables={envVariables}
+							setEnvVariables={setEnvVariables}
+							inputType={SwitchInputType.DISABLE_PHONE_LOGIN}
+						/>
+					</Flex>
+				</Flex>
 			</Stack>
 			<Divider marginTop="2%" marginBottom="2%" />
 			<Text fontSize="md" paddingTop="2%" fontWeight="bold">
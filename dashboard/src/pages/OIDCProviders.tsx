@@ -0,0 +1,243 @@
+import React, { useEffect, useState } from 'react';
+import {
+	Box,
+	Button,
+	IconButton,
+	Input,
+	Stack,
+	Switch,
+	Table,
+	Tbody,
+	Td,
+	Text,
+	Th,
+	Thead,
+	Tr,
+	useToast,
+} from '@chakra-ui/react';
+import { useClient } from 'urql';
+import { FaPlus, FaTrash } from 'react-icons/fa';
+import { OIDCProvidersQuery } from '../graphql/queries';
+import {
+	AddOIDCProvider,
+	DeleteOIDCProvider,
+	UpdateOIDCProvider,
+} from '../graphql/mutation';
+import { capitalizeFirstLetter } from '../utils';
+
+interface oidcProviderType {
+	id: string;
+	name: string;
+	display_name: string;
+	issuer_url: string;
+	client_id: string;
+	scopes: string[];
+	trust_email: boolean;
+	enabled: boolean;
+	created_at: number;
+}
+
+const initialFormData = {
+	name: '',
+	display_name: '',
+	issuer_url: '',
+	client_id: '',
+	client_secret: '',
+};
+
+export default function OIDCProviders() {
+	const client = useClient();
+	const toast = useToast();
+	const [loading, setLoading] = useState<boolean>(true);
+	const [oidcProviders, setOIDCProviders] = useState<oidcProviderType[]>([]);
+	const [formData, setFormData] = useState<Record<string, string>>(
+		initialFormData
+	);
+
+	const showError = (message: string) => {
+		toast({
+			title: capitalizeFirstLetter(message),
+			isClosable: true,
+			status: 'error',
+			position: 'bottom-right',
+		});
+	};
+
+	const getOIDCProviders = async () => {
+		const res = await client
+			.query(OIDCProvidersQuery, {}, { requestPolicy: 'network-only' })
+			.toPromise();
+		if (res.error) {
+			showError(res.error.message);
+			return;
+		}
+		setOIDCProviders(res.data._oidc_providers.oidc_providers);
+	};
+
+	useEffect(() => {
+		let isMounted = true;
+		async function getData() {
+			await getOIDCProviders();
+			if (isMounted) {
+				setLoading(false);
+			}
+		}
+
+		getData();
+
+		return () => {
+			isMounted = false;
+		};
+	}, []);
+
+	const inputHandler = (field: string, value: string) => {
+		setFormData({ ...formData, [field]: value });
+	};
+
+	const addHandler = async () => {
+		setLoading(true);
+		const res = await client
+			.mutation(AddOIDCProvider, { params: formData })
+			.toPromise();
+		setLoading(false);
+		if (res.error) {
+			showError(res.error.message);
+			return;
+		}
+
+		setFormData(initialFormData);
+		await getOIDCProviders();
+	};
+
+	const toggleHandler = async (
+		oidcProvider: oidcProviderType,
+		field: 'enabled' | 'trust_email'
+	) => {
+		const res = await client
+			.mutation(UpdateOIDCProvider, {
+				params: { id: oidcProvider.id, [field]: !oidcProvider[field] },
+			})
+			.toPromise();
+		if (res.error) {
+			showError(res.error.message);
+			return;
+		}
+
+		await getOIDCProviders();
+	};
+
+	const deleteHandler = async (oidcProvider: oidcProviderType) => {
+		const res = await client
+			.mutation(DeleteOIDCProvider, { params: { id: oidcProvider.id } })
+			.toPromise();
+		if (res.error) {
+			showError(res.error.message);
+			return;
+		}
+
+		await getOIDCProviders();
+	};
+
+	return (
+		<Box m="5" py="5" px="10" bg="white" rounded="md">
+			<Text fontSize="md" paddingTop="2%" fontWeight="bold">
+				OpenID Connect Providers
+			</Text>
+			<Stack direction="row" spacing={4} padding="2% 0%">
+				<Input
+					size="sm"
+					placeholder="okta"
+					value={formData.name}
+					onChange={(e) => inputHandler('name', e.target.value)}
+				/>
+				<Input
+					size="sm"
+					placeholder="Display Name"
+					value={formData.display_name}
+					onChange={(e) => inputHandler('display_name', e.target.value)}
+				/>
+				<Input
+					size="sm"
+					placeholder="https://example.okta.com"
+					value={formData.issuer_url}
+					onChange={(e) => inputHandler('issuer_url', e.target.value)}
+				/>
+				<Input
+					size="sm"
+					placeholder="Client ID"
+					value={formData.client_id}
+					onChange={(e) => inputHandler('client_id', e.target.value)}
+				/>
+				<Input
+					size="sm"
+					type="password"
+					placeholder="Client Secret"
+					value={formData.client_secret}
+					onChange={(e) => inputHandler('client_secret', e.target.value)}
+				/>
+				<Button
+					leftIcon={<FaPlus />}
+					colorScheme="blue"
+					variant="solid"
+					size="sm"
+					onClick={addHandler}
+					isDisabled={
+						loading ||
+						formData.name === '' ||
+						formData.issuer_url === '' ||
+						formData.client_id === '' ||
+						formData.client_secret === ''
+					}
+				>
+					Add
+				</Button>
+			</Stack>
+			<Table size="sm">
+				<Thead>
+					<Tr>
+						<Th>Name</Th>
+						<Th>Display Name</Th>
+						<Th>Issuer</Th>
+						<Th>Client ID</Th>
+						<Th>Scopes</Th>
+						<Th>Trust Email</Th>
+						<Th>Enabled</Th>
+						<Th />
+					</Tr>
+				</Thead>
+				<Tbody>
+					{oidcProviders.map((oidcProvider) => (
+						<Tr key={oidcProvider.id}>
+							<Td fontFamily="mono">{oidcProvider.name}</Td>
+							<Td>{oidcProvider.display_name}</Td>
+							<Td>{oidcProvider.issuer_url}</Td>
+							<Td>{oidcProvider.client_id}</Td>
+							<Td>{oidcProvider.scopes.join(' ')}</Td>
+							<Td>
+								<Switch
+									isChecked={oidcProvider.trust_email}
+									onChange={() => toggleHandler(oidcProvider, 'trust_email')}
+								/>
+							</Td>
+							<Td>
+								<Switch
+									isChecked={oidcProvider.enabled}
+									onChange={() => toggleHandler(oidcProvider, 'enabled')}
+								/>
+							</Td>
+							<Td>
+								<IconButton
+									aria-label="delete oidc provider"
+									icon={<FaTrash />}
+									size="sm"
+									variant="ghost"
+									onClick={() => deleteHandler(oidcProvider)}
+								/>
+							</Td>
+						</Tr>
+					))}
+				</Tbody>
+			</Table>
+		</Box>
+	);
+}
@@ -0,0 +1,323 @@
+import React, { useEffect, useState } from 'react';
+import {
+	Badge,
+	Box,
+	Button,
+	Flex,
+	IconButton,
+	Input,
+	Select,
+	Stack,
+	Switch,
+	Table,
+	Tbody,
+	Td,
+	Text,
+	Th,
+	Thead,
+	Tr,
+	useToast,
+} from '@chakra-ui/react';
+import { useClient } from 'urql';
+import { FaPlus, FaTrash } from 'react-icons/fa';
+import { WebhookDeliveriesQuery, WebhooksQuery } from '../graphql/queries';
+import {
+	AddWebhook,
+	DeleteWebhook,
+	UpdateWebhook,
+} from '../graphql/mutation';
+import { capitalizeFirstLetter } from '../utils';
+
+const webhookEvents = [
+	'user.signup',
+	'user.email_verified',
+	'user.login',
+	'user.profile_updated',
+	'user.deleted',
+];
+
+interface webhookType {
+	id: string;
+	event_name: string;
+	endpoint: string;
+	enabled: boolean;
+	created_at: number;
+}
+
+interface webhookDeliveryType {
+	id: string;
+	webhook_id: string;
+	event_name: string;
+	endpoint: string;
+	status: string;
+	attempts: number;
+	response_status: number | null;
+	error: string | null;
+	next_attempt_at: number | null;
+	created_at: number;
+}
+
+const deliveryStatusColors: Record<string, string> = {
+	pending: 'yellow',
+	success: 'green',
+	failed: 'red',
+};
+
+const formatDate = (timestamp: number | null) =>
+	timestamp ? new Date(timestamp * 1000).toLocaleString() : '-';
+
+export default function Webhooks() {
+	const client = useClient();
+	const toast = useToast();
+	const [loading, setLoading] = useState<boolean>(true);
+	const [webhooks, setWebhooks] = useState<webhookType[]>([]);
+	const [deliveries, setDeliveries] = useState<webhookDeliveryType[]>([]);
+	const [selectedWebhookID, setSelectedWebhookID] = useState<string>('');
+	const [eventName, setEventName] = useState<string>(webhookEvents[0]);
+	const [endpoint, setEndpoint] = useState<string>('');
+	// signing secret is only returned when webhook is added
+	const [newSecret, setNewSecret] = useState<string>('');
+
+	const showError = (message: string) => {
+		toast({
+			title: capitalizeFirstLetter(message),
+			isClosable: true,
+			status: 'error',
+			position: 'bottom-right',
+		});
+	};
+
+	const getWebhooks = async () => {
+		const res = await client
+			.query(WebhooksQuery, {}, { requestPolicy: 'network-only' })
+			.toPromise();
+		if (res.error) {
+			showError(res.error.message);
+			return;
+		}
+		setWebhooks(res.data._webhooks.webhooks);
+	};
+
+	const getDeliveries = async (webhookID: string) => {
+		const res = await client
+			.query(
+				WebhookDeliveriesQuery,
+				{
+					params: webhookID ? { webhook_id: webhookID } : {},
+				},
+				{ requestPolicy: 'network-only' }
+			)
+			.toPromise();
+		if (res.error) {
+			showError(res.error.message);
+			return;
+		}
+		setDeliveries(res.data._webhook_deliveries.webhook_deliveries);
+	};
+
+	useEffect(() => {
+		let isMounted = true;
+		async function getData() {
+			await Promise.all([getWebhooks(), getDeliveries('')]);
+			if (isMounted) {
+				setLoading(false);
+			}
+		}
+
+		getData();
+
+		return () => {
+			isMounted = false;
+		};
+	}, []);
+
+	const addHandler = async () => {
+		setLoading(true);
+		const res = await client
+			.mutation(AddWebhook, {
+				params: { event_name: eventName, endpoint },
+			})
+			.toPromise();
+		setLoading(false);
+		if (res.error) {
+			showError(res.error.message);
+			return;
+		}
+
+		setEndpoint('');
+		setNewSecret(res.data._add_webhook.secret);
+		await getWebhooks();
+	};
+
+	const toggleHandler = async (webhook: webhookType) => {
+		const res = await client
+			.mutation(UpdateWebhook, {
+				params: { id: webhook.id, enabled: !webhook.enabled },
+			})
+			.toPromise();
+		if (res.error) {
+			showError(res.error.message);
+			return;
+		}
+
+		await getWebhooks();
+	};
+
+	const deleteHandler = async (webhook: webhookType) => {
+		const res = await client
+			.mutation(DeleteWebhook, { params: { id: webhook.id } })
+			.toPromise();
+		if (res.error) {
+			showError(res.error.message);
+			return;
+		}
+
+		if (selectedWebhookID === webhook.id) {
+			setSelectedWebhookID('');
+		}
+		await getWebhooks();
+	};
+
+	const selectWebhookHandler = async (webhookID: string) => {
+		setSelectedWebhookID(webhookID);
+		await getDeliveries(webhookID);
+	};
+
+	return (
+		<Box m="5" py="5" px="10" bg="white" rounded="md">
+			<Text fontSize="md" paddingTop="2%" fontWeight="bold">
+				Webhooks
+			</Text>
+			<Stack direction="row" spacing={4} padding="2% 0%">
+				<Select
+					width="30%"
+					size="sm"
+					value={eventName}
+					onChange={(e) => setEventName(e.target.value)}
+				>
+					{webhookEvents.map((event) => (
+						<option key={event} value={event}>
+							{event}
+						</option>
+					))}
+				</Select>
+				<Input
+					size="sm"
+					placeholder="https://example.com/webhook"
+					value={endpoint}
+					onChange={(e) => setEndpoint(e.target.value)}
+				/>
+				<Button
+					leftIcon={<FaPlus />}
+					colorScheme="blue"
+					variant="solid"
+					size="sm"
+					onClick={addHandler}
+					isDisabled={loading || endpoint === ''}
+				>
+					Add
+				</Button>
+			</Stack>
+			{newSecret && (
+				<Box padding="2%" marginBottom="2%" bg="yellow.50" rounded="md">
+					<Text fontSize="sm">
+						Copy the signing secret of the new webhook, it will not be shown
+						again:
+					</Text>
+					<Text fontSize="sm" fontFamily="mono">
+						{newSecret}
+					</Text>
+				</Box>
+			)}
+			<Table size="sm">
+				<Thead>
+					<Tr>
+						<Th>Event</Th>
+						<Th>Endpoint</Th>
+						<Th>Enabled</Th>
+						<Th />
+					</Tr>
+				</Thead>
+				<Tbody>
+					{webhooks.map((webhook) => (
+						<Tr
+							key={webhook.id}
+							cursor="pointer"
+							bg={selectedWebhookID === webhook.id ? 'gray.100' : ''}
+							onClick={() => selectWebhookHandler(webhook.id)}
+						>
+							<Td>{webhook.event_name}</Td>
+							<Td>{webhook.endpoint}</Td>
+							<Td onClick={(e) => e.stopPropagation()}>
+								<Switch
+									isChecked={webhook.enabled}
+									onChange={() => toggleHandler(webhook)}
+								/>
+							</Td>
+							<Td onClick={(e) => e.stopPropagation()}>
+								<IconButton
+									aria-label="delete webhook"
+									icon={<FaTrash />}
+									size="sm"
+									variant="ghost"
+									onClick={() => deleteHandler(webhook)}
+								/>
+							</Td>
+						</Tr>
+					))}
+				</Tbody>
+			</Table>
+
+			<Flex paddingTop="5%" justifyContent="space-between">
+				<Text fontSize="md" fontWeight="bold">
+					Delivery Log
+				</Text>
+				{selectedWebhookID && (
+					<Button
+						size="sm"
+						variant="outline"
+						onClick={() => selectWebhookHandler('')}
+					>
+						Show all
+					</Button>
+				)}
+			</Flex>
+			<Table size="sm" marginTop="2%">
+				<Thead>
+					<Tr>
+						<Th>Event</Th>
+						<Th>Endpoint</Th>
+						<Th>Status</Th>
+						<Th>Attempts</Th>
+						<Th>Response</Th>
+						<Th>Next Attempt</Th>
+						<Th>Created At</Th>
+					</Tr>
+				</Thead>
+				<Tbody>
+					{deliveries.map((delivery) => (
+						<Tr key={delivery.id}>
+							<Td>{delivery.event_name}</Td>
+							<Td>{delivery.endpoint}</Td>
+							<Td>
+								<Badge colorScheme={deliveryStatusColors[delivery.status]}>
+									{delivery.status}
+								</Badge>
+							</Td>
+							<Td>{delivery.attempts}</Td>
+							<Td title={delivery.error || ''}>
+								{delivery.response_status || delivery.error || '-'}
+							</Td>
+							<Td>
+								{delivery.status === 'pending'
+									? formatDate(delivery.next_attempt_at)
+									: '-'}
+							</Td>
+							<Td>{formatDate(delivery.created_at)}</Td>
+						</Tr>
+					))}
+				</Tbody>
+			</Table>
+		</Box>
+	);
+}
@@ -7,7 +7,9 @@ import { DashboardLayout } from '../layouts/DashboardLayout';
 const Auth = lazy(() => import('../pages/Auth'));
 const Environment = lazy(() => import('../pages/Environment'));
 const Home = lazy(() => import('../pages/Home'));
+const OIDCProviders = lazy(() => import('../pages/OIDCProviders'));
 const Users = lazy(() => import('../pages/Users'));
+const Webhooks = lazy(() => import('../pages/Webhooks'));
 
 export const AppRoutes = () => {
 	const { isLoggedIn } = useAuthContext();
@@ -26,6 +28,8 @@ export const AppRoutes = () => {
 						<Route path="/" element={<Home />} />
 						<Route path="users" element={<Users />} />
 						<Route path="environment" element={<Environment />} />
+						<Route path="webhooks" element={<Webhooks />} />
+						<Route path="oidc-providers" element={<OIDCProviders />} />
 						<Route path="*" element={<Home />} />
 					</Route>
 				</Routes>
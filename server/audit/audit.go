@@ -0,0 +1,120 @@
+package audit
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/authorizerdev/authorizer/server/constants"
+	"github.com/authorizerdev/authorizer/server/db"
+	"github.com/authorizerdev/authorizer/server/db/models"
+	"github.com/authorizerdev/authorizer/server/env"
+	"github.com/authorizerdev/authorizer/server/utils"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	// eventContextKey is the key of the audit event of request in context.
+	// It is a string, so that it can also be set in gin context
+	eventContextKey = "AuditEventKey"
+	// retentionInterval is the interval at which audit events older than retention are deleted
+	retentionInterval = time.Hour
+)
+
+// NewEvent returns the event of action done by anonymous actor
+func NewEvent(action string) *models.AuditEvent {
+	return &models.AuditEvent{
+		Action:    action,
+		ActorType: constants.AuditActorTypeAnonymous,
+	}
+}
+
+// NewContext returns the context with the audit event of request,
+// so that resolvers can set its actor & target
+func NewContext(ctx context.Context, event *models.AuditEvent) context.Context {
+	return context.WithValue(ctx, eventContextKey, event)
+}
+
+// SetGinContext sets the audit event of request in gin context,
+// so that handlers can set its actor & target
+func SetGinContext(gc *gin.Context, event *models.AuditEvent) {
+	gc.Set(eventContextKey, event)
+}
+
+// SetActor sets the actor of the audit event of request, if any
+func SetActor(ctx context.Context, actorType, actorID, actorEmail string) {
+	event, ok := ctx.Value(eventContextKey).(*models.AuditEvent)
+	if !ok {
+		return
+	}
+
+	event.ActorType = actorType
+	event.ActorID = actorID
+	event.ActorEmail = actorEmail
+}
+
+// SetTarget sets the target of the audit event of request, if any
+func SetTarget(ctx context.Context, targetType, targetID string) {
+	event, ok := ctx.Value(eventContextKey).(*models.AuditEvent)
+	if !ok {
+		return
+	}
+
+	event.TargetType = targetType
+	event.TargetID = targetID
+}
+
+// SetMessage sets the message of the audit event of request, if any
+func SetMessage(ctx context.Context, message string) {
+	event, ok := ctx.Value(eventContextKey).(*models.AuditEvent)
+	if !ok {
+		return
+	}
+
+	event.Message = message
+}
+
+// Log saves the audit event with ip & user agent of the request.
+// Event is marked as failed with the error message (followed by the message set by handler) if err is not nil
+func Log(gc *gin.Context, event models.AuditEvent, err error) {
+	event.IP = utils.GetIP(gc.Request)
+	event.UserAgent = utils.GetUserAgent(gc.Request)
+	event.CreatedAt = time.Now().Unix()
+	event.Result = constants.AuditResultSuccess
+	if err != nil {
+		event.Result = constants.AuditResultFailure
+		message := err.Error()
+		if event.Message != "" {
+			message = message + ", " + event.Message
+		}
+		event.Message = message
+	}
+
+	if _, err := db.Provider.AddAuditEvent(event); err != nil {
+		log.Println("error saving audit event:", err)
+	}
+}
+
+// StartRetentionWorker deletes the audit events older than AUDIT_LOG_RETENTION_DAYS, in background
+func StartRetentionWorker() {
+	go func() {
+		DeleteExpiredEvents()
+		ticker := time.NewTicker(retentionInterval)
+		defer ticker.Stop()
+		for range ticker.C {
+			DeleteExpiredEvents()
+		}
+	}()
+}
+
+// DeleteExpiredEvents deletes the audit events older than AUDIT_LOG_RETENTION_DAYS, if it is not 0
+func DeleteExpiredEvents() {
+	days := env.GetAuditLogRetentionDays()
+	if days == 0 {
+		return
+	}
+
+	if err := db.Provider.DeleteAuditEventsBefore(time.Now().AddDate(0, 0, -days).Unix()); err != nil {
+		log.Println("error deleting expired audit events:", err)
+	}
+}
@@ -0,0 +1,53 @@
+package constants
+
+const (
+	// AuditActorTypeUser is the actor type of events done by user
+	AuditActorTypeUser = "user"
+	// AuditActorTypeAdmin is the actor type of events done with admin secret or admin session
+	AuditActorTypeAdmin = "admin"
+	// AuditActorTypeClient is the actor type of events done by oauth client
+	AuditActorTypeClient = "client"
+	// AuditActorTypeAnonymous is the actor type of events done without identifying the actor, e.g. failed admin login
+	AuditActorTypeAnonymous = "anonymous"
+
+	// AuditResultSuccess is the result of successful event
+	AuditResultSuccess = "success"
+	// AuditResultFailure is the result of failed event
+	AuditResultFailure = "failure"
+
+	// AuditTargetTypeUser is the target type of events on user
+	AuditTargetTypeUser = "user"
+	// AuditTargetTypeClient is the target type of events on oauth client
+	AuditTargetTypeClient = "client"
+	// AuditTargetTypeEnv is the target type of events on env settings
+	AuditTargetTypeEnv = "env"
+	// AuditTargetTypeJWTKey is the target type of events on jwt signing key
+	AuditTargetTypeJWTKey = "jwt_key"
+	// AuditTargetTypeWebhook is the target type of events on webhook
+	AuditTargetTypeWebhook = "webhook"
+	// AuditTargetTypeOIDCProvider is the target type of events on OpenID Connect provider
+	AuditTargetTypeOIDCProvider = "oidc_provider"
+
+	// Actions of the http handlers, graphql events use the name of mutation or query as action
+
+	// AuditActionOAuthLogin is the action of starting social login
+	AuditActionOAuthLogin = "oauth_login"
+	// AuditActionOAuthCallback is the action of completing social login
+	AuditActionOAuthCallback = "oauth_callback"
+	// AuditActionVerifyEmailLink is the action of verifying email with magic link
+	AuditActionVerifyEmailLink = "verify_email_link"
+	// AuditActionAuthorize is the action of oauth authorize endpoint
+	AuditActionAuthorize = "authorize"
+	// AuditActionOAuthToken is the action of oauth token endpoint
+	AuditActionOAuthToken = "oauth_token"
+	// AuditActionDeviceAuthorization is the action of oauth device authorization endpoint
+	AuditActionDeviceAuthorization = "device_authorization"
+	// AuditActionOAuthRevoke is the action of oauth token revocation endpoint
+	AuditActionOAuthRevoke = "oauth_revoke"
+	// AuditActionOAuthIntrospect is the action of oauth token introspection endpoint
+	AuditActionOAuthIntrospect = "oauth_introspect"
+	// AuditActionUserInfo is the action of openid connect userinfo endpoint
+	AuditActionUserInfo = "userinfo"
+	// AuditActionRefreshTokenReuse is the security event of reused refresh token
+	AuditActionRefreshTokenReuse = "refresh_token_reuse"
+)
@@ -0,0 +1,10 @@
+package constants
+
+const (
+	// DeviceCodeStatusPending is the status of device code waiting for user approval
+	DeviceCodeStatusPending = "pending"
+	// DeviceCodeStatusApproved is the status of device code approved by user
+	DeviceCodeStatusApproved = "approved"
+	// DeviceCodeStatusDenied is the status of device code denied by user
+	DeviceCodeStatusDenied = "denied"
+)
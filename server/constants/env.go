@@ -38,10 +38,70 @@ const (
 	EnvKeySmtpPassword = "SMTP_PASSWORD"
 	// EnvKeySenderEmail key for env variable SENDER_EMAIL
 	EnvKeySenderEmail = "SENDER_EMAIL"
+	// EnvKeySmsWebhookURL key for env variable SMS_WEBHOOK_URL
+	EnvKeySmsWebhookURL = "SMS_WEBHOOK_URL"
+	// EnvKeySmsWebhookAuthorization key for env variable SMS_WEBHOOK_AUTHORIZATION
+	// It is sent as Authorization header of sms webhook requests
+	EnvKeySmsWebhookAuthorization = "SMS_WEBHOOK_AUTHORIZATION"
+	// EnvKeyClientID key for env variable CLIENT_ID
+	// It is used as audience of ID tokens issued to first party apps
+	EnvKeyClientID = "CLIENT_ID"
+	// EnvKeyClientRedirectURIs key for env variable CLIENT_REDIRECT_URIS
+	// It holds the redirect uris allowed for authorization code flow of first party apps
+	EnvKeyClientRedirectURIs = "CLIENT_REDIRECT_URIS"
 	// EnvKeyJwtType key for env variable JWT_TYPE
 	EnvKeyJwtType = "JWT_TYPE"
 	// EnvKeyJwtSecret key for env variable JWT_SECRET
 	EnvKeyJwtSecret = "JWT_SECRET"
+	// EnvKeyJwtPrivateKey key for env variable JWT_PRIVATE_KEY
+	EnvKeyJwtPrivateKey = "JWT_PRIVATE_KEY"
+	// EnvKeyJwtPublicKey key for env variable JWT_PUBLIC_KEY
+	EnvKeyJwtPublicKey = "JWT_PUBLIC_KEY"
+	// EnvKeyJwtKeyID key for env variable JWT_KEY_ID
+	EnvKeyJwtKeyID = "JWT_KEY_ID"
+	// EnvKeyJwtKeys key for env variable JWT_KEYS
+	// It holds the JSON encoded key ring of pending & retired signing keys
+	EnvKeyJwtKeys = "JWT_KEYS"
+	// EnvKeyJwtKeyGracePeriod key for env variable JWT_KEY_GRACE_PERIOD
+	EnvKeyJwtKeyGracePeriod = "JWT_KEY_GRACE_PERIOD"
+	// EnvKeyAccessTokenExpiryTime key for env variable ACCESS_TOKEN_EXPIRY_TIME
+	EnvKeyAccessTokenExpiryTime = "ACCESS_TOKEN_EXPIRY_TIME"
+	// EnvKeyRefreshTokenExpiryTime key for env variable REFRESH_TOKEN_EXPIRY_TIME
+	EnvKeyRefreshTokenExpiryTime = "REFRESH_TOKEN_EXPIRY_TIME"
+	// EnvKeyVerificationTokenExpiryTime key for env variable VERIFICATION_TOKEN_EXPIRY_TIME
+	// It is used for email verification, forgot password & magic link tokens
+	EnvKeyVerificationTokenExpiryTime = "VERIFICATION_TOKEN_EXPIRY_TIME"
+	// EnvKeySessionInactivityTimeout key for env variable SESSION_INACTIVITY_TIMEOUT
+	EnvKeySessionInactivityTimeout = "SESSION_INACTIVITY_TIMEOUT"
+	// EnvKeyRoleAccessTokenExpiryTimes key for env variable ROLE_ACCESS_TOKEN_EXPIRY_TIMES
+	// It holds the list of role:duration
+	EnvKeyRoleAccessTokenExpiryTimes = "ROLE_ACCESS_TOKEN_EXPIRY_TIMES"
+	// EnvKeyRoleRefreshTokenExpiryTimes key for env variable ROLE_REFRESH_TOKEN_EXPIRY_TIMES
+	// It holds the list of role:duration
+	EnvKeyRoleRefreshTokenExpiryTimes = "ROLE_REFRESH_TOKEN_EXPIRY_TIMES"
+	// EnvKeyRateLimits key for env variable RATE_LIMITS
+	// It holds the list of action:scope:limit/window
+	EnvKeyRateLimits = "RATE_LIMITS"
+	// EnvKeyAccountLockoutThreshold key for env variable ACCOUNT_LOCKOUT_THRESHOLD
+	EnvKeyAccountLockoutThreshold = "ACCOUNT_LOCKOUT_THRESHOLD"
+	// EnvKeyAccountLockoutDuration key for env variable ACCOUNT_LOCKOUT_DURATION
+	EnvKeyAccountLockoutDuration = "ACCOUNT_LOCKOUT_DURATION"
+	// EnvKeyPasswordMinLength key for env variable PASSWORD_MIN_LENGTH
+	EnvKeyPasswordMinLength = "PASSWORD_MIN_LENGTH"
+	// EnvKeyPasswordCharacterClasses key for env variable PASSWORD_CHARACTER_CLASSES
+	// It holds the list of required character classes: lowercase, uppercase, number & special
+	EnvKeyPasswordCharacterClasses = "PASSWORD_CHARACTER_CLASSES"
+	// EnvKeyPasswordHistorySize key for env variable PASSWORD_HISTORY_SIZE
+	EnvKeyPasswordHistorySize = "PASSWORD_HISTORY_SIZE"
+	// EnvKeyBreachedPasswordsFile key for env variable BREACHED_PASSWORDS_FILE
+	// It is the path of file with sorted SHA-1 hashes of breached passwords
+	EnvKeyBreachedPasswordsFile = "BREACHED_PASSWORDS_FILE"
+	// EnvKeyPasswordHashAlgorithm key for env variable PASSWORD_HASH_ALGORITHM
+	// It is one of argon2id (default), bcrypt & scrypt
+	EnvKeyPasswordHashAlgorithm = "PASSWORD_HASH_ALGORITHM"
+	// EnvKeyAuditLogRetentionDays key for env variable AUDIT_LOG_RETENTION_DAYS
+	// Audit events older than it are deleted, 0 keeps them forever
+	EnvKeyAuditLogRetentionDays = "AUDIT_LOG_RETENTION_DAYS"
 	// EnvKeyAllowedOrigins key for env variable ALLOWED_ORIGINS
 	EnvKeyAllowedOrigins = "ALLOWED_ORIGINS"
 	// EnvKeyAppURL key for env variable APP_URL
@@ -64,6 +124,15 @@ const (
 	EnvKeyDisableMagicLinkLogin = "DISABLE_MAGIC_LINK_LOGIN"
 	// EnvKeyDisableLoginPage key for env variable DISABLE_LOGIN_PAGE
 	EnvKeyDisableLoginPage = "DISABLE_LOGIN_PAGE"
+	// EnvKeyDisableSecurityAlertEmail key for env variable DISABLE_SECURITY_ALERT_EMAIL
+	EnvKeyDisableSecurityAlertEmail = "DISABLE_SECURITY_ALERT_EMAIL"
+	// EnvKeyDisableWebauthn key for env variable DISABLE_WEBAUTHN
+	EnvKeyDisableWebauthn = "DISABLE_WEBAUTHN"
+	// EnvKeyDisablePasswordEmailCheck key for env variable DISABLE_PASSWORD_EMAIL_CHECK
+	// By default password containing the email is not allowed
+	EnvKeyDisablePasswordEmailCheck = "DISABLE_PASSWORD_EMAIL_CHECK"
+	// EnvKeyDisablePhoneLogin key for env variable DISABLE_PHONE_LOGIN
+	EnvKeyDisablePhoneLogin = "DISABLE_PHONE_LOGIN"
 	// EnvKeyRoles key for env variable ROLES
 	EnvKeyRoles = "ROLES"
 	// EnvKeyProtectedRoles key for env variable PROTECTED_ROLES
@@ -84,6 +153,40 @@ const (
 	EnvKeyFacebookClientID = "FACEBOOK_CLIENT_ID"
 	// EnvKeyFacebookClientSecret key for env variable FACEBOOK_CLIENT_SECRET
 	EnvKeyFacebookClientSecret = "FACEBOOK_CLIENT_SECRET"
+	// EnvKeyAppleClientID key for env variable APPLE_CLIENT_ID
+	// It is the services id configured for sign in with apple
+	EnvKeyAppleClientID = "APPLE_CLIENT_ID"
+	// EnvKeyAppleTeamID key for env variable APPLE_TEAM_ID
+	EnvKeyAppleTeamID = "APPLE_TEAM_ID"
+	// EnvKeyAppleKeyID key for env variable APPLE_KEY_ID
+	EnvKeyAppleKeyID = "APPLE_KEY_ID"
+	// EnvKeyApplePrivateKey key for env variable APPLE_PRIVATE_KEY
+	// It is the PEM encoded private key used to sign the client secret JWT
+	EnvKeyApplePrivateKey = "APPLE_PRIVATE_KEY"
+	// EnvKeyMicrosoftClientID key for env variable MICROSOFT_CLIENT_ID
+	EnvKeyMicrosoftClientID = "MICROSOFT_CLIENT_ID"
+	// EnvKeyMicrosoftClientSecret key for env variable MICROSOFT_CLIENT_SECRET
+	EnvKeyMicrosoftClientSecret = "MICROSOFT_CLIENT_SECRET"
+	// EnvKeyMicrosoftTenantID key for env variable MICROSOFT_TENANT_ID
+	// It is required and should be the directory (tenant) id, multi tenant common, organizations
+	// & consumers are not supported. Email is only trusted with xms_edov optional claim of id_token
+	EnvKeyMicrosoftTenantID = "MICROSOFT_TENANT_ID"
+	// EnvKeyGitlabClientID key for env variable GITLAB_CLIENT_ID
+	EnvKeyGitlabClientID = "GITLAB_CLIENT_ID"
+	// EnvKeyGitlabClientSecret key for env variable GITLAB_CLIENT_SECRET
+	EnvKeyGitlabClientSecret = "GITLAB_CLIENT_SECRET"
+	// EnvKeyLinkedinClientID key for env variable LINKEDIN_CLIENT_ID
+	EnvKeyLinkedinClientID = "LINKEDIN_CLIENT_ID"
+	// EnvKeyLinkedinClientSecret key for env variable LINKEDIN_CLIENT_SECRET
+	EnvKeyLinkedinClientSecret = "LINKEDIN_CLIENT_SECRET"
+	// EnvKeyDiscordClientID key for env variable DISCORD_CLIENT_ID
+	EnvKeyDiscordClientID = "DISCORD_CLIENT_ID"
+	// EnvKeyDiscordClientSecret key for env variable DISCORD_CLIENT_SECRET
+	EnvKeyDiscordClientSecret = "DISCORD_CLIENT_SECRET"
+	// EnvKeyTwitterClientID key for env variable TWITTER_CLIENT_ID
+	EnvKeyTwitterClientID = "TWITTER_CLIENT_ID"
+	// EnvKeyTwitterClientSecret key for env variable TWITTER_CLIENT_SECRET
+	EnvKeyTwitterClientSecret = "TWITTER_CLIENT_SECRET"
 	// EnvKeyOrganizationName key for env variable ORGANIZATION_NAME
 	EnvKeyOrganizationName = "ORGANIZATION_NAME"
 	// EnvKeyOrganizationLogo key for env variable ORGANIZATION_LOGO
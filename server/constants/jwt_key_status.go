@@ -0,0 +1,11 @@
+package constants
+
+const (
+	// JwtKeyStatusActive is the status of key used for signing new tokens
+	JwtKeyStatusActive = "active"
+	// JwtKeyStatusPending is the status of generated key which is not promoted yet
+	JwtKeyStatusPending = "pending"
+	// JwtKeyStatusRetired is the status of previously active key,
+	// which is used for verifying tokens till the grace period ends
+	JwtKeyStatusRetired = "retired"
+)
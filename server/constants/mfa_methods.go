@@ -0,0 +1,8 @@
+package constants
+
+const (
+	// MfaMethodTotp is the second factor using authenticator app or backup code (verify_otp mutation)
+	MfaMethodTotp = "totp"
+	// MfaMethodWebauthn is the second factor using passkey (webauthn_login mutation)
+	MfaMethodWebauthn = "webauthn"
+)
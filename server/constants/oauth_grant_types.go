@@ -0,0 +1,23 @@
+package constants
+
+const (
+	// GrantTypeAuthorizationCode is the grant type to exchange authorization code for tokens
+	GrantTypeAuthorizationCode = "authorization_code"
+	// GrantTypeRefreshToken is the grant type to exchange refresh token for new tokens
+	GrantTypeRefreshToken = "refresh_token"
+	// GrantTypeClientCredentials is the grant type to get tokens for the client itself (machine to machine)
+	GrantTypeClientCredentials = "client_credentials"
+	// GrantTypeDeviceCode is the grant type to exchange device code for tokens (RFC 8628)
+	GrantTypeDeviceCode = "urn:ietf:params:oauth:grant-type:device_code"
+	// ResponseTypeCode is the response type of authorization code flow
+	ResponseTypeCode = "code"
+)
+
+// ClientGrantTypes is the list of grant types that can be allowed for oauth clients
+var ClientGrantTypes = []string{GrantTypeAuthorizationCode, GrantTypeRefreshToken, GrantTypeClientCredentials, GrantTypeDeviceCode}
+
+// DefaultClientGrantTypes is the list of grant types allowed for oauth clients by default
+var DefaultClientGrantTypes = []string{GrantTypeAuthorizationCode, GrantTypeRefreshToken}
+
+// DefaultClientScopes is the list of scopes allowed for oauth clients by default
+var DefaultClientScopes = []string{"openid", "email", "profile"}
@@ -8,4 +8,14 @@ const (
 	FacebookUserInfoURL = "https://graph.facebook.com/me?fields=id,first_name,last_name,name,email,picture&access_token="
 	// Ref: https://docs.github.com/en/developers/apps/building-github-apps/identifying-and-authorizing-users-for-github-apps#3-your-github-app-accesses-the-api-with-the-users-access-token
 	GithubUserInfoURL = "https://api.github.com/user"
+	// Ref: https://docs.gitlab.com/ee/api/users.html#list-current-user
+	GitlabUserInfoURL = "https://gitlab.com/api/v4/user"
+	// Ref: https://learn.microsoft.com/en-us/linkedin/consumer/integrations/self-serve/sign-in-with-linkedin-v2
+	LinkedinUserInfoURL = "https://api.linkedin.com/v2/userinfo"
+	// Ref: https://discord.com/developers/docs/resources/user#get-current-user
+	DiscordUserInfoURL = "https://discord.com/api/users/@me"
+	// DiscordAvatarURL is the url of discord avatar, formatted with user id and avatar hash
+	DiscordAvatarURL = "https://cdn.discordapp.com/avatars/%s/%s.png"
+	// Ref: https://developer.x.com/en/docs/x-api/users/lookup/api-reference/get-users-me
+	TwitterUserInfoURL = "https://api.twitter.com/2/users/me?user.fields=name,profile_image_url,confirmed_email"
 )
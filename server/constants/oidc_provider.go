@@ -0,0 +1,19 @@
+package constants
+
+// OIDCProviderDefaultScopes are the scopes requested from the OpenID Connect provider when scopes are not configured
+var OIDCProviderDefaultScopes = []string{"openid", "profile", "email"}
+
+// OIDCProviderClaimMappingFields are the user fields which can be mapped to the claims of OpenID Connect provider.
+// By default the claim with same name as field is used
+var OIDCProviderClaimMappingFields = []string{
+	"email",
+	"email_verified",
+	"given_name",
+	"family_name",
+	"middle_name",
+	"nickname",
+	"picture",
+	"gender",
+	"birthdate",
+	"phone_number",
+}
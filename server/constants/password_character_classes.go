@@ -0,0 +1,20 @@
+package constants
+
+const (
+	// PasswordCharacterClassLowercase requires a lowercase letter in password
+	PasswordCharacterClassLowercase = "lowercase"
+	// PasswordCharacterClassUppercase requires an uppercase letter in password
+	PasswordCharacterClassUppercase = "uppercase"
+	// PasswordCharacterClassNumber requires a number in password
+	PasswordCharacterClassNumber = "number"
+	// PasswordCharacterClassSpecial requires a character other than letters & numbers in password
+	PasswordCharacterClassSpecial = "special"
+)
+
+// PasswordCharacterClasses is the list of character classes that can be required in password
+var PasswordCharacterClasses = []string{
+	PasswordCharacterClassLowercase,
+	PasswordCharacterClassUppercase,
+	PasswordCharacterClassNumber,
+	PasswordCharacterClassSpecial,
+}
@@ -0,0 +1,38 @@
+package constants
+
+const (
+	// RateLimitScopeEmail is the scope to limit requests per email
+	RateLimitScopeEmail = "email"
+	// RateLimitScopeIP is the scope to limit requests per ip address
+	RateLimitScopeIP = "ip"
+
+	// RateLimitActionLogin is the login action
+	RateLimitActionLogin = "login"
+	// RateLimitActionAdminLogin is the admin login action
+	RateLimitActionAdminLogin = "admin_login"
+	// RateLimitActionSignup is the signup action
+	RateLimitActionSignup = "signup"
+	// RateLimitActionForgotPassword is the forgot password action
+	RateLimitActionForgotPassword = "forgot_password"
+	// RateLimitActionMagicLinkLogin is the magic link (and email otp) login action
+	RateLimitActionMagicLinkLogin = "magic_link_login"
+	// RateLimitActionResendVerifyEmail is the resend verify email action
+	RateLimitActionResendVerifyEmail = "resend_verify_email"
+	// RateLimitActionPhoneLogin is the phone otp login action
+	RateLimitActionPhoneLogin = "phone_login"
+	// RateLimitActionSendPhoneVerificationOtp is the phone number verification otp action
+	RateLimitActionSendPhoneVerificationOtp = "send_phone_verification_otp"
+	// RateLimitActionVerifyEmailOtp is the email otp login verification action
+	RateLimitActionVerifyEmailOtp = "verify_email_otp"
+	// RateLimitActionVerifyPhoneOtp is the phone otp login verification action
+	RateLimitActionVerifyPhoneOtp = "verify_phone_otp"
+	// RateLimitActionVerifyOtp is the mfa otp verification action
+	RateLimitActionVerifyOtp = "verify_otp"
+	// RateLimitActionWebauthnLogin is the passkey login action
+	RateLimitActionWebauthnLogin = "webauthn_login"
+
+	// ErrorCodeTooManyRequests is the error code when request is rate limited
+	ErrorCodeTooManyRequests = "TOO_MANY_REQUESTS"
+	// ErrorCodeAccountLocked is the error code when account is locked after failed logins
+	ErrorCodeAccountLocked = "ACCOUNT_LOCKED"
+)
@@ -11,4 +11,32 @@ const (
 	SignupMethodGithub = "github"
 	// SignupMethodFacebook is the facebook signup method
 	SignupMethodFacebook = "facebook"
+	// SignupMethodApple is the apple signup method
+	SignupMethodApple = "apple"
+	// SignupMethodMicrosoft is the microsoft signup method
+	SignupMethodMicrosoft = "microsoft"
+	// SignupMethodGitlab is the gitlab signup method
+	SignupMethodGitlab = "gitlab"
+	// SignupMethodLinkedin is the linkedin signup method
+	SignupMethodLinkedin = "linkedin"
+	// SignupMethodDiscord is the discord signup method
+	SignupMethodDiscord = "discord"
+	// SignupMethodTwitter is the twitter signup method
+	SignupMethodTwitter = "twitter"
 )
+
+// SignupMethods are the built in signup methods,
+// their names can not be used by the generic OpenID Connect providers
+var SignupMethods = []string{
+	SignupMethodBasicAuth,
+	SignupMethodMagicLinkLogin,
+	SignupMethodGoogle,
+	SignupMethodGithub,
+	SignupMethodFacebook,
+	SignupMethodApple,
+	SignupMethodMicrosoft,
+	SignupMethodGitlab,
+	SignupMethodLinkedin,
+	SignupMethodDiscord,
+	SignupMethodTwitter,
+}
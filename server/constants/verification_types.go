@@ -9,4 +9,10 @@ const (
 	VerificationTypeUpdateEmail = "update_email"
 	// VerificationTypeForgotPassword is the forgot_password verification type
 	VerificationTypeForgotPassword = "forgot_password"
+	// VerificationTypeEmailOTPLogin is the email_otp_login verification type
+	VerificationTypeEmailOTPLogin = "email_otp_login"
+	// VerificationTypeVerifyPhoneNumber is the verify_phone_number verification type
+	VerificationTypeVerifyPhoneNumber = "verify_phone_number"
+	// VerificationTypePhoneOTPLogin is the phone_otp_login verification type
+	VerificationTypePhoneOTPLogin = "phone_otp_login"
 )
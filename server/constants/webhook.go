@@ -0,0 +1,32 @@
+package constants
+
+const (
+	// WebhookEventUserSignup is the event of user signup with any of the signup methods,
+	// including the users created with magic link login and oauth login
+	WebhookEventUserSignup = "user.signup"
+	// WebhookEventUserEmailVerified is the event of user verifying the email address
+	WebhookEventUserEmailVerified = "user.email_verified"
+	// WebhookEventUserLogin is the event of user login with any of the login methods,
+	// it is triggered whenever a new session is issued to the user
+	WebhookEventUserLogin = "user.login"
+	// WebhookEventUserProfileUpdated is the event of user or admin updating the user profile
+	WebhookEventUserProfileUpdated = "user.profile_updated"
+	// WebhookEventUserDeleted is the event of admin deleting the user
+	WebhookEventUserDeleted = "user.deleted"
+
+	// WebhookDeliveryStatusPending is the status of delivery that is not yet delivered, and is retried
+	WebhookDeliveryStatusPending = "pending"
+	// WebhookDeliveryStatusSuccess is the status of delivery that endpoint responded with 2xx
+	WebhookDeliveryStatusSuccess = "success"
+	// WebhookDeliveryStatusFailed is the status of delivery that failed all the attempts
+	WebhookDeliveryStatusFailed = "failed"
+)
+
+// WebhookEvents is the list of events that webhooks can be registered for
+var WebhookEvents = []string{
+	WebhookEventUserSignup,
+	WebhookEventUserEmailVerified,
+	WebhookEventUserLogin,
+	WebhookEventUserProfileUpdated,
+	WebhookEventUserDeleted,
+}
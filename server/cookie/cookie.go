@@ -3,6 +3,7 @@ package cookie
 import (
 	"net/http"
 	"net/url"
+	"time"
 
 	"github.com/authorizerdev/authorizer/server/constants"
 	"github.com/authorizerdev/authorizer/server/envstore"
@@ -16,7 +17,8 @@ import (
 // 3 COOKIE_NAME.fingerprint fingerprint hash for the refresh token verification.
 // 4 COOKIE_NAME.refresh_token refresh token
 // Note all sites don't allow 2nd type of cookie
-func SetCookie(gc *gin.Context, accessToken, refreshToken, fingerprintHash string) {
+// Cookies expire along with the tokens, based on the given unix expiry times
+func SetCookie(gc *gin.Context, accessToken, refreshToken, fingerprintHash string, accessTokenExpiresAt, refreshTokenExpiresAt int64) {
 	secure := true
 	httpOnly := true
 	host, _ := utils.GetHostParts(envstore.EnvInMemoryStoreObj.GetStringStoreEnvVariable(constants.EnvKeyAuthorizerURL))
@@ -25,21 +27,21 @@ func SetCookie(gc *gin.Context, accessToken, refreshToken, fingerprintHash strin
 		domain = "." + domain
 	}
 
-	year := 60 * 60 * 24 * 365
-	thirtyMin := 60 * 30
+	accessTokenMaxAge := int(accessTokenExpiresAt - time.Now().Unix())
+	refreshTokenMaxAge := int(refreshTokenExpiresAt - time.Now().Unix())
 
 	gc.SetSameSite(http.SameSiteNoneMode)
 	// set cookie for host
-	gc.SetCookie(envstore.EnvInMemoryStoreObj.GetStringStoreEnvVariable(constants.EnvKeyCookieName)+".access_token", accessToken, thirtyMin, "/", host, secure, httpOnly)
+	gc.SetCookie(envstore.EnvInMemoryStoreObj.GetStringStoreEnvVariable(constants.EnvKeyCookieName)+".access_token", accessToken, accessTokenMaxAge, "/", host, secure, httpOnly)
 
 	// in case of subdomain, set cookie for domain
-	gc.SetCookie(envstore.EnvInMemoryStoreObj.GetStringStoreEnvVariable(constants.EnvKeyCookieName)+".access_token.domain", accessToken, thirtyMin, "/", domain, secure, httpOnly)
+	gc.SetCookie(envstore.EnvInMemoryStoreObj.GetStringStoreEnvVariable(constants.EnvKeyCookieName)+".access_token.domain", accessToken, accessTokenMaxAge, "/", domain, secure, httpOnly)
 
 	// set finger print cookie (this should be accessed via cookie only)
-	gc.SetCookie(envstore.EnvInMemoryStoreObj.GetStringStoreEnvVariable(constants.EnvKeyCookieName)+".fingerprint", fingerprintHash, year, "/", host, secure, httpOnly)
+	gc.SetCookie(envstore.EnvInMemoryStoreObj.GetStringStoreEnvVariable(constants.EnvKeyCookieName)+".fingerprint", fingerprintHash, refreshTokenMaxAge, "/", host, secure, httpOnly)
 
 	// set refresh token cookie (this should be accessed via cookie only)
-	gc.SetCookie(envstore.EnvInMemoryStoreObj.GetStringStoreEnvVariable(constants.EnvKeyCookieName)+".refresh_token", refreshToken, year, "/", host, secure, httpOnly)
+	gc.SetCookie(envstore.EnvInMemoryStoreObj.GetStringStoreEnvVariable(constants.EnvKeyCookieName)+".refresh_token", refreshToken, refreshTokenMaxAge, "/", host, secure, httpOnly)
 }
 
 // GetAccessTokenCookie to get access token cookie from the request
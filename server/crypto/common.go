@@ -0,0 +1,129 @@
+package crypto
+
+import (
+	"crypto"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/golang-jwt/jwt"
+	"gopkg.in/square/go-jose.v2"
+)
+
+// IsHMACAlgo returns true if given algo is HMAC based
+func IsHMACAlgo(algo string) bool {
+	return strings.HasPrefix(algo, "HS")
+}
+
+// IsRSAAlgo returns true if given algo is RSA based
+func IsRSAAlgo(algo string) bool {
+	return strings.HasPrefix(algo, "RS")
+}
+
+// IsECDSAAlgo returns true if given algo is ECDSA based
+func IsECDSAAlgo(algo string) bool {
+	return strings.HasPrefix(algo, "ES")
+}
+
+// IsEdDSAAlgo returns true if given algo is EdDSA (Ed25519) based
+func IsEdDSAAlgo(algo string) bool {
+	return algo == "EdDSA"
+}
+
+// IsAsymmetricAlgo returns true if given algo uses a private / public key pair
+func IsAsymmetricAlgo(algo string) bool {
+	return IsRSAAlgo(algo) || IsECDSAAlgo(algo) || IsEdDSAAlgo(algo)
+}
+
+// IsValidJwtAlgo returns true if given algo is supported for signing JWT tokens
+func IsValidJwtAlgo(algo string) bool {
+	switch algo {
+	case "HS256", "HS384", "HS512", "RS256", "RS384", "RS512", "ES256", "ES384", "ES512", "EdDSA":
+		return true
+	}
+	return false
+}
+
+// NewKeyPair generates new private & public key pair in PEM format for the given algo
+func NewKeyPair(algo string) (string, string, error) {
+	switch {
+	case IsRSAAlgo(algo):
+		return NewRSAKey()
+	case IsECDSAAlgo(algo):
+		return NewECDSAKey(algo)
+	case IsEdDSAAlgo(algo):
+		return NewEd25519Key()
+	}
+
+	return "", "", fmt.Errorf("unsupported algorithm %s for key pair", algo)
+}
+
+// ParsePrivateKey parses the PEM encoded private key for given algo
+func ParsePrivateKey(algo, privateKey string) (crypto.PrivateKey, error) {
+	switch {
+	case IsRSAAlgo(algo):
+		return jwt.ParseRSAPrivateKeyFromPEM([]byte(privateKey))
+	case IsECDSAAlgo(algo):
+		return jwt.ParseECPrivateKeyFromPEM([]byte(privateKey))
+	case IsEdDSAAlgo(algo):
+		return jwt.ParseEdPrivateKeyFromPEM([]byte(privateKey))
+	}
+
+	return nil, fmt.Errorf("unsupported algorithm %s for private key", algo)
+}
+
+// ParsePublicKey parses the PEM encoded public key for given algo
+func ParsePublicKey(algo, publicKey string) (crypto.PublicKey, error) {
+	switch {
+	case IsRSAAlgo(algo):
+		return jwt.ParseRSAPublicKeyFromPEM([]byte(publicKey))
+	case IsECDSAAlgo(algo):
+		return jwt.ParseECPublicKeyFromPEM([]byte(publicKey))
+	case IsEdDSAAlgo(algo):
+		return jwt.ParseEdPublicKeyFromPEM([]byte(publicKey))
+	}
+
+	return nil, fmt.Errorf("unsupported algorithm %s for public key", algo)
+}
+
+// GetPublicKeyFromPrivateKey returns the PEM encoded public key for the given PEM encoded private key
+func GetPublicKeyFromPrivateKey(algo, privateKey string) (string, error) {
+	key, err := ParsePrivateKey(algo, privateKey)
+	if err != nil {
+		return "", err
+	}
+
+	signer, ok := key.(crypto.Signer)
+	if !ok {
+		return "", fmt.Errorf("invalid private key")
+	}
+
+	return encodePublicKey(signer.Public())
+}
+
+// GetPubJWK returns the JSON web key for the given PEM encoded public key
+func GetPubJWK(algo, keyID, publicKey string) (map[string]interface{}, error) {
+	key, err := ParsePublicKey(algo, publicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	jwk := &jose.JSONWebKey{
+		Key:       key,
+		Algorithm: algo,
+		Use:       "sig",
+		KeyID:     keyID,
+	}
+	jwkBytes, err := jwk.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+
+	var res map[string]interface{}
+	err = json.Unmarshal(jwkBytes, &res)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
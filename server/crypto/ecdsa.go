@@ -0,0 +1,48 @@
+package crypto
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+)
+
+// NewECDSAKey generates new ECDSA private & public key pair in PEM format.
+// Curve is selected based on the algo (ES256, ES384, ES512)
+func NewECDSAKey(algo string) (string, string, error) {
+	var curve elliptic.Curve
+	switch algo {
+	case "ES256":
+		curve = elliptic.P256()
+	case "ES384":
+		curve = elliptic.P384()
+	case "ES512":
+		curve = elliptic.P521()
+	default:
+		return "", "", fmt.Errorf("unsupported ecdsa algorithm %s", algo)
+	}
+
+	key, err := ecdsa.GenerateKey(curve, rand.Reader)
+	if err != nil {
+		return "", "", err
+	}
+
+	keyBytes, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return "", "", err
+	}
+
+	privateKey := pem.EncodeToMemory(&pem.Block{
+		Type:  "EC PRIVATE KEY",
+		Bytes: keyBytes,
+	})
+
+	publicKey, err := encodePublicKey(&key.PublicKey)
+	if err != nil {
+		return "", "", err
+	}
+
+	return string(privateKey), publicKey, nil
+}
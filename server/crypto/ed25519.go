@@ -0,0 +1,33 @@
+package crypto
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+)
+
+// NewEd25519Key generates new Ed25519 private & public key pair in PEM format
+func NewEd25519Key() (string, string, error) {
+	publicKey, key, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return "", "", err
+	}
+
+	keyBytes, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return "", "", err
+	}
+
+	privateKey := pem.EncodeToMemory(&pem.Block{
+		Type:  "PRIVATE KEY",
+		Bytes: keyBytes,
+	})
+
+	encodedPublicKey, err := encodePublicKey(publicKey)
+	if err != nil {
+		return "", "", err
+	}
+
+	return string(privateKey), encodedPublicKey, nil
+}
@@ -0,0 +1,146 @@
+package crypto
+
+import (
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/subtle"
+	"encoding/base64"
+	"fmt"
+	"hash"
+	"strconv"
+	"strings"
+
+	"golang.org/x/crypto/pbkdf2"
+)
+
+// maxLegacyPasswordIterations limits the PBKDF2 iterations of imported hashes
+const maxLegacyPasswordIterations = 10000000
+
+// legacyPasswordHash is the parsed password hash of other identity systems.
+// Iterations is 0 for salted digests, i.e. digest(password + salt)
+type legacyPasswordHash struct {
+	hashFunc   func() hash.Hash
+	iterations int
+	salt       []byte
+	key        []byte
+}
+
+// legacyHashFuncs are the digest functions by their name in PBKDF2 hashes
+var legacyHashFuncs = map[string]func() hash.Hash{
+	"sha1":   sha1.New,
+	"sha256": sha256.New,
+	"sha512": sha512.New,
+}
+
+// parseLegacyPasswordHash parses the password hash imported from other identity systems. Supported formats are
+//   - PBKDF2 of passlib & PHC: $pbkdf2-sha256$<iterations>$<salt>$<hash> ($pbkdf2$ for sha1)
+//   - PBKDF2 of Django: pbkdf2_sha256$<iterations>$<salt>$<hash>
+//   - salted SHA of LDAP: {SSHA}, {SSHA256} & {SSHA512} of base64(digest + salt)
+func parseLegacyPasswordHash(hash string) (*legacyPasswordHash, error) {
+	switch {
+	case strings.HasPrefix(hash, "{SSHA"):
+		end := strings.Index(hash, "}")
+		if end < 0 {
+			return nil, fmt.Errorf("invalid salted sha hash")
+		}
+
+		hashFunc, size := sha1.New, sha1.Size
+		switch hash[:end+1] {
+		case "{SSHA}":
+		case "{SSHA256}":
+			hashFunc, size = sha256.New, sha256.Size
+		case "{SSHA512}":
+			hashFunc, size = sha512.New, sha512.Size
+		default:
+			return nil, fmt.Errorf("unsupported salted sha hash %s", hash[:end+1])
+		}
+
+		decoded, err := base64.StdEncoding.DecodeString(hash[end+1:])
+		if err != nil || len(decoded) <= size {
+			return nil, fmt.Errorf("invalid salted sha hash")
+		}
+
+		return &legacyPasswordHash{
+			hashFunc: hashFunc,
+			salt:     decoded[size:],
+			key:      decoded[:size],
+		}, nil
+	case strings.HasPrefix(hash, "$pbkdf2"):
+		parts := strings.Split(hash, "$")
+		if len(parts) != 5 {
+			return nil, fmt.Errorf("invalid pbkdf2 hash")
+		}
+
+		digest := strings.TrimPrefix(strings.TrimPrefix(parts[1], "pbkdf2"), "-")
+		if digest == "" {
+			digest = "sha1"
+		}
+
+		// passlib uses adapted base64 with . instead of +
+		salt, err := base64.RawStdEncoding.DecodeString(strings.TrimRight(strings.Replace(parts[3], ".", "+", -1), "="))
+		if err != nil {
+			return nil, fmt.Errorf("invalid pbkdf2 salt")
+		}
+
+		key, err := base64.RawStdEncoding.DecodeString(strings.TrimRight(strings.Replace(parts[4], ".", "+", -1), "="))
+		if err != nil {
+			return nil, fmt.Errorf("invalid pbkdf2 hash")
+		}
+
+		return newPBKDF2Hash(digest, strings.TrimPrefix(parts[2], "i="), salt, key)
+	case strings.HasPrefix(hash, "pbkdf2_"):
+		parts := strings.Split(hash, "$")
+		if len(parts) != 4 {
+			return nil, fmt.Errorf("invalid pbkdf2 hash")
+		}
+
+		key, err := base64.StdEncoding.DecodeString(parts[3])
+		if err != nil {
+			return nil, fmt.Errorf("invalid pbkdf2 hash")
+		}
+
+		return newPBKDF2Hash(strings.TrimPrefix(parts[0], "pbkdf2_"), parts[1], []byte(parts[2]), key)
+	}
+
+	return nil, fmt.Errorf("unsupported password hash")
+}
+
+// newPBKDF2Hash returns the PBKDF2 hash with given digest and iterations
+func newPBKDF2Hash(digest, iterations string, salt, key []byte) (*legacyPasswordHash, error) {
+	hashFunc, ok := legacyHashFuncs[digest]
+	if !ok {
+		return nil, fmt.Errorf("unsupported pbkdf2 digest %s", digest)
+	}
+
+	rounds, err := strconv.Atoi(iterations)
+	if err != nil || rounds <= 0 || rounds > maxLegacyPasswordIterations {
+		return nil, fmt.Errorf("invalid pbkdf2 iterations %s", iterations)
+	}
+
+	if len(key) == 0 || len(key) > maxPasswordKeyLength {
+		return nil, fmt.Errorf("invalid pbkdf2 hash")
+	}
+
+	return &legacyPasswordHash{
+		hashFunc:   hashFunc,
+		iterations: rounds,
+		salt:       salt,
+		key:        key,
+	}, nil
+}
+
+// verify checks if password matches the legacy hash
+func (h *legacyPasswordHash) verify(password string) bool {
+	var key []byte
+	if h.iterations > 0 {
+		key = pbkdf2.Key([]byte(password), h.salt, h.iterations, len(h.key), h.hashFunc)
+	} else {
+		digest := h.hashFunc()
+		digest.Write([]byte(password))
+		digest.Write(h.salt)
+		key = digest.Sum(nil)
+	}
+
+	return subtle.ConstantTimeCompare(key, h.key) == 1
+}
@@ -0,0 +1,272 @@
+package crypto
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/bcrypt"
+	"golang.org/x/crypto/scrypt"
+)
+
+const (
+	// PasswordHashAlgorithmArgon2id is the argon2id password hash algorithm
+	PasswordHashAlgorithmArgon2id = "argon2id"
+	// PasswordHashAlgorithmBcrypt is the bcrypt password hash algorithm
+	PasswordHashAlgorithmBcrypt = "bcrypt"
+	// PasswordHashAlgorithmScrypt is the scrypt password hash algorithm
+	PasswordHashAlgorithmScrypt = "scrypt"
+
+	// passwordHashAlgorithmArgon2i is the argon2i algorithm, it is only verified for imported hashes
+	passwordHashAlgorithmArgon2i = "argon2i"
+)
+
+// Current parameters of the password hash algorithms.
+// Hashes with other parameters are rehashed on login, so that strength can be raised over time
+const (
+	argon2idMemory      = 19 * 1024
+	argon2idIterations  = 2
+	argon2idParallelism = 1
+	bcryptCost          = bcrypt.DefaultCost
+	scryptLogN          = 15
+	scryptR             = 8
+	scryptP             = 1
+	passwordSaltLength  = 16
+	passwordKeyLength   = 32
+)
+
+// Limits of the parameters of imported hashes, so that a crafted hash can not exhaust memory or cpu on login
+const (
+	maxArgon2Memory      = 256 * 1024 // KiB
+	maxArgon2Iterations  = 16
+	maxArgon2Parallelism = 16
+	maxScryptMemory      = 256 * 1024 * 1024 // bytes, i.e. 128 * N * r
+	maxScryptP           = 16
+	maxPasswordKeyLength = 128
+)
+
+// passwordHash is the parsed PHC string $<id>$<params>$<salt>$<hash>
+type passwordHash struct {
+	algorithm string
+	params    map[string]int
+	salt      []byte
+	key       []byte
+}
+
+// IsValidPasswordHashAlgorithm checks if the password hash algorithm is supported
+func IsValidPasswordHashAlgorithm(algorithm string) bool {
+	return algorithm == PasswordHashAlgorithmArgon2id || algorithm == PasswordHashAlgorithmBcrypt || algorithm == PasswordHashAlgorithmScrypt
+}
+
+// HashPassword hashes the password with given algorithm and returns the PHC string,
+// e.g. $argon2id$v=19$m=19456,t=2,p=1$<salt>$<hash>. bcrypt hashes are in their own $2a$ format
+func HashPassword(algorithm, password string) (string, error) {
+	switch algorithm {
+	case PasswordHashAlgorithmBcrypt:
+		hash, err := bcrypt.GenerateFromPassword([]byte(password), bcryptCost)
+		if err != nil {
+			return "", err
+		}
+		return string(hash), nil
+	case PasswordHashAlgorithmArgon2id, PasswordHashAlgorithmScrypt:
+		salt := make([]byte, passwordSaltLength)
+		if _, err := io.ReadFull(rand.Reader, salt); err != nil {
+			return "", err
+		}
+
+		var params map[string]int
+		var paramNames []string
+		if algorithm == PasswordHashAlgorithmArgon2id {
+			params = map[string]int{"m": argon2idMemory, "t": argon2idIterations, "p": argon2idParallelism}
+			paramNames = []string{"m", "t", "p"}
+		} else {
+			params = map[string]int{"ln": scryptLogN, "r": scryptR, "p": scryptP}
+			paramNames = []string{"ln", "r", "p"}
+		}
+
+		key, err := deriveKey(algorithm, password, salt, params, passwordKeyLength)
+		if err != nil {
+			return "", err
+		}
+
+		encodedParams := []string{}
+		for _, name := range paramNames {
+			encodedParams = append(encodedParams, fmt.Sprintf("%s=%d", name, params[name]))
+		}
+
+		version := ""
+		if algorithm == PasswordHashAlgorithmArgon2id {
+			version = fmt.Sprintf("$v=%d", argon2.Version)
+		}
+
+		return fmt.Sprintf("$%s%s$%s$%s$%s", algorithm, version, strings.Join(encodedParams, ","), base64.RawStdEncoding.EncodeToString(salt), base64.RawStdEncoding.EncodeToString(key)), nil
+	default:
+		return "", fmt.Errorf("unsupported password hash algorithm %s", algorithm)
+	}
+}
+
+// VerifyPassword checks if password matches the hash created with any of the supported algorithms,
+// including the hashes imported from other identity systems
+func VerifyPassword(hash, password string) bool {
+	if isBcryptHash(hash) {
+		return bcrypt.CompareHashAndPassword([]byte(hash), []byte(password)) == nil
+	}
+
+	parsedHash, err := parsePasswordHash(hash)
+	if err != nil {
+		legacyHash, err := parseLegacyPasswordHash(hash)
+		if err != nil {
+			return false
+		}
+		return legacyHash.verify(password)
+	}
+
+	key, err := deriveKey(parsedHash.algorithm, password, parsedHash.salt, parsedHash.params, len(parsedHash.key))
+	if err != nil {
+		return false
+	}
+
+	return subtle.ConstantTimeCompare(key, parsedHash.key) == 1
+}
+
+// IsSupportedPasswordHash checks if hash is in one of the formats that can be verified
+func IsSupportedPasswordHash(hash string) bool {
+	if isBcryptHash(hash) {
+		_, err := bcrypt.Cost([]byte(hash))
+		return err == nil
+	}
+
+	if _, err := parsePasswordHash(hash); err == nil {
+		return true
+	}
+
+	_, err := parseLegacyPasswordHash(hash)
+	return err == nil
+}
+
+// PasswordNeedsRehash checks if hash is not created with the given algorithm and its current parameters
+func PasswordNeedsRehash(algorithm, hash string) bool {
+	if isBcryptHash(hash) {
+		if algorithm != PasswordHashAlgorithmBcrypt {
+			return true
+		}
+		cost, err := bcrypt.Cost([]byte(hash))
+		return err != nil || cost != bcryptCost
+	}
+
+	parsedHash, err := parsePasswordHash(hash)
+	if err != nil || parsedHash.algorithm != algorithm || len(parsedHash.key) != passwordKeyLength {
+		return true
+	}
+
+	switch algorithm {
+	case PasswordHashAlgorithmArgon2id:
+		return parsedHash.params["m"] != argon2idMemory || parsedHash.params["t"] != argon2idIterations || parsedHash.params["p"] != argon2idParallelism
+	case PasswordHashAlgorithmScrypt:
+		return parsedHash.params["ln"] != scryptLogN || parsedHash.params["r"] != scryptR || parsedHash.params["p"] != scryptP
+	}
+
+	return true
+}
+
+// deriveKey derives the key of password with argon2id or scrypt parameters
+func deriveKey(algorithm, password string, salt []byte, params map[string]int, keyLength int) ([]byte, error) {
+	if err := validatePasswordHashParams(algorithm, params, keyLength); err != nil {
+		return nil, err
+	}
+
+	switch algorithm {
+	case PasswordHashAlgorithmArgon2id:
+		return argon2.IDKey([]byte(password), salt, uint32(params["t"]), uint32(params["m"]), uint8(params["p"]), uint32(keyLength)), nil
+	case passwordHashAlgorithmArgon2i:
+		return argon2.Key([]byte(password), salt, uint32(params["t"]), uint32(params["m"]), uint8(params["p"]), uint32(keyLength)), nil
+	case PasswordHashAlgorithmScrypt:
+		return scrypt.Key([]byte(password), salt, 1<<uint(params["ln"]), params["r"], params["p"], keyLength)
+	}
+
+	return nil, fmt.Errorf("unsupported password hash algorithm %s", algorithm)
+}
+
+// validatePasswordHashParams checks that argon2 or scrypt parameters are within the limits
+func validatePasswordHashParams(algorithm string, params map[string]int, keyLength int) error {
+	if keyLength <= 0 || keyLength > maxPasswordKeyLength {
+		return fmt.Errorf("invalid %s key length", algorithm)
+	}
+
+	switch algorithm {
+	case PasswordHashAlgorithmArgon2id, passwordHashAlgorithmArgon2i:
+		if params["m"] <= 0 || params["m"] > maxArgon2Memory || params["t"] <= 0 || params["t"] > maxArgon2Iterations || params["p"] <= 0 || params["p"] > maxArgon2Parallelism {
+			return fmt.Errorf("invalid %s parameters", algorithm)
+		}
+	case PasswordHashAlgorithmScrypt:
+		ln, r, p := params["ln"], params["r"], params["p"]
+		if ln <= 0 || ln > 30 || r <= 0 || r > maxScryptMemory/128 || p <= 0 || p > maxScryptP || int64(128*r)<<uint(ln) > maxScryptMemory {
+			return fmt.Errorf("invalid scrypt parameters")
+		}
+	default:
+		return fmt.Errorf("unsupported password hash algorithm %s", algorithm)
+	}
+
+	return nil
+}
+
+// parsePasswordHash parses the argon2id, argon2i or scrypt PHC string
+func parsePasswordHash(hash string) (*passwordHash, error) {
+	parts := strings.Split(hash, "$")
+	isArgon2 := len(parts) > 1 && (parts[1] == PasswordHashAlgorithmArgon2id || parts[1] == passwordHashAlgorithmArgon2i)
+	// argon2 has the version part: $argon2id$v=19$m=..,t=..,p=..$salt$hash
+	if len(parts) == 6 && isArgon2 {
+		if parts[2] != fmt.Sprintf("v=%d", argon2.Version) {
+			return nil, fmt.Errorf("unsupported %s version %s", parts[1], parts[2])
+		}
+		parts = append(parts[:2], parts[3:]...)
+	}
+
+	if len(parts) != 5 || parts[0] != "" || (!isArgon2 && parts[1] != PasswordHashAlgorithmScrypt) {
+		return nil, fmt.Errorf("invalid password hash")
+	}
+
+	params := map[string]int{}
+	for _, param := range strings.Split(parts[2], ",") {
+		nameValue := strings.SplitN(param, "=", 2)
+		if len(nameValue) != 2 {
+			return nil, fmt.Errorf("invalid password hash parameter %s", param)
+		}
+		value, err := strconv.Atoi(nameValue[1])
+		if err != nil {
+			return nil, fmt.Errorf("invalid password hash parameter %s", param)
+		}
+		params[nameValue[0]] = value
+	}
+
+	salt, err := base64.RawStdEncoding.DecodeString(parts[3])
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := base64.RawStdEncoding.DecodeString(parts[4])
+	if err != nil || len(key) == 0 {
+		return nil, fmt.Errorf("invalid password hash")
+	}
+
+	if err := validatePasswordHashParams(parts[1], params, len(key)); err != nil {
+		return nil, err
+	}
+
+	return &passwordHash{
+		algorithm: parts[1],
+		params:    params,
+		salt:      salt,
+		key:       key,
+	}, nil
+}
+
+// isBcryptHash checks if hash is in bcrypt $2a$, $2b$ or $2y$ format
+func isBcryptHash(hash string) bool {
+	return strings.HasPrefix(hash, "$2a$") || strings.HasPrefix(hash, "$2b$") || strings.HasPrefix(hash, "$2y$")
+}
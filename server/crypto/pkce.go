@@ -0,0 +1,41 @@
+package crypto
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+)
+
+const (
+	// CodeChallengeMethodS256 is the PKCE code challenge method using SHA-256
+	CodeChallengeMethodS256 = "S256"
+	// CodeChallengeMethodPlain is the PKCE code challenge method using the verifier as it is
+	CodeChallengeMethodPlain = "plain"
+)
+
+// IsValidCodeChallengeMethod checks if the PKCE code challenge method is supported
+func IsValidCodeChallengeMethod(method string) bool {
+	return method == CodeChallengeMethodS256 || method == CodeChallengeMethodPlain
+}
+
+// NewS256CodeChallenge returns the S256 PKCE code challenge for the code verifier
+func NewS256CodeChallenge(codeVerifier string) string {
+	hash := sha256.Sum256([]byte(codeVerifier))
+	return base64.RawURLEncoding.EncodeToString(hash[:])
+}
+
+// VerifyCodeChallenge verifies the PKCE code verifier against the code challenge (RFC 7636)
+func VerifyCodeChallenge(codeVerifier, codeChallenge, method string) bool {
+	if codeVerifier == "" || codeChallenge == "" {
+		return false
+	}
+
+	expected := codeVerifier
+	if method == CodeChallengeMethodS256 {
+		expected = NewS256CodeChallenge(codeVerifier)
+	} else if method != CodeChallengeMethodPlain {
+		return false
+	}
+
+	return subtle.ConstantTimeCompare([]byte(expected), []byte(codeChallenge)) == 1
+}
@@ -0,0 +1,43 @@
+package crypto
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+)
+
+// NewRSAKey generates new RSA private & public key pair in PEM format
+func NewRSAKey() (string, string, error) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return "", "", err
+	}
+
+	privateKey := pem.EncodeToMemory(&pem.Block{
+		Type:  "RSA PRIVATE KEY",
+		Bytes: x509.MarshalPKCS1PrivateKey(key),
+	})
+
+	publicKey, err := encodePublicKey(&key.PublicKey)
+	if err != nil {
+		return "", "", err
+	}
+
+	return string(privateKey), publicKey, nil
+}
+
+// encodePublicKey encodes the given public key in PKIX PEM format
+func encodePublicKey(key interface{}) (string, error) {
+	keyBytes, err := x509.MarshalPKIXPublicKey(key)
+	if err != nil {
+		return "", err
+	}
+
+	publicKey := pem.EncodeToMemory(&pem.Block{
+		Type:  "PUBLIC KEY",
+		Bytes: keyBytes,
+	})
+
+	return string(publicKey), nil
+}
@@ -0,0 +1,134 @@
+package crypto
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"math/big"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	// TOTPDigits is the number of digits in TOTP code
+	TOTPDigits = 6
+	// TOTPPeriod is the time step of TOTP code
+	TOTPPeriod = 30 * time.Second
+	// totpSkew is the number of time steps accepted before & after the current one,
+	// to allow clock drift of authenticator apps
+	totpSkew = 1
+	// backupCodeCharset excludes similar looking characters
+	backupCodeCharset = "abcdefghjkmnpqrstuvwxyz23456789"
+)
+
+var totpEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// NewTOTPSecret generates new base32 encoded secret (160 bits as suggested by RFC 4226)
+func NewTOTPSecret() (string, error) {
+	secret := make([]byte, 20)
+	if _, err := io.ReadFull(rand.Reader, secret); err != nil {
+		return "", err
+	}
+
+	return totpEncoding.EncodeToString(secret), nil
+}
+
+// GetTOTPProvisioningURI returns the otpauth uri used by authenticator apps (usually as QR code)
+func GetTOTPProvisioningURI(secret, issuer, accountName string) string {
+	query := url.Values{}
+	query.Set("secret", secret)
+	query.Set("issuer", issuer)
+	query.Set("algorithm", "SHA1")
+	query.Set("digits", fmt.Sprintf("%d", TOTPDigits))
+	query.Set("period", fmt.Sprintf("%d", int(TOTPPeriod.Seconds())))
+
+	return fmt.Sprintf("otpauth://totp/%s?%s", url.PathEscape(issuer+":"+accountName), query.Encode())
+}
+
+// GenerateTOTPCode generates the TOTP code of the secret for given time (RFC 6238)
+func GenerateTOTPCode(secret string, t time.Time) (string, error) {
+	return generateHOTPCode(secret, uint64(t.Unix()/int64(TOTPPeriod.Seconds())))
+}
+
+// ValidateTOTPCode validates the code against the current time step with allowed skew.
+// It returns the matched time step, which can be used to prevent replay of the code
+func ValidateTOTPCode(secret, code string) (int64, bool) {
+	code = strings.TrimSpace(code)
+	if len(code) != TOTPDigits {
+		return 0, false
+	}
+
+	currentStep := time.Now().Unix() / int64(TOTPPeriod.Seconds())
+	for step := currentStep - totpSkew; step <= currentStep+totpSkew; step++ {
+		expected, err := generateHOTPCode(secret, uint64(step))
+		if err != nil {
+			return 0, false
+		}
+
+		if subtle.ConstantTimeCompare([]byte(expected), []byte(code)) == 1 {
+			return step, true
+		}
+	}
+
+	return 0, false
+}
+
+// generateHOTPCode generates the HOTP code of the secret for given counter (RFC 4226)
+func generateHOTPCode(secret string, counter uint64) (string, error) {
+	key, err := totpEncoding.DecodeString(strings.ToUpper(strings.TrimRight(secret, "=")))
+	if err != nil {
+		return "", err
+	}
+
+	counterBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(counterBytes, counter)
+
+	mac := hmac.New(sha1.New, key)
+	mac.Write(counterBytes)
+	sum := mac.Sum(nil)
+
+	// dynamic truncation
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+
+	mod := uint32(1)
+	for i := 0; i < TOTPDigits; i++ {
+		mod *= 10
+	}
+
+	return fmt.Sprintf("%0*d", TOTPDigits, value%mod), nil
+}
+
+// NewBackupCodes generates given number of one time backup codes of format xxxxx-xxxxx
+func NewBackupCodes(count int) ([]string, error) {
+	codes := []string{}
+	for i := 0; i < count; i++ {
+		code := make([]byte, 10)
+		for j := range code {
+			n, err := rand.Int(rand.Reader, big.NewInt(int64(len(backupCodeCharset))))
+			if err != nil {
+				return nil, err
+			}
+			code[j] = backupCodeCharset[n.Int64()]
+		}
+		codes = append(codes, string(code[:5])+"-"+string(code[5:]))
+	}
+
+	return codes, nil
+}
+
+// HashBackupCode returns sha256 hash of the normalized backup code.
+// Backup codes are random, so they don't need slow password hashing
+func HashBackupCode(code string) string {
+	normalized := strings.ToLower(strings.ReplaceAll(strings.TrimSpace(code), "-", ""))
+	hash := sha256.Sum256([]byte(normalized))
+	return hex.EncodeToString(hash[:])
+}
@@ -0,0 +1,49 @@
+package models
+
+import "github.com/authorizerdev/authorizer/server/graph/model"
+
+// AuditEvent model for db
+// It records the authentication & admin events, e.g. login, failed login, password reset or env update
+type AuditEvent struct {
+	Key        string `json:"_key,omitempty" bson:"_key,omitempty"` // for arangodb
+	ID         string `gorm:"primaryKey;type:char(36)" json:"_id" bson:"_id"`
+	ActorID    string `gorm:"index" json:"actor_id" bson:"actor_id"`
+	ActorType  string `json:"actor_type" bson:"actor_type"` // user, admin, client or anonymous
+	ActorEmail string `json:"actor_email" bson:"actor_email"`
+	Action     string `gorm:"index" json:"action" bson:"action"`
+	TargetType string `json:"target_type" bson:"target_type"`
+	TargetID   string `gorm:"index" json:"target_id" bson:"target_id"`
+	IP         string `json:"ip" bson:"ip"`
+	UserAgent  string `gorm:"type:text" json:"user_agent" bson:"user_agent"`
+	Result     string `json:"result" bson:"result"` // success or failure
+	Message    string `gorm:"type:text" json:"message" bson:"message"`
+	CreatedAt  int64  `gorm:"index" json:"created_at" bson:"created_at"`
+}
+
+// AuditEventFilter is the filter of audit events list, empty values are not filtered
+type AuditEventFilter struct {
+	Action   string
+	ActorID  string
+	TargetID string
+	Result   string
+	IP       string
+	From     int64 // created at or after, unix time
+	To       int64 // created at or before, unix time
+}
+
+func (event *AuditEvent) AsAPIAuditEvent() *model.AuditEvent {
+	return &model.AuditEvent{
+		ID:         event.ID,
+		ActorID:    &event.ActorID,
+		ActorType:  event.ActorType,
+		ActorEmail: &event.ActorEmail,
+		Action:     event.Action,
+		TargetType: &event.TargetType,
+		TargetID:   &event.TargetID,
+		IP:         &event.IP,
+		UserAgent:  &event.UserAgent,
+		Result:     event.Result,
+		Message:    &event.Message,
+		CreatedAt:  &event.CreatedAt,
+	}
+}
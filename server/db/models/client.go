@@ -0,0 +1,83 @@
+package models
+
+import (
+	"strings"
+
+	"github.com/authorizerdev/authorizer/server/graph/model"
+)
+
+// Client model for db
+// It represents the OAuth client (application) registered with authorizer
+type Client struct {
+	Key                   string `json:"_key,omitempty" bson:"_key,omitempty"` // for arangodb
+	ID                    string `gorm:"primaryKey;type:char(36)" json:"_id" bson:"_id"`
+	ClientID              string `gorm:"unique" json:"client_id" bson:"client_id"`
+	ClientSecret          string `gorm:"type:text" json:"client_secret" bson:"client_secret"` // bcrypt hash, empty for public clients
+	Name                  string `json:"name" bson:"name"`
+	RedirectURIs          string `gorm:"type:text" json:"redirect_uris" bson:"redirect_uris"`
+	GrantTypes            string `json:"grant_types" bson:"grant_types"`
+	Scopes                string `gorm:"type:text" json:"scopes" bson:"scopes"`
+	Roles                 string `json:"roles" bson:"roles"` // roles of tokens issued via client_credentials grant
+	AllowPlainPKCE        bool   `json:"allow_plain_pkce" bson:"allow_plain_pkce"`
+	Trusted               bool   `json:"trusted" bson:"trusted"`                                   // authorization codes are issued without user consent only to trusted clients
+	AccessTokenExpiresIn  int64  `json:"access_token_expires_in" bson:"access_token_expires_in"`   // in seconds, 0 means default
+	RefreshTokenExpiresIn int64  `json:"refresh_token_expires_in" bson:"refresh_token_expires_in"` // in seconds, 0 means default
+	CreatedAt             int64  `gorm:"autoCreateTime" json:"created_at" bson:"created_at"`
+	UpdatedAt             int64  `gorm:"autoUpdateTime" json:"updated_at" bson:"updated_at"`
+}
+
+// IsPublic returns true for clients without secret (e.g. mobile & single page apps)
+func (client *Client) IsPublic() bool {
+	return client.ClientSecret == ""
+}
+
+// GetRedirectURIs returns the list of allowed redirect uris
+func (client *Client) GetRedirectURIs() []string {
+	return splitList(client.RedirectURIs)
+}
+
+// GetGrantTypes returns the list of allowed grant types
+func (client *Client) GetGrantTypes() []string {
+	return splitList(client.GrantTypes)
+}
+
+// GetScopes returns the list of allowed scopes
+func (client *Client) GetScopes() []string {
+	return splitList(client.Scopes)
+}
+
+// GetRoles returns the list of roles assigned to the client
+func (client *Client) GetRoles() []string {
+	return splitList(client.Roles)
+}
+
+func (client *Client) AsAPIClient() *model.Client {
+	return &model.Client{
+		ID:                    client.ID,
+		ClientID:              client.ClientID,
+		Name:                  client.Name,
+		IsPublic:              client.IsPublic(),
+		RedirectUris:          client.GetRedirectURIs(),
+		GrantTypes:            client.GetGrantTypes(),
+		Scopes:                client.GetScopes(),
+		Roles:                 client.GetRoles(),
+		AllowPlainPkce:        client.AllowPlainPKCE,
+		Trusted:               client.Trusted,
+		AccessTokenExpiresIn:  &client.AccessTokenExpiresIn,
+		RefreshTokenExpiresIn: &client.RefreshTokenExpiresIn,
+		CreatedAt:             &client.CreatedAt,
+		UpdatedAt:             &client.UpdatedAt,
+	}
+}
+
+// splitList splits the comma separated list stored in db
+func splitList(list string) []string {
+	res := []string{}
+	for _, item := range strings.Split(list, ",") {
+		if item != "" {
+			res = append(res, item)
+		}
+	}
+
+	return res
+}
@@ -6,6 +6,12 @@ type CollectionList struct {
 	VerificationRequest string
 	Session             string
 	Env                 string
+	Client              string
+	WebauthnCredential  string
+	AuditEvent          string
+	Webhook             string
+	WebhookDelivery     string
+	OIDCProvider        string
 }
 
 var (
@@ -17,5 +23,11 @@ var (
 		VerificationRequest: Prefix + "verification_requests",
 		Session:             Prefix + "sessions",
 		Env:                 Prefix + "env",
+		Client:              Prefix + "clients",
+		WebauthnCredential:  Prefix + "webauthn_credentials",
+		AuditEvent:          Prefix + "audit_events",
+		Webhook:             Prefix + "webhooks",
+		WebhookDelivery:     Prefix + "webhook_deliveries",
+		OIDCProvider:        Prefix + "oidc_providers",
 	}
 )
@@ -0,0 +1,83 @@
+package models
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/authorizerdev/authorizer/server/constants"
+	"github.com/authorizerdev/authorizer/server/graph/model"
+)
+
+// OIDCProvider model for db
+// It represents the generic OpenID Connect identity provider (e.g. Okta, Keycloak) configured by admin.
+// Name is used in /oauth_login/:oauth_provider route and as the signup method of users
+type OIDCProvider struct {
+	Key          string `json:"_key,omitempty" bson:"_key,omitempty"` // for arangodb
+	ID           string `gorm:"primaryKey;type:char(36)" json:"_id" bson:"_id"`
+	Name         string `gorm:"unique" json:"name" bson:"name"`
+	DisplayName  string `json:"display_name" bson:"display_name"`
+	IssuerURL    string `gorm:"type:text" json:"issuer_url" bson:"issuer_url"`
+	ClientID     string `gorm:"type:text" json:"client_id" bson:"client_id"`
+	ClientSecret string `gorm:"type:text" json:"client_secret" bson:"client_secret"` // encrypted with AES
+	Scopes       string `gorm:"type:text" json:"scopes" bson:"scopes"`
+	ClaimMapping string `gorm:"type:text" json:"claim_mapping" bson:"claim_mapping"` // json object of user field to claim name
+	TrustEmail   bool   `json:"trust_email" bson:"trust_email"`                      // email is trusted as verified without email_verified claim
+	Enabled      bool   `json:"enabled" bson:"enabled"`
+	CreatedAt    int64  `gorm:"autoCreateTime" json:"created_at" bson:"created_at"`
+	UpdatedAt    int64  `gorm:"autoUpdateTime" json:"updated_at" bson:"updated_at"`
+}
+
+// GetScopes returns the scopes requested from the provider
+func (provider *OIDCProvider) GetScopes() []string {
+	scopes := splitList(provider.Scopes)
+	if len(scopes) == 0 {
+		return constants.OIDCProviderDefaultScopes
+	}
+
+	return scopes
+}
+
+// GetClaimMapping returns the claim name of each user field,
+// fields which are not mapped use the claim with same name
+func (provider *OIDCProvider) GetClaimMapping() map[string]string {
+	claimMapping := map[string]string{}
+	json.Unmarshal([]byte(provider.ClaimMapping), &claimMapping)
+
+	for _, field := range constants.OIDCProviderClaimMappingFields {
+		if strings.TrimSpace(claimMapping[field]) == "" {
+			claimMapping[field] = field
+		}
+	}
+
+	return claimMapping
+}
+
+// GetDisplayName returns the name of provider shown to users
+func (provider *OIDCProvider) GetDisplayName() string {
+	if provider.DisplayName == "" {
+		return provider.Name
+	}
+
+	return provider.DisplayName
+}
+
+func (provider *OIDCProvider) AsAPIOIDCProvider() *model.OIDCProvider {
+	claimMapping := map[string]interface{}{}
+	for field, claim := range provider.GetClaimMapping() {
+		claimMapping[field] = claim
+	}
+
+	return &model.OIDCProvider{
+		ID:           provider.ID,
+		Name:         provider.Name,
+		DisplayName:  provider.GetDisplayName(),
+		IssuerURL:    provider.IssuerURL,
+		ClientID:     provider.ClientID,
+		Scopes:       provider.GetScopes(),
+		ClaimMapping: claimMapping,
+		TrustEmail:   provider.TrustEmail,
+		Enabled:      provider.Enabled,
+		CreatedAt:    &provider.CreatedAt,
+		UpdatedAt:    &provider.UpdatedAt,
+	}
+}
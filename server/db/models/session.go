@@ -1,13 +1,29 @@
 package models
 
+import "github.com/authorizerdev/authorizer/server/graph/model"
+
 // Session model for db
+// FamilyID is the refresh token family of the session, which is kept while refreshing the session
 type Session struct {
-	Key       string `json:"_key,omitempty" bson:"_key,omitempty"` // for arangodb
-	ID        string `gorm:"primaryKey;type:char(36)" json:"_id" bson:"_id"`
-	UserID    string `gorm:"type:char(36),index:" json:"user_id" bson:"user_id"`
-	User      User   `json:"-" bson:"-"`
-	UserAgent string `json:"user_agent" bson:"user_agent"`
-	IP        string `json:"ip" bson:"ip"`
-	CreatedAt int64  `gorm:"autoCreateTime" json:"created_at" bson:"created_at"`
-	UpdatedAt int64  `gorm:"autoUpdateTime" json:"updated_at" bson:"updated_at"`
+	Key        string `json:"_key,omitempty" bson:"_key,omitempty"` // for arangodb
+	ID         string `gorm:"primaryKey;type:char(36)" json:"_id" bson:"_id"`
+	UserID     string `gorm:"type:char(36),index:" json:"user_id" bson:"user_id"`
+	User       User   `json:"-" bson:"-"`
+	FamilyID   string `gorm:"index" json:"family_id" bson:"family_id"`
+	UserAgent  string `json:"user_agent" bson:"user_agent"`
+	IP         string `json:"ip" bson:"ip"`
+	LastUsedAt int64  `json:"last_used_at" bson:"last_used_at"`
+	CreatedAt  int64  `gorm:"autoCreateTime" json:"created_at" bson:"created_at"`
+	UpdatedAt  int64  `gorm:"autoUpdateTime" json:"updated_at" bson:"updated_at"`
+}
+
+func (session *Session) AsAPISession() *model.Session {
+	return &model.Session{
+		ID:         session.ID,
+		UserID:     session.UserID,
+		UserAgent:  session.UserAgent,
+		IP:         session.IP,
+		CreatedAt:  session.CreatedAt,
+		LastUsedAt: session.LastUsedAt,
+	}
 }
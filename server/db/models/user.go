@@ -11,9 +11,11 @@ type User struct {
 	Key string `json:"_key,omitempty" bson:"_key"` // for arangodb
 	ID  string `gorm:"primaryKey;type:char(36)" json:"_id" bson:"_id"`
 
-	Email                 string  `gorm:"unique" json:"email" bson:"email"`
-	EmailVerifiedAt       *int64  `json:"email_verified_at" bson:"email_verified_at"`
-	Password              *string `gorm:"type:text" json:"password" bson:"password"`
+	Email           string  `gorm:"unique" json:"email" bson:"email"`
+	EmailVerifiedAt *int64  `json:"email_verified_at" bson:"email_verified_at"`
+	Password        *string `gorm:"type:text" json:"password" bson:"password"`
+	// PasswordHistory is the space separated hashes of previous passwords, most recent first
+	PasswordHistory       *string `gorm:"type:text" json:"password_history" bson:"password_history"`
 	SignupMethods         string  `json:"signup_methods" bson:"signup_methods"`
 	GivenName             *string `json:"given_name" bson:"given_name"`
 	FamilyName            *string `json:"family_name" bson:"family_name"`
@@ -25,13 +27,26 @@ type User struct {
 	PhoneNumberVerifiedAt *int64  `json:"phone_number_verified_at" bson:"phone_number_verified_at"`
 	Picture               *string `gorm:"type:text" json:"picture" bson:"picture"`
 	Roles                 string  `json:"roles" bson:"roles"`
-	UpdatedAt             int64   `gorm:"autoUpdateTime" json:"updated_at" bson:"updated_at"`
-	CreatedAt             int64   `gorm:"autoCreateTime" json:"created_at" bson:"created_at"`
+	// TotpSecret is the AES encrypted TOTP secret
+	TotpSecret *string `gorm:"type:text" json:"totp_secret" bson:"totp_secret"`
+	// TotpVerifiedAt is set once the enrollment is confirmed with a valid code,
+	// only then TOTP is required for login
+	TotpVerifiedAt *int64 `json:"totp_verified_at" bson:"totp_verified_at"`
+	// TotpBackupCodes is the comma separated hashes of unused backup codes
+	TotpBackupCodes *string `gorm:"type:text" json:"totp_backup_codes" bson:"totp_backup_codes"`
+	UpdatedAt       int64   `gorm:"autoUpdateTime" json:"updated_at" bson:"updated_at"`
+	CreatedAt       int64   `gorm:"autoCreateTime" json:"created_at" bson:"created_at"`
+}
+
+// IsTotpEnabled returns true if user has confirmed the TOTP enrollment
+func (user *User) IsTotpEnabled() bool {
+	return user.TotpSecret != nil && user.TotpVerifiedAt != nil
 }
 
 func (user *User) AsAPIUser() *model.User {
 	isEmailVerified := user.EmailVerifiedAt != nil
 	isPhoneVerified := user.PhoneNumberVerifiedAt != nil
+	isTotpEnabled := user.IsTotpEnabled()
 	return &model.User{
 		ID:                  user.ID,
 		Email:               user.Email,
@@ -47,6 +62,7 @@ func (user *User) AsAPIUser() *model.User {
 		PhoneNumber:         user.PhoneNumber,
 		PhoneNumberVerified: &isPhoneVerified,
 		Picture:             user.Picture,
+		TotpEnabled:         &isTotpEnabled,
 		Roles:               strings.Split(user.Roles, ","),
 		CreatedAt:           &user.CreatedAt,
 		UpdatedAt:           &user.UpdatedAt,
@@ -0,0 +1,29 @@
+package models
+
+import "github.com/authorizerdev/authorizer/server/graph/model"
+
+// WebauthnCredential model for db
+// It represents the WebAuthn credential (passkey) registered by user
+type WebauthnCredential struct {
+	Key          string `json:"_key,omitempty" bson:"_key,omitempty"` // for arangodb
+	ID           string `gorm:"primaryKey;type:char(36)" json:"_id" bson:"_id"`
+	UserID       string `gorm:"type:char(36);index" json:"user_id" bson:"user_id"`
+	CredentialID string `gorm:"unique" json:"credential_id" bson:"credential_id"` // base64url encoded
+	PublicKey    string `gorm:"type:text" json:"public_key" bson:"public_key"`    // base64url encoded COSE key
+	SignCount    int64  `json:"sign_count" bson:"sign_count"`
+	Name         string `json:"name" bson:"name"`
+	MfaEnabled   bool   `json:"mfa_enabled" bson:"mfa_enabled"` // passkey is used as second factor of password login
+	LastUsedAt   *int64 `json:"last_used_at" bson:"last_used_at"`
+	CreatedAt    int64  `gorm:"autoCreateTime" json:"created_at" bson:"created_at"`
+	UpdatedAt    int64  `gorm:"autoUpdateTime" json:"updated_at" bson:"updated_at"`
+}
+
+func (credential *WebauthnCredential) AsAPIWebauthnCredential() *model.WebauthnCredential {
+	return &model.WebauthnCredential{
+		ID:         credential.ID,
+		Name:       credential.Name,
+		MfaEnabled: credential.MfaEnabled,
+		LastUsedAt: credential.LastUsedAt,
+		CreatedAt:  &credential.CreatedAt,
+	}
+}
@@ -0,0 +1,27 @@
+package models
+
+import "github.com/authorizerdev/authorizer/server/graph/model"
+
+// Webhook model for db
+// It represents the endpoint registered for an event, e.g. user.signup
+type Webhook struct {
+	Key       string `json:"_key,omitempty" bson:"_key,omitempty"` // for arangodb
+	ID        string `gorm:"primaryKey;type:char(36)" json:"_id" bson:"_id"`
+	EventName string `gorm:"index" json:"event_name" bson:"event_name"`
+	Endpoint  string `gorm:"type:text" json:"endpoint" bson:"endpoint"`
+	Secret    string `gorm:"type:text" json:"secret" bson:"secret"` // key of HMAC signature of the deliveries, encrypted with AES
+	Enabled   bool   `json:"enabled" bson:"enabled"`
+	CreatedAt int64  `gorm:"autoCreateTime" json:"created_at" bson:"created_at"`
+	UpdatedAt int64  `gorm:"autoUpdateTime" json:"updated_at" bson:"updated_at"`
+}
+
+func (webhook *Webhook) AsAPIWebhook() *model.Webhook {
+	return &model.Webhook{
+		ID:        webhook.ID,
+		EventName: webhook.EventName,
+		Endpoint:  webhook.Endpoint,
+		Enabled:   webhook.Enabled,
+		CreatedAt: &webhook.CreatedAt,
+		UpdatedAt: &webhook.UpdatedAt,
+	}
+}
@@ -0,0 +1,40 @@
+package models
+
+import "github.com/authorizerdev/authorizer/server/graph/model"
+
+// WebhookDelivery model for db
+// It is the delivery of event payload to the webhook endpoint, retried until it succeeds or attempts are exhausted
+type WebhookDelivery struct {
+	Key            string `json:"_key,omitempty" bson:"_key,omitempty"` // for arangodb
+	ID             string `gorm:"primaryKey;type:char(36)" json:"_id" bson:"_id"`
+	WebhookID      string `gorm:"index" json:"webhook_id" bson:"webhook_id"`
+	EventName      string `json:"event_name" bson:"event_name"`
+	Endpoint       string `gorm:"type:text" json:"endpoint" bson:"endpoint"`
+	Payload        string `gorm:"type:text" json:"payload" bson:"payload"`
+	Status         string `gorm:"index" json:"status" bson:"status"` // pending, success or failed
+	Attempts       int64  `json:"attempts" bson:"attempts"`
+	ResponseStatus int64  `json:"response_status" bson:"response_status"`
+	ResponseBody   string `gorm:"type:text" json:"response_body" bson:"response_body"`
+	Error          string `gorm:"type:text" json:"error" bson:"error"`
+	NextAttemptAt  int64  `gorm:"index" json:"next_attempt_at" bson:"next_attempt_at"`
+	CreatedAt      int64  `gorm:"autoCreateTime" json:"created_at" bson:"created_at"`
+	UpdatedAt      int64  `gorm:"autoUpdateTime" json:"updated_at" bson:"updated_at"`
+}
+
+func (delivery *WebhookDelivery) AsAPIWebhookDelivery() *model.WebhookDelivery {
+	return &model.WebhookDelivery{
+		ID:             delivery.ID,
+		WebhookID:      delivery.WebhookID,
+		EventName:      delivery.EventName,
+		Endpoint:       delivery.Endpoint,
+		Payload:        delivery.Payload,
+		Status:         delivery.Status,
+		Attempts:       delivery.Attempts,
+		ResponseStatus: &delivery.ResponseStatus,
+		ResponseBody:   &delivery.ResponseBody,
+		Error:          &delivery.Error,
+		NextAttemptAt:  &delivery.NextAttemptAt,
+		CreatedAt:      &delivery.CreatedAt,
+		UpdatedAt:      &delivery.UpdatedAt,
+	}
+}
@@ -106,6 +106,9 @@ func NewProvider() (*provider, error) {
 	sessionCollection.EnsureHashIndex(ctx, []string{"user_id"}, &arangoDriver.EnsureHashIndexOptions{
 		Sparse: true,
 	})
+	sessionCollection.EnsureHashIndex(ctx, []string{"family_id"}, &arangoDriver.EnsureHashIndexOptions{
+		Sparse: true,
+	})
 
 	configCollectionExists, err := arangodb.CollectionExists(ctx, models.Collections.Env)
 	if configCollectionExists {
@@ -117,6 +120,107 @@ func NewProvider() (*provider, error) {
 		}
 	}
 
+	clientCollectionExists, err := arangodb.CollectionExists(ctx, models.Collections.Client)
+	if clientCollectionExists {
+		log.Println(models.Collections.Client + " collection exists already")
+	} else {
+		_, err = arangodb.CreateCollection(ctx, models.Collections.Client, nil)
+		if err != nil {
+			log.Println("error creating collection("+models.Collections.Client+"):", err)
+		}
+	}
+
+	clientCollection, _ := arangodb.Collection(nil, models.Collections.Client)
+	clientCollection.EnsureHashIndex(ctx, []string{"client_id"}, &arangoDriver.EnsureHashIndexOptions{
+		Unique: true,
+		Sparse: true,
+	})
+
+	webauthnCredentialCollectionExists, err := arangodb.CollectionExists(ctx, models.Collections.WebauthnCredential)
+	if webauthnCredentialCollectionExists {
+		log.Println(models.Collections.WebauthnCredential + " collection exists already")
+	} else {
+		_, err = arangodb.CreateCollection(ctx, models.Collections.WebauthnCredential, nil)
+		if err != nil {
+			log.Println("error creating collection("+models.Collections.WebauthnCredential+"):", err)
+		}
+	}
+
+	webauthnCredentialCollection, _ := arangodb.Collection(nil, models.Collections.WebauthnCredential)
+	webauthnCredentialCollection.EnsureHashIndex(ctx, []string{"credential_id"}, &arangoDriver.EnsureHashIndexOptions{
+		Unique: true,
+		Sparse: true,
+	})
+	webauthnCredentialCollection.EnsureHashIndex(ctx, []string{"user_id"}, &arangoDriver.EnsureHashIndexOptions{
+		Sparse: true,
+	})
+
+	auditEventCollectionExists, err := arangodb.CollectionExists(ctx, models.Collections.AuditEvent)
+	if auditEventCollectionExists {
+		log.Println(models.Collections.AuditEvent + " collection exists already")
+	} else {
+		_, err = arangodb.CreateCollection(ctx, models.Collections.AuditEvent, nil)
+		if err != nil {
+			log.Println("error creating collection("+models.Collections.AuditEvent+"):", err)
+		}
+	}
+
+	auditEventCollection, _ := arangodb.Collection(nil, models.Collections.AuditEvent)
+	auditEventCollection.EnsureSkipListIndex(ctx, []string{"created_at"}, &arangoDriver.EnsureSkipListIndexOptions{})
+	auditEventCollection.EnsureHashIndex(ctx, []string{"actor_id"}, &arangoDriver.EnsureHashIndexOptions{
+		Sparse: true,
+	})
+	auditEventCollection.EnsureHashIndex(ctx, []string{"target_id"}, &arangoDriver.EnsureHashIndexOptions{
+		Sparse: true,
+	})
+
+	webhookCollectionExists, err := arangodb.CollectionExists(ctx, models.Collections.Webhook)
+	if webhookCollectionExists {
+		log.Println(models.Collections.Webhook + " collection exists already")
+	} else {
+		_, err = arangodb.CreateCollection(ctx, models.Collections.Webhook, nil)
+		if err != nil {
+			log.Println("error creating collection("+models.Collections.Webhook+"):", err)
+		}
+	}
+
+	webhookCollection, _ := arangodb.Collection(nil, models.Collections.Webhook)
+	webhookCollection.EnsureHashIndex(ctx, []string{"event_name"}, &arangoDriver.EnsureHashIndexOptions{
+		Sparse: true,
+	})
+
+	webhookDeliveryCollectionExists, err := arangodb.CollectionExists(ctx, models.Collections.WebhookDelivery)
+	if webhookDeliveryCollectionExists {
+		log.Println(models.Collections.WebhookDelivery + " collection exists already")
+	} else {
+		_, err = arangodb.CreateCollection(ctx, models.Collections.WebhookDelivery, nil)
+		if err != nil {
+			log.Println("error creating collection("+models.Collections.WebhookDelivery+"):", err)
+		}
+	}
+
+	webhookDeliveryCollection, _ := arangodb.Collection(nil, models.Collections.WebhookDelivery)
+	webhookDeliveryCollection.EnsureHashIndex(ctx, []string{"webhook_id"}, &arangoDriver.EnsureHashIndexOptions{
+		Sparse: true,
+	})
+	webhookDeliveryCollection.EnsureSkipListIndex(ctx, []string{"status", "next_attempt_at"}, &arangoDriver.EnsureSkipListIndexOptions{})
+
+	oidcProviderCollectionExists, err := arangodb.CollectionExists(ctx, models.Collections.OIDCProvider)
+	if oidcProviderCollectionExists {
+		log.Println(models.Collections.OIDCProvider + " collection exists already")
+	} else {
+		_, err = arangodb.CreateCollection(ctx, models.Collections.OIDCProvider, nil)
+		if err != nil {
+			log.Println("error creating collection("+models.Collections.OIDCProvider+"):", err)
+		}
+	}
+
+	oidcProviderCollection, _ := arangodb.Collection(nil, models.Collections.OIDCProvider)
+	oidcProviderCollection.EnsureHashIndex(ctx, []string{"name"}, &arangoDriver.EnsureHashIndexOptions{
+		Unique: true,
+		Sparse: true,
+	})
+
 	return &provider{
 		db: arangodb,
 	}, err
@@ -0,0 +1,113 @@
+package arangodb
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/arangodb/go-driver"
+	arangoDriver "github.com/arangodb/go-driver"
+	"github.com/authorizerdev/authorizer/server/db/models"
+	"github.com/authorizerdev/authorizer/server/graph/model"
+	"github.com/google/uuid"
+)
+
+// AddAuditEvent to save audit event in database
+func (p *provider) AddAuditEvent(event models.AuditEvent) (models.AuditEvent, error) {
+	if event.ID == "" {
+		event.ID = uuid.New().String()
+	}
+
+	if event.CreatedAt == 0 {
+		event.CreatedAt = time.Now().Unix()
+	}
+
+	auditEventCollection, _ := p.db.Collection(nil, models.Collections.AuditEvent)
+	meta, err := auditEventCollection.CreateDocument(nil, event)
+	if err != nil {
+		log.Println("error adding audit event:", err)
+		return event, err
+	}
+	event.Key = meta.Key
+	event.ID = meta.ID.String()
+
+	return event, nil
+}
+
+// ListAuditEvents to get list of audit events matching the filter from database
+func (p *provider) ListAuditEvents(pagination model.Pagination, filter models.AuditEventFilter) (*model.AuditLogs, error) {
+	events := []*model.AuditEvent{}
+	ctx := driver.WithQueryFullCount(context.Background())
+
+	filters := []string{}
+	bindVars := map[string]interface{}{}
+	for key, value := range map[string]string{
+		"action":    filter.Action,
+		"actor_id":  filter.ActorID,
+		"target_id": filter.TargetID,
+		"result":    filter.Result,
+		"ip":        filter.IP,
+	} {
+		if value != "" {
+			filters = append(filters, fmt.Sprintf("FILTER d.%s == @%s", key, key))
+			bindVars[key] = value
+		}
+	}
+	if filter.From > 0 {
+		filters = append(filters, "FILTER d.created_at >= @from")
+		bindVars["from"] = filter.From
+	}
+	if filter.To > 0 {
+		filters = append(filters, "FILTER d.created_at <= @to")
+		bindVars["to"] = filter.To
+	}
+
+	query := fmt.Sprintf("FOR d in %s %s SORT d.created_at DESC LIMIT %d, %d RETURN d", models.Collections.AuditEvent, strings.Join(filters, " "), pagination.Offset, pagination.Limit)
+
+	cursor, err := p.db.Query(ctx, query, bindVars)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close()
+
+	paginationClone := pagination
+	paginationClone.Total = cursor.Statistics().FullCount()
+
+	for {
+		var event models.AuditEvent
+		meta, err := cursor.ReadDocument(nil, &event)
+
+		if arangoDriver.IsNoMoreDocuments(err) {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+
+		if meta.Key != "" {
+			events = append(events, event.AsAPIAuditEvent())
+		}
+	}
+
+	return &model.AuditLogs{
+		Pagination:  &paginationClone,
+		AuditEvents: events,
+	}, nil
+}
+
+// DeleteAuditEventsBefore to delete audit events created before the given unix time from database
+func (p *provider) DeleteAuditEventsBefore(createdAt int64) error {
+	query := fmt.Sprintf(`FOR d IN %s FILTER d.created_at < @createdAt REMOVE { _key: d._key } IN %s`, models.Collections.AuditEvent, models.Collections.AuditEvent)
+	bindVars := map[string]interface{}{
+		"createdAt": createdAt,
+	}
+	cursor, err := p.db.Query(nil, query, bindVars)
+	if err != nil {
+		log.Println("error deleting audit events:", err)
+		return err
+	}
+	defer cursor.Close()
+
+	return nil
+}
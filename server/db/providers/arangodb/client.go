@@ -0,0 +1,129 @@
+package arangodb
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/arangodb/go-driver"
+	arangoDriver "github.com/arangodb/go-driver"
+	"github.com/authorizerdev/authorizer/server/db/models"
+	"github.com/authorizerdev/authorizer/server/graph/model"
+	"github.com/google/uuid"
+)
+
+// AddClient to save oauth client information in database
+func (p *provider) AddClient(client models.Client) (models.Client, error) {
+	if client.ID == "" {
+		client.ID = uuid.New().String()
+	}
+
+	client.CreatedAt = time.Now().Unix()
+	client.UpdatedAt = time.Now().Unix()
+	clientCollection, _ := p.db.Collection(nil, models.Collections.Client)
+	meta, err := clientCollection.CreateDocument(nil, client)
+	if err != nil {
+		log.Println("error adding client:", err)
+		return client, err
+	}
+	client.Key = meta.Key
+	client.ID = meta.ID.String()
+
+	return client, nil
+}
+
+// UpdateClient to update oauth client information in database
+func (p *provider) UpdateClient(client models.Client) (models.Client, error) {
+	client.UpdatedAt = time.Now().Unix()
+	collection, _ := p.db.Collection(nil, models.Collections.Client)
+	meta, err := collection.UpdateDocument(nil, client.Key, client)
+	if err != nil {
+		log.Println("error updating client:", err)
+		return client, err
+	}
+
+	client.Key = meta.Key
+	client.ID = meta.ID.String()
+	return client, nil
+}
+
+// DeleteClient to delete oauth client information from database
+func (p *provider) DeleteClient(client models.Client) error {
+	collection, _ := p.db.Collection(nil, models.Collections.Client)
+	_, err := collection.RemoveDocument(nil, client.Key)
+	if err != nil {
+		log.Println("error deleting client:", err)
+		return err
+	}
+
+	return nil
+}
+
+// ListClients to get list of oauth clients from database
+func (p *provider) ListClients(pagination model.Pagination) (*model.Clients, error) {
+	clients := []*model.Client{}
+	ctx := driver.WithQueryFullCount(context.Background())
+
+	query := fmt.Sprintf("FOR d in %s SORT d.created_at DESC LIMIT %d, %d RETURN d", models.Collections.Client, pagination.Offset, pagination.Limit)
+
+	cursor, err := p.db.Query(ctx, query, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close()
+
+	paginationClone := pagination
+	paginationClone.Total = cursor.Statistics().FullCount()
+
+	for {
+		var client models.Client
+		meta, err := cursor.ReadDocument(nil, &client)
+
+		if arangoDriver.IsNoMoreDocuments(err) {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+
+		if meta.Key != "" {
+			clients = append(clients, client.AsAPIClient())
+		}
+	}
+
+	return &model.Clients{
+		Pagination: &paginationClone,
+		Clients:    clients,
+	}, nil
+}
+
+// GetClientByClientID to get oauth client information from database using client id
+func (p *provider) GetClientByClientID(clientID string) (models.Client, error) {
+	var client models.Client
+
+	query := fmt.Sprintf("FOR d in %s FILTER d.client_id == @client_id LIMIT 1 RETURN d", models.Collections.Client)
+	bindVars := map[string]interface{}{
+		"client_id": clientID,
+	}
+
+	cursor, err := p.db.Query(nil, query, bindVars)
+	if err != nil {
+		return client, err
+	}
+	defer cursor.Close()
+
+	for {
+		if !cursor.HasMore() {
+			if client.Key == "" {
+				return client, fmt.Errorf("client not found")
+			}
+			break
+		}
+		_, err := cursor.ReadDocument(nil, &client)
+		if err != nil {
+			return client, err
+		}
+	}
+
+	return client, nil
+}
@@ -0,0 +1,174 @@
+package arangodb
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/arangodb/go-driver"
+	arangoDriver "github.com/arangodb/go-driver"
+	"github.com/authorizerdev/authorizer/server/db/models"
+	"github.com/authorizerdev/authorizer/server/graph/model"
+	"github.com/google/uuid"
+)
+
+// AddOIDCProvider to save oidc provider information in database
+func (p *provider) AddOIDCProvider(oidcProvider models.OIDCProvider) (models.OIDCProvider, error) {
+	if oidcProvider.ID == "" {
+		oidcProvider.ID = uuid.New().String()
+	}
+
+	oidcProvider.CreatedAt = time.Now().Unix()
+	oidcProvider.UpdatedAt = time.Now().Unix()
+	oidcProviderCollection, _ := p.db.Collection(nil, models.Collections.OIDCProvider)
+	meta, err := oidcProviderCollection.CreateDocument(nil, oidcProvider)
+	if err != nil {
+		log.Println("error adding oidc provider:", err)
+		return oidcProvider, err
+	}
+	oidcProvider.Key = meta.Key
+	oidcProvider.ID = meta.ID.String()
+
+	return oidcProvider, nil
+}
+
+// UpdateOIDCProvider to update oidc provider information in database
+func (p *provider) UpdateOIDCProvider(oidcProvider models.OIDCProvider) (models.OIDCProvider, error) {
+	oidcProvider.UpdatedAt = time.Now().Unix()
+	collection, _ := p.db.Collection(nil, models.Collections.OIDCProvider)
+	meta, err := collection.UpdateDocument(nil, oidcProvider.Key, oidcProvider)
+	if err != nil {
+		log.Println("error updating oidc provider:", err)
+		return oidcProvider, err
+	}
+
+	oidcProvider.Key = meta.Key
+	oidcProvider.ID = meta.ID.String()
+	return oidcProvider, nil
+}
+
+// DeleteOIDCProvider to delete oidc provider information from database
+func (p *provider) DeleteOIDCProvider(oidcProvider models.OIDCProvider) error {
+	collection, _ := p.db.Collection(nil, models.Collections.OIDCProvider)
+	_, err := collection.RemoveDocument(nil, oidcProvider.Key)
+	if err != nil {
+		log.Println("error deleting oidc provider:", err)
+		return err
+	}
+
+	return nil
+}
+
+// ListOIDCProviders to get list of oidc providers from database
+func (p *provider) ListOIDCProviders(pagination model.Pagination) (*model.OIDCProviders, error) {
+	oidcProviders := []*model.OIDCProvider{}
+	ctx := driver.WithQueryFullCount(context.Background())
+
+	query := fmt.Sprintf("FOR d in %s SORT d.created_at DESC LIMIT %d, %d RETURN d", models.Collections.OIDCProvider, pagination.Offset, pagination.Limit)
+
+	cursor, err := p.db.Query(ctx, query, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close()
+
+	paginationClone := pagination
+	paginationClone.Total = cursor.Statistics().FullCount()
+
+	for {
+		var oidcProvider models.OIDCProvider
+		meta, err := cursor.ReadDocument(nil, &oidcProvider)
+
+		if arangoDriver.IsNoMoreDocuments(err) {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+
+		if meta.Key != "" {
+			oidcProviders = append(oidcProviders, oidcProvider.AsAPIOIDCProvider())
+		}
+	}
+
+	return &model.OIDCProviders{
+		Pagination:    &paginationClone,
+		OidcProviders: oidcProviders,
+	}, nil
+}
+
+// ListEnabledOIDCProviders to get list of enabled oidc providers from database
+func (p *provider) ListEnabledOIDCProviders() ([]models.OIDCProvider, error) {
+	oidcProviders := []models.OIDCProvider{}
+
+	query := fmt.Sprintf("FOR d in %s FILTER d.enabled == true SORT d.created_at ASC RETURN d", models.Collections.OIDCProvider)
+
+	cursor, err := p.db.Query(nil, query, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close()
+
+	for {
+		var oidcProvider models.OIDCProvider
+		meta, err := cursor.ReadDocument(nil, &oidcProvider)
+
+		if arangoDriver.IsNoMoreDocuments(err) {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+
+		if meta.Key != "" {
+			oidcProviders = append(oidcProviders, oidcProvider)
+		}
+	}
+
+	return oidcProviders, nil
+}
+
+// GetOIDCProviderByID to get oidc provider information from database using id
+func (p *provider) GetOIDCProviderByID(id string) (models.OIDCProvider, error) {
+	query := fmt.Sprintf("FOR d in %s FILTER d._id == @id LIMIT 1 RETURN d", models.Collections.OIDCProvider)
+	bindVars := map[string]interface{}{
+		"id": id,
+	}
+
+	return p.getOIDCProvider(query, bindVars)
+}
+
+// GetOIDCProviderByName to get oidc provider information from database using name
+func (p *provider) GetOIDCProviderByName(name string) (models.OIDCProvider, error) {
+	query := fmt.Sprintf("FOR d in %s FILTER d.name == @name LIMIT 1 RETURN d", models.Collections.OIDCProvider)
+	bindVars := map[string]interface{}{
+		"name": name,
+	}
+
+	return p.getOIDCProvider(query, bindVars)
+}
+
+// getOIDCProvider to get single oidc provider matching the query from database
+func (p *provider) getOIDCProvider(query string, bindVars map[string]interface{}) (models.OIDCProvider, error) {
+	var oidcProvider models.OIDCProvider
+
+	cursor, err := p.db.Query(nil, query, bindVars)
+	if err != nil {
+		return oidcProvider, err
+	}
+	defer cursor.Close()
+
+	for {
+		if !cursor.HasMore() {
+			if oidcProvider.Key == "" {
+				return oidcProvider, fmt.Errorf("oidc provider not found")
+			}
+			break
+		}
+		_, err := cursor.ReadDocument(nil, &oidcProvider)
+		if err != nil {
+			return oidcProvider, err
+		}
+	}
+
+	return oidcProvider, nil
+}
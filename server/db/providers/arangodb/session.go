@@ -5,6 +5,7 @@ import (
 	"log"
 	"time"
 
+	arangoDriver "github.com/arangodb/go-driver"
 	"github.com/authorizerdev/authorizer/server/db/models"
 	"github.com/google/uuid"
 )
@@ -40,3 +41,108 @@ func (p *provider) DeleteSession(userId string) error {
 	defer cursor.Close()
 	return nil
 }
+
+// UpdateSession to update session information in database
+func (p *provider) UpdateSession(session models.Session) (models.Session, error) {
+	session.UpdatedAt = time.Now().Unix()
+	collection, _ := p.db.Collection(nil, models.Collections.Session)
+	meta, err := collection.UpdateDocument(nil, session.Key, session)
+	if err != nil {
+		log.Println("error updating session:", err)
+		return session, err
+	}
+
+	session.Key = meta.Key
+	session.ID = meta.ID.String()
+	return session, nil
+}
+
+// DeleteSessionByID to delete single session information from database
+func (p *provider) DeleteSessionByID(sessionID string) error {
+	query := fmt.Sprintf(`FOR d IN %s FILTER d._id == @id REMOVE { _key: d._key } IN %s`, models.Collections.Session, models.Collections.Session)
+	bindVars := map[string]interface{}{
+		"id": sessionID,
+	}
+	cursor, err := p.db.Query(nil, query, bindVars)
+	if err != nil {
+		log.Println("=> error deleting arangodb session:", err)
+		return err
+	}
+	defer cursor.Close()
+	return nil
+}
+
+// ListSessionsByUserID to get list of sessions of user from database
+func (p *provider) ListSessionsByUserID(userID string) ([]models.Session, error) {
+	sessions := []models.Session{}
+
+	query := fmt.Sprintf("FOR d in %s FILTER d.user_id == @user_id SORT d.created_at DESC RETURN d", models.Collections.Session)
+	bindVars := map[string]interface{}{
+		"user_id": userID,
+	}
+
+	cursor, err := p.db.Query(nil, query, bindVars)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close()
+
+	for {
+		var session models.Session
+		meta, err := cursor.ReadDocument(nil, &session)
+
+		if arangoDriver.IsNoMoreDocuments(err) {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+
+		if meta.Key != "" {
+			sessions = append(sessions, session)
+		}
+	}
+
+	return sessions, nil
+}
+
+// GetSessionByID to get session information from database using session id
+func (p *provider) GetSessionByID(sessionID string) (models.Session, error) {
+	query := fmt.Sprintf("FOR d in %s FILTER d._id == @id LIMIT 1 RETURN d", models.Collections.Session)
+	return p.getSession(query, map[string]interface{}{
+		"id": sessionID,
+	})
+}
+
+// GetSessionByFamilyID to get session information from database using refresh token family id
+func (p *provider) GetSessionByFamilyID(familyID string) (models.Session, error) {
+	query := fmt.Sprintf("FOR d in %s FILTER d.family_id == @family_id SORT d.created_at DESC LIMIT 1 RETURN d", models.Collections.Session)
+	return p.getSession(query, map[string]interface{}{
+		"family_id": familyID,
+	})
+}
+
+// getSession returns the first session matching the query
+func (p *provider) getSession(query string, bindVars map[string]interface{}) (models.Session, error) {
+	var session models.Session
+
+	cursor, err := p.db.Query(nil, query, bindVars)
+	if err != nil {
+		return session, err
+	}
+	defer cursor.Close()
+
+	for {
+		if !cursor.HasMore() {
+			if session.Key == "" {
+				return session, fmt.Errorf("session not found")
+			}
+			break
+		}
+		_, err := cursor.ReadDocument(nil, &session)
+		if err != nil {
+			return session, err
+		}
+	}
+
+	return session, nil
+}
@@ -55,6 +55,30 @@ func (p *provider) UpdateUser(user models.User) (models.User, error) {
 	return user, nil
 }
 
+// UpdateUserTotpBackupCodes to update totp backup codes of user in database, only if they are not changed since the user was read.
+// It returns false if they were changed, e.g. the same backup code was used by parallel request
+func (p *provider) UpdateUserTotpBackupCodes(user models.User, backupCodes string) (bool, error) {
+	if user.TotpBackupCodes == nil {
+		return false, nil
+	}
+
+	query := fmt.Sprintf(`FOR d IN %s FILTER d._key == @key AND d.totp_backup_codes == @currentBackupCodes UPDATE d WITH { totp_backup_codes: @backupCodes, updated_at: @updatedAt } IN %s RETURN NEW._key`, models.Collections.User, models.Collections.User)
+	bindVars := map[string]interface{}{
+		"key":                user.Key,
+		"currentBackupCodes": *user.TotpBackupCodes,
+		"backupCodes":        backupCodes,
+		"updatedAt":          time.Now().Unix(),
+	}
+	cursor, err := p.db.Query(nil, query, bindVars)
+	if err != nil {
+		log.Println("error updating user totp backup codes:", err)
+		return false, err
+	}
+	defer cursor.Close()
+
+	return cursor.HasMore(), nil
+}
+
 // DeleteUser to delete user information from database
 func (p *provider) DeleteUser(user models.User) error {
 	collection, _ := p.db.Collection(nil, models.Collections.User)
@@ -135,6 +159,37 @@ func (p *provider) GetUserByEmail(email string) (models.User, error) {
 	return user, nil
 }
 
+// GetUserByVerifiedPhoneNumber to get information of the user who has verified the phone number
+func (p *provider) GetUserByVerifiedPhoneNumber(phoneNumber string) (models.User, error) {
+	var user models.User
+
+	query := fmt.Sprintf("FOR d in %s FILTER d.phone_number == @phone_number AND d.phone_number_verified_at != null LIMIT 1 RETURN d", models.Collections.User)
+	bindVars := map[string]interface{}{
+		"phone_number": phoneNumber,
+	}
+
+	cursor, err := p.db.Query(nil, query, bindVars)
+	if err != nil {
+		return user, err
+	}
+	defer cursor.Close()
+
+	for {
+		if !cursor.HasMore() {
+			if user.Key == "" {
+				return user, fmt.Errorf("user not found")
+			}
+			break
+		}
+		_, err := cursor.ReadDocument(nil, &user)
+		if err != nil {
+			return user, err
+		}
+	}
+
+	return user, nil
+}
+
 // GetUserByID to get user information from database using user ID
 func (p *provider) GetUserByID(id string) (models.User, error) {
 	var user models.User
@@ -0,0 +1,122 @@
+package arangodb
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	arangoDriver "github.com/arangodb/go-driver"
+	"github.com/authorizerdev/authorizer/server/db/models"
+	"github.com/google/uuid"
+)
+
+// AddWebauthnCredential to save webauthn credential information in database
+func (p *provider) AddWebauthnCredential(credential models.WebauthnCredential) (models.WebauthnCredential, error) {
+	if credential.ID == "" {
+		credential.ID = uuid.New().String()
+	}
+
+	credential.CreatedAt = time.Now().Unix()
+	credential.UpdatedAt = time.Now().Unix()
+	credentialCollection, _ := p.db.Collection(nil, models.Collections.WebauthnCredential)
+	meta, err := credentialCollection.CreateDocument(nil, credential)
+	if err != nil {
+		log.Println("error adding webauthn credential:", err)
+		return credential, err
+	}
+	credential.Key = meta.Key
+	credential.ID = meta.ID.String()
+
+	return credential, nil
+}
+
+// UpdateWebauthnCredential to update webauthn credential information in database
+func (p *provider) UpdateWebauthnCredential(credential models.WebauthnCredential) (models.WebauthnCredential, error) {
+	credential.UpdatedAt = time.Now().Unix()
+	collection, _ := p.db.Collection(nil, models.Collections.WebauthnCredential)
+	meta, err := collection.UpdateDocument(nil, credential.Key, credential)
+	if err != nil {
+		log.Println("error updating webauthn credential:", err)
+		return credential, err
+	}
+
+	credential.Key = meta.Key
+	credential.ID = meta.ID.String()
+	return credential, nil
+}
+
+// DeleteWebauthnCredential to delete webauthn credential information from database
+func (p *provider) DeleteWebauthnCredential(credential models.WebauthnCredential) error {
+	collection, _ := p.db.Collection(nil, models.Collections.WebauthnCredential)
+	_, err := collection.RemoveDocument(nil, credential.Key)
+	if err != nil {
+		log.Println("error deleting webauthn credential:", err)
+		return err
+	}
+
+	return nil
+}
+
+// ListWebauthnCredentialsByUserID to get list of webauthn credentials of user from database
+func (p *provider) ListWebauthnCredentialsByUserID(userID string) ([]models.WebauthnCredential, error) {
+	credentials := []models.WebauthnCredential{}
+
+	query := fmt.Sprintf("FOR d in %s FILTER d.user_id == @user_id SORT d.created_at DESC RETURN d", models.Collections.WebauthnCredential)
+	bindVars := map[string]interface{}{
+		"user_id": userID,
+	}
+
+	cursor, err := p.db.Query(nil, query, bindVars)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close()
+
+	for {
+		var credential models.WebauthnCredential
+		meta, err := cursor.ReadDocument(nil, &credential)
+
+		if arangoDriver.IsNoMoreDocuments(err) {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+
+		if meta.Key != "" {
+			credentials = append(credentials, credential)
+		}
+	}
+
+	return credentials, nil
+}
+
+// GetWebauthnCredentialByCredentialID to get webauthn credential information from database using credential id
+func (p *provider) GetWebauthnCredentialByCredentialID(credentialID string) (models.WebauthnCredential, error) {
+	var credential models.WebauthnCredential
+
+	query := fmt.Sprintf("FOR d in %s FILTER d.credential_id == @credential_id LIMIT 1 RETURN d", models.Collections.WebauthnCredential)
+	bindVars := map[string]interface{}{
+		"credential_id": credentialID,
+	}
+
+	cursor, err := p.db.Query(nil, query, bindVars)
+	if err != nil {
+		return credential, err
+	}
+	defer cursor.Close()
+
+	for {
+		if !cursor.HasMore() {
+			if credential.Key == "" {
+				return credential, fmt.Errorf("webauthn credential not found")
+			}
+			break
+		}
+		_, err := cursor.ReadDocument(nil, &credential)
+		if err != nil {
+			return credential, err
+		}
+	}
+
+	return credential, nil
+}
@@ -0,0 +1,162 @@
+package arangodb
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/arangodb/go-driver"
+	arangoDriver "github.com/arangodb/go-driver"
+	"github.com/authorizerdev/authorizer/server/db/models"
+	"github.com/authorizerdev/authorizer/server/graph/model"
+	"github.com/google/uuid"
+)
+
+// AddWebhook to save webhook information in database
+func (p *provider) AddWebhook(webhook models.Webhook) (models.Webhook, error) {
+	if webhook.ID == "" {
+		webhook.ID = uuid.New().String()
+	}
+
+	webhook.CreatedAt = time.Now().Unix()
+	webhook.UpdatedAt = time.Now().Unix()
+	webhookCollection, _ := p.db.Collection(nil, models.Collections.Webhook)
+	meta, err := webhookCollection.CreateDocument(nil, webhook)
+	if err != nil {
+		log.Println("error adding webhook:", err)
+		return webhook, err
+	}
+	webhook.Key = meta.Key
+	webhook.ID = meta.ID.String()
+
+	return webhook, nil
+}
+
+// UpdateWebhook to update webhook information in database
+func (p *provider) UpdateWebhook(webhook models.Webhook) (models.Webhook, error) {
+	webhook.UpdatedAt = time.Now().Unix()
+	collection, _ := p.db.Collection(nil, models.Collections.Webhook)
+	meta, err := collection.UpdateDocument(nil, webhook.Key, webhook)
+	if err != nil {
+		log.Println("error updating webhook:", err)
+		return webhook, err
+	}
+
+	webhook.Key = meta.Key
+	webhook.ID = meta.ID.String()
+	return webhook, nil
+}
+
+// DeleteWebhook to delete webhook information from database
+func (p *provider) DeleteWebhook(webhook models.Webhook) error {
+	collection, _ := p.db.Collection(nil, models.Collections.Webhook)
+	_, err := collection.RemoveDocument(nil, webhook.Key)
+	if err != nil {
+		log.Println("error deleting webhook:", err)
+		return err
+	}
+
+	return nil
+}
+
+// ListWebhooks to get list of webhooks from database
+func (p *provider) ListWebhooks(pagination model.Pagination) (*model.Webhooks, error) {
+	webhooks := []*model.Webhook{}
+	ctx := driver.WithQueryFullCount(context.Background())
+
+	query := fmt.Sprintf("FOR d in %s SORT d.created_at DESC LIMIT %d, %d RETURN d", models.Collections.Webhook, pagination.Offset, pagination.Limit)
+
+	cursor, err := p.db.Query(ctx, query, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close()
+
+	paginationClone := pagination
+	paginationClone.Total = cursor.Statistics().FullCount()
+
+	for {
+		var webhook models.Webhook
+		meta, err := cursor.ReadDocument(nil, &webhook)
+
+		if arangoDriver.IsNoMoreDocuments(err) {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+
+		if meta.Key != "" {
+			webhooks = append(webhooks, webhook.AsAPIWebhook())
+		}
+	}
+
+	return &model.Webhooks{
+		Pagination: &paginationClone,
+		Webhooks:   webhooks,
+	}, nil
+}
+
+// GetWebhookByID to get webhook information from database using webhook id
+func (p *provider) GetWebhookByID(id string) (models.Webhook, error) {
+	var webhook models.Webhook
+
+	query := fmt.Sprintf("FOR d in %s FILTER d._id == @id LIMIT 1 RETURN d", models.Collections.Webhook)
+	bindVars := map[string]interface{}{
+		"id": id,
+	}
+
+	cursor, err := p.db.Query(nil, query, bindVars)
+	if err != nil {
+		return webhook, err
+	}
+	defer cursor.Close()
+
+	for {
+		if !cursor.HasMore() {
+			if webhook.Key == "" {
+				return webhook, fmt.Errorf("webhook not found")
+			}
+			break
+		}
+		_, err := cursor.ReadDocument(nil, &webhook)
+		if err != nil {
+			return webhook, err
+		}
+	}
+
+	return webhook, nil
+}
+
+// GetWebhooksByEventName to get list of webhooks of event from database
+func (p *provider) GetWebhooksByEventName(eventName string) ([]models.Webhook, error) {
+	webhooks := []models.Webhook{}
+
+	query := fmt.Sprintf("FOR d in %s FILTER d.event_name == @event_name RETURN d", models.Collections.Webhook)
+	bindVars := map[string]interface{}{
+		"event_name": eventName,
+	}
+
+	cursor, err := p.db.Query(nil, query, bindVars)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close()
+
+	for {
+		var webhook models.Webhook
+		meta, err := cursor.ReadDocument(nil, &webhook)
+
+		if arangoDriver.IsNoMoreDocuments(err) {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+
+		if meta.Key != "" {
+			webhooks = append(webhooks, webhook)
+		}
+	}
+
+	return webhooks, nil
+}
@@ -0,0 +1,128 @@
+package arangodb
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/arangodb/go-driver"
+	arangoDriver "github.com/arangodb/go-driver"
+	"github.com/authorizerdev/authorizer/server/constants"
+	"github.com/authorizerdev/authorizer/server/db/models"
+	"github.com/authorizerdev/authorizer/server/graph/model"
+	"github.com/google/uuid"
+)
+
+// AddWebhookDelivery to save webhook delivery in database
+func (p *provider) AddWebhookDelivery(delivery models.WebhookDelivery) (models.WebhookDelivery, error) {
+	if delivery.ID == "" {
+		delivery.ID = uuid.New().String()
+	}
+
+	delivery.CreatedAt = time.Now().Unix()
+	delivery.UpdatedAt = time.Now().Unix()
+	deliveryCollection, _ := p.db.Collection(nil, models.Collections.WebhookDelivery)
+	meta, err := deliveryCollection.CreateDocument(nil, delivery)
+	if err != nil {
+		log.Println("error adding webhook delivery:", err)
+		return delivery, err
+	}
+	delivery.Key = meta.Key
+	delivery.ID = meta.ID.String()
+
+	return delivery, nil
+}
+
+// UpdateWebhookDelivery to update webhook delivery in database
+func (p *provider) UpdateWebhookDelivery(delivery models.WebhookDelivery) (models.WebhookDelivery, error) {
+	delivery.UpdatedAt = time.Now().Unix()
+	collection, _ := p.db.Collection(nil, models.Collections.WebhookDelivery)
+	meta, err := collection.UpdateDocument(nil, delivery.Key, delivery)
+	if err != nil {
+		log.Println("error updating webhook delivery:", err)
+		return delivery, err
+	}
+
+	delivery.Key = meta.Key
+	delivery.ID = meta.ID.String()
+	return delivery, nil
+}
+
+// ListWebhookDeliveries to get list of webhook deliveries from database, filtered by webhook id if not empty
+func (p *provider) ListWebhookDeliveries(pagination model.Pagination, webhookID string) (*model.WebhookDeliveries, error) {
+	deliveries := []*model.WebhookDelivery{}
+	ctx := driver.WithQueryFullCount(context.Background())
+
+	filter := ""
+	bindVars := map[string]interface{}{}
+	if webhookID != "" {
+		filter = "FILTER d.webhook_id == @webhook_id"
+		bindVars["webhook_id"] = webhookID
+	}
+
+	query := fmt.Sprintf("FOR d in %s %s SORT d.created_at DESC LIMIT %d, %d RETURN d", models.Collections.WebhookDelivery, filter, pagination.Offset, pagination.Limit)
+
+	cursor, err := p.db.Query(ctx, query, bindVars)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close()
+
+	paginationClone := pagination
+	paginationClone.Total = cursor.Statistics().FullCount()
+
+	for {
+		var delivery models.WebhookDelivery
+		meta, err := cursor.ReadDocument(nil, &delivery)
+
+		if arangoDriver.IsNoMoreDocuments(err) {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+
+		if meta.Key != "" {
+			deliveries = append(deliveries, delivery.AsAPIWebhookDelivery())
+		}
+	}
+
+	return &model.WebhookDeliveries{
+		Pagination:        &paginationClone,
+		WebhookDeliveries: deliveries,
+	}, nil
+}
+
+// ListPendingWebhookDeliveries to get list of pending webhook deliveries due for next attempt at given time from database
+func (p *provider) ListPendingWebhookDeliveries(now int64, limit int64) ([]models.WebhookDelivery, error) {
+	deliveries := []models.WebhookDelivery{}
+
+	query := fmt.Sprintf("FOR d in %s FILTER d.status == @status FILTER d.next_attempt_at <= @now SORT d.next_attempt_at ASC LIMIT %d RETURN d", models.Collections.WebhookDelivery, limit)
+	bindVars := map[string]interface{}{
+		"status": constants.WebhookDeliveryStatusPending,
+		"now":    now,
+	}
+
+	cursor, err := p.db.Query(nil, query, bindVars)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close()
+
+	for {
+		var delivery models.WebhookDelivery
+		meta, err := cursor.ReadDocument(nil, &delivery)
+
+		if arangoDriver.IsNoMoreDocuments(err) {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+
+		if meta.Key != "" {
+			deliveries = append(deliveries, delivery)
+		}
+	}
+
+	return deliveries, nil
+}
@@ -0,0 +1,110 @@
+package mongodb
+
+import (
+	"log"
+	"time"
+
+	"github.com/authorizerdev/authorizer/server/db/models"
+	"github.com/authorizerdev/authorizer/server/graph/model"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// AddAuditEvent to save audit event in database
+func (p *provider) AddAuditEvent(event models.AuditEvent) (models.AuditEvent, error) {
+	if event.ID == "" {
+		event.ID = uuid.New().String()
+	}
+
+	if event.CreatedAt == 0 {
+		event.CreatedAt = time.Now().Unix()
+	}
+
+	event.Key = event.ID
+	auditEventCollection := p.db.Collection(models.Collections.AuditEvent, options.Collection())
+	_, err := auditEventCollection.InsertOne(nil, event)
+	if err != nil {
+		log.Println("error adding audit event:", err)
+		return event, err
+	}
+
+	return event, nil
+}
+
+// ListAuditEvents to get list of audit events matching the filter from database
+func (p *provider) ListAuditEvents(pagination model.Pagination, filter models.AuditEventFilter) (*model.AuditLogs, error) {
+	events := []*model.AuditEvent{}
+	opts := options.Find()
+	opts.SetLimit(pagination.Limit)
+	opts.SetSkip(pagination.Offset)
+	opts.SetSort(bson.M{"created_at": -1})
+
+	query := bson.M{}
+	for key, value := range map[string]string{
+		"action":    filter.Action,
+		"actor_id":  filter.ActorID,
+		"target_id": filter.TargetID,
+		"result":    filter.Result,
+		"ip":        filter.IP,
+	} {
+		if value != "" {
+			query[key] = value
+		}
+	}
+
+	createdAt := bson.M{}
+	if filter.From > 0 {
+		createdAt["$gte"] = filter.From
+	}
+	if filter.To > 0 {
+		createdAt["$lte"] = filter.To
+	}
+	if len(createdAt) > 0 {
+		query["created_at"] = createdAt
+	}
+
+	paginationClone := pagination
+
+	auditEventCollection := p.db.Collection(models.Collections.AuditEvent, options.Collection())
+	count, err := auditEventCollection.CountDocuments(nil, query, options.Count())
+	if err != nil {
+		log.Println("error getting total audit events:", err)
+		return nil, err
+	}
+
+	paginationClone.Total = count
+
+	cursor, err := auditEventCollection.Find(nil, query, opts)
+	if err != nil {
+		log.Println("error getting audit events:", err)
+		return nil, err
+	}
+	defer cursor.Close(nil)
+
+	for cursor.Next(nil) {
+		var event models.AuditEvent
+		err := cursor.Decode(&event)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, event.AsAPIAuditEvent())
+	}
+
+	return &model.AuditLogs{
+		Pagination:  &paginationClone,
+		AuditEvents: events,
+	}, nil
+}
+
+// DeleteAuditEventsBefore to delete audit events created before the given unix time from database
+func (p *provider) DeleteAuditEventsBefore(createdAt int64) error {
+	auditEventCollection := p.db.Collection(models.Collections.AuditEvent, options.Collection())
+	_, err := auditEventCollection.DeleteMany(nil, bson.M{"created_at": bson.M{"$lt": createdAt}}, options.Delete())
+	if err != nil {
+		log.Println("error deleting audit events:", err)
+		return err
+	}
+
+	return nil
+}
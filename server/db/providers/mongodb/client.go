@@ -0,0 +1,110 @@
+package mongodb
+
+import (
+	"log"
+	"time"
+
+	"github.com/authorizerdev/authorizer/server/db/models"
+	"github.com/authorizerdev/authorizer/server/graph/model"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// AddClient to save oauth client information in database
+func (p *provider) AddClient(client models.Client) (models.Client, error) {
+	if client.ID == "" {
+		client.ID = uuid.New().String()
+	}
+
+	client.CreatedAt = time.Now().Unix()
+	client.UpdatedAt = time.Now().Unix()
+	client.Key = client.ID
+	clientCollection := p.db.Collection(models.Collections.Client, options.Collection())
+	_, err := clientCollection.InsertOne(nil, client)
+	if err != nil {
+		log.Println("error adding client:", err)
+		return client, err
+	}
+
+	return client, nil
+}
+
+// UpdateClient to update oauth client information in database
+func (p *provider) UpdateClient(client models.Client) (models.Client, error) {
+	client.UpdatedAt = time.Now().Unix()
+	clientCollection := p.db.Collection(models.Collections.Client, options.Collection())
+	_, err := clientCollection.UpdateOne(nil, bson.M{"_id": bson.M{"$eq": client.ID}}, bson.M{"$set": client}, options.MergeUpdateOptions())
+	if err != nil {
+		log.Println("error updating client:", err)
+		return client, err
+	}
+
+	return client, nil
+}
+
+// DeleteClient to delete oauth client information from database
+func (p *provider) DeleteClient(client models.Client) error {
+	clientCollection := p.db.Collection(models.Collections.Client, options.Collection())
+	_, err := clientCollection.DeleteOne(nil, bson.M{"_id": client.ID}, options.Delete())
+	if err != nil {
+		log.Println("error deleting client:", err)
+		return err
+	}
+
+	return nil
+}
+
+// ListClients to get list of oauth clients from database
+func (p *provider) ListClients(pagination model.Pagination) (*model.Clients, error) {
+	clients := []*model.Client{}
+	opts := options.Find()
+	opts.SetLimit(pagination.Limit)
+	opts.SetSkip(pagination.Offset)
+	opts.SetSort(bson.M{"created_at": -1})
+
+	paginationClone := pagination
+
+	clientCollection := p.db.Collection(models.Collections.Client, options.Collection())
+	count, err := clientCollection.CountDocuments(nil, bson.M{}, options.Count())
+	if err != nil {
+		log.Println("error getting total clients:", err)
+		return nil, err
+	}
+
+	paginationClone.Total = count
+
+	cursor, err := clientCollection.Find(nil, bson.M{}, opts)
+	if err != nil {
+		log.Println("error getting clients:", err)
+		return nil, err
+	}
+	defer cursor.Close(nil)
+
+	for cursor.Next(nil) {
+		var client models.Client
+		err := cursor.Decode(&client)
+		if err != nil {
+			return nil, err
+		}
+		clients = append(clients, client.AsAPIClient())
+	}
+
+	return &model.Clients{
+		Pagination: &paginationClone,
+		Clients:    clients,
+	}, nil
+}
+
+// GetClientByClientID to get oauth client information from database using client id
+func (p *provider) GetClientByClientID(clientID string) (models.Client, error) {
+	var client models.Client
+
+	clientCollection := p.db.Collection(models.Collections.Client, options.Collection())
+	err := clientCollection.FindOne(nil, bson.M{"client_id": clientID}).Decode(&client)
+	if err != nil {
+		return client, err
+	}
+
+	return client, nil
+}
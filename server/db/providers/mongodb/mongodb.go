@@ -26,7 +26,8 @@ func NewProvider() (*provider, error) {
 	if err != nil {
 		return nil, err
 	}
-	ctx, _ := context.WithTimeout(context.Background(), 30*time.Second)
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
 	err = mongoClient.Connect(ctx)
 	if err != nil {
 		return nil, err
@@ -78,10 +79,77 @@ func NewProvider() (*provider, error) {
 			Keys:    bson.M{"user_id": 1},
 			Options: options.Index().SetSparse(true),
 		},
+		mongo.IndexModel{
+			Keys:    bson.M{"family_id": 1},
+			Options: options.Index().SetSparse(true),
+		},
 	}, options.CreateIndexes())
 
 	mongodb.CreateCollection(ctx, models.Collections.Env, options.CreateCollection())
 
+	mongodb.CreateCollection(ctx, models.Collections.Client, options.CreateCollection())
+	clientCollection := mongodb.Collection(models.Collections.Client, options.Collection())
+	clientCollection.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		mongo.IndexModel{
+			Keys:    bson.M{"client_id": 1},
+			Options: options.Index().SetUnique(true).SetSparse(true),
+		},
+	}, options.CreateIndexes())
+
+	mongodb.CreateCollection(ctx, models.Collections.WebauthnCredential, options.CreateCollection())
+	webauthnCredentialCollection := mongodb.Collection(models.Collections.WebauthnCredential, options.Collection())
+	webauthnCredentialCollection.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		mongo.IndexModel{
+			Keys:    bson.M{"credential_id": 1},
+			Options: options.Index().SetUnique(true).SetSparse(true),
+		},
+		mongo.IndexModel{
+			Keys: bson.M{"user_id": 1},
+		},
+	}, options.CreateIndexes())
+
+	mongodb.CreateCollection(ctx, models.Collections.AuditEvent, options.CreateCollection())
+	auditEventCollection := mongodb.Collection(models.Collections.AuditEvent, options.Collection())
+	auditEventCollection.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		mongo.IndexModel{
+			Keys: bson.M{"created_at": -1},
+		},
+		mongo.IndexModel{
+			Keys: bson.M{"actor_id": 1},
+		},
+		mongo.IndexModel{
+			Keys: bson.M{"target_id": 1},
+		},
+	}, options.CreateIndexes())
+
+	mongodb.CreateCollection(ctx, models.Collections.Webhook, options.CreateCollection())
+	webhookCollection := mongodb.Collection(models.Collections.Webhook, options.Collection())
+	webhookCollection.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		mongo.IndexModel{
+			Keys: bson.M{"event_name": 1},
+		},
+	}, options.CreateIndexes())
+
+	mongodb.CreateCollection(ctx, models.Collections.WebhookDelivery, options.CreateCollection())
+	webhookDeliveryCollection := mongodb.Collection(models.Collections.WebhookDelivery, options.Collection())
+	webhookDeliveryCollection.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		mongo.IndexModel{
+			Keys: bson.M{"webhook_id": 1},
+		},
+		mongo.IndexModel{
+			Keys: bson.D{{Key: "status", Value: 1}, {Key: "next_attempt_at", Value: 1}},
+		},
+	}, options.CreateIndexes())
+
+	mongodb.CreateCollection(ctx, models.Collections.OIDCProvider, options.CreateCollection())
+	oidcProviderCollection := mongodb.Collection(models.Collections.OIDCProvider, options.Collection())
+	oidcProviderCollection.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		mongo.IndexModel{
+			Keys:    bson.M{"name": 1},
+			Options: options.Index().SetUnique(true).SetSparse(true),
+		},
+	}, options.CreateIndexes())
+
 	return &provider{
 		db: mongodb,
 	}, nil
@@ -0,0 +1,149 @@
+package mongodb
+
+import (
+	"log"
+	"time"
+
+	"github.com/authorizerdev/authorizer/server/db/models"
+	"github.com/authorizerdev/authorizer/server/graph/model"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// AddOIDCProvider to save oidc provider information in database
+func (p *provider) AddOIDCProvider(oidcProvider models.OIDCProvider) (models.OIDCProvider, error) {
+	if oidcProvider.ID == "" {
+		oidcProvider.ID = uuid.New().String()
+	}
+
+	oidcProvider.CreatedAt = time.Now().Unix()
+	oidcProvider.UpdatedAt = time.Now().Unix()
+	oidcProvider.Key = oidcProvider.ID
+	oidcProviderCollection := p.db.Collection(models.Collections.OIDCProvider, options.Collection())
+	_, err := oidcProviderCollection.InsertOne(nil, oidcProvider)
+	if err != nil {
+		log.Println("error adding oidc provider:", err)
+		return oidcProvider, err
+	}
+
+	return oidcProvider, nil
+}
+
+// UpdateOIDCProvider to update oidc provider information in database
+func (p *provider) UpdateOIDCProvider(oidcProvider models.OIDCProvider) (models.OIDCProvider, error) {
+	oidcProvider.UpdatedAt = time.Now().Unix()
+	oidcProviderCollection := p.db.Collection(models.Collections.OIDCProvider, options.Collection())
+	_, err := oidcProviderCollection.UpdateOne(nil, bson.M{"_id": bson.M{"$eq": oidcProvider.ID}}, bson.M{"$set": oidcProvider}, options.MergeUpdateOptions())
+	if err != nil {
+		log.Println("error updating oidc provider:", err)
+		return oidcProvider, err
+	}
+
+	return oidcProvider, nil
+}
+
+// DeleteOIDCProvider to delete oidc provider information from database
+func (p *provider) DeleteOIDCProvider(oidcProvider models.OIDCProvider) error {
+	oidcProviderCollection := p.db.Collection(models.Collections.OIDCProvider, options.Collection())
+	_, err := oidcProviderCollection.DeleteOne(nil, bson.M{"_id": oidcProvider.ID}, options.Delete())
+	if err != nil {
+		log.Println("error deleting oidc provider:", err)
+		return err
+	}
+
+	return nil
+}
+
+// ListOIDCProviders to get list of oidc providers from database
+func (p *provider) ListOIDCProviders(pagination model.Pagination) (*model.OIDCProviders, error) {
+	oidcProviders := []*model.OIDCProvider{}
+	opts := options.Find()
+	opts.SetLimit(pagination.Limit)
+	opts.SetSkip(pagination.Offset)
+	opts.SetSort(bson.M{"created_at": -1})
+
+	paginationClone := pagination
+
+	oidcProviderCollection := p.db.Collection(models.Collections.OIDCProvider, options.Collection())
+	count, err := oidcProviderCollection.CountDocuments(nil, bson.M{}, options.Count())
+	if err != nil {
+		log.Println("error getting total oidc providers:", err)
+		return nil, err
+	}
+
+	paginationClone.Total = count
+
+	cursor, err := oidcProviderCollection.Find(nil, bson.M{}, opts)
+	if err != nil {
+		log.Println("error getting oidc providers:", err)
+		return nil, err
+	}
+	defer cursor.Close(nil)
+
+	for cursor.Next(nil) {
+		var oidcProvider models.OIDCProvider
+		err := cursor.Decode(&oidcProvider)
+		if err != nil {
+			return nil, err
+		}
+		oidcProviders = append(oidcProviders, oidcProvider.AsAPIOIDCProvider())
+	}
+
+	return &model.OIDCProviders{
+		Pagination:    &paginationClone,
+		OidcProviders: oidcProviders,
+	}, nil
+}
+
+// ListEnabledOIDCProviders to get list of enabled oidc providers from database
+func (p *provider) ListEnabledOIDCProviders() ([]models.OIDCProvider, error) {
+	oidcProviders := []models.OIDCProvider{}
+	opts := options.Find()
+	opts.SetSort(bson.M{"created_at": 1})
+
+	oidcProviderCollection := p.db.Collection(models.Collections.OIDCProvider, options.Collection())
+	cursor, err := oidcProviderCollection.Find(nil, bson.M{"enabled": true}, opts)
+	if err != nil {
+		log.Println("error getting oidc providers:", err)
+		return nil, err
+	}
+	defer cursor.Close(nil)
+
+	for cursor.Next(nil) {
+		var oidcProvider models.OIDCProvider
+		err := cursor.Decode(&oidcProvider)
+		if err != nil {
+			return nil, err
+		}
+		oidcProviders = append(oidcProviders, oidcProvider)
+	}
+
+	return oidcProviders, nil
+}
+
+// GetOIDCProviderByID to get oidc provider information from database using id
+func (p *provider) GetOIDCProviderByID(id string) (models.OIDCProvider, error) {
+	var oidcProvider models.OIDCProvider
+
+	oidcProviderCollection := p.db.Collection(models.Collections.OIDCProvider, options.Collection())
+	err := oidcProviderCollection.FindOne(nil, bson.M{"_id": id}).Decode(&oidcProvider)
+	if err != nil {
+		return oidcProvider, err
+	}
+
+	return oidcProvider, nil
+}
+
+// GetOIDCProviderByName to get oidc provider information from database using name
+func (p *provider) GetOIDCProviderByName(name string) (models.OIDCProvider, error) {
+	var oidcProvider models.OIDCProvider
+
+	oidcProviderCollection := p.db.Collection(models.Collections.OIDCProvider, options.Collection())
+	err := oidcProviderCollection.FindOne(nil, bson.M{"name": name}).Decode(&oidcProvider)
+	if err != nil {
+		return oidcProvider, err
+	}
+
+	return oidcProvider, nil
+}
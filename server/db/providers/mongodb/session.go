@@ -38,3 +38,82 @@ func (p *provider) DeleteSession(userId string) error {
 	}
 	return nil
 }
+
+// UpdateSession to update session information in database
+func (p *provider) UpdateSession(session models.Session) (models.Session, error) {
+	session.UpdatedAt = time.Now().Unix()
+	sessionCollection := p.db.Collection(models.Collections.Session, options.Collection())
+	_, err := sessionCollection.UpdateOne(nil, bson.M{"_id": bson.M{"$eq": session.ID}}, bson.M{"$set": session}, options.MergeUpdateOptions())
+	if err != nil {
+		log.Println("error updating session:", err)
+		return session, err
+	}
+
+	return session, nil
+}
+
+// DeleteSessionByID to delete single session information from database
+func (p *provider) DeleteSessionByID(sessionID string) error {
+	sessionCollection := p.db.Collection(models.Collections.Session, options.Collection())
+	_, err := sessionCollection.DeleteOne(nil, bson.M{"_id": sessionID}, options.Delete())
+	if err != nil {
+		log.Println("error deleting session:", err)
+		return err
+	}
+
+	return nil
+}
+
+// ListSessionsByUserID to get list of sessions of user from database
+func (p *provider) ListSessionsByUserID(userID string) ([]models.Session, error) {
+	sessions := []models.Session{}
+	opts := options.Find()
+	opts.SetSort(bson.M{"created_at": -1})
+
+	sessionCollection := p.db.Collection(models.Collections.Session, options.Collection())
+	cursor, err := sessionCollection.Find(nil, bson.M{"user_id": userID}, opts)
+	if err != nil {
+		log.Println("error getting sessions:", err)
+		return nil, err
+	}
+	defer cursor.Close(nil)
+
+	for cursor.Next(nil) {
+		var session models.Session
+		err := cursor.Decode(&session)
+		if err != nil {
+			return nil, err
+		}
+		sessions = append(sessions, session)
+	}
+
+	return sessions, nil
+}
+
+// GetSessionByID to get session information from database using session id
+func (p *provider) GetSessionByID(sessionID string) (models.Session, error) {
+	var session models.Session
+
+	sessionCollection := p.db.Collection(models.Collections.Session, options.Collection())
+	err := sessionCollection.FindOne(nil, bson.M{"_id": sessionID}).Decode(&session)
+	if err != nil {
+		return session, err
+	}
+
+	return session, nil
+}
+
+// GetSessionByFamilyID to get session information from database using refresh token family id
+func (p *provider) GetSessionByFamilyID(familyID string) (models.Session, error) {
+	var session models.Session
+
+	opts := options.FindOne()
+	opts.SetSort(bson.M{"created_at": -1})
+	sessionCollection := p.db.Collection(models.Collections.Session, options.Collection())
+	err := sessionCollection.FindOne(nil, bson.M{"family_id": familyID}, opts).Decode(&session)
+	if err != nil {
+		return session, err
+	}
+
+	return session, nil
+}
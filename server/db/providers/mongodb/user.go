@@ -48,6 +48,26 @@ func (p *provider) UpdateUser(user models.User) (models.User, error) {
 	return user, nil
 }
 
+// UpdateUserTotpBackupCodes to update totp backup codes of user in database, only if they are not changed since the user was read.
+// It returns false if they were changed, e.g. the same backup code was used by parallel request
+func (p *provider) UpdateUserTotpBackupCodes(user models.User, backupCodes string) (bool, error) {
+	if user.TotpBackupCodes == nil {
+		return false, nil
+	}
+
+	userCollection := p.db.Collection(models.Collections.User, options.Collection())
+	res, err := userCollection.UpdateOne(nil, bson.M{"_id": user.ID, "totp_backup_codes": *user.TotpBackupCodes}, bson.M{"$set": bson.M{
+		"totp_backup_codes": backupCodes,
+		"updated_at":        time.Now().Unix(),
+	}}, options.Update())
+	if err != nil {
+		log.Println("error updating user totp backup codes:", err)
+		return false, err
+	}
+
+	return res.MatchedCount == 1, nil
+}
+
 // DeleteUser to delete user information from database
 func (p *provider) DeleteUser(user models.User) error {
 	userCollection := p.db.Collection(models.Collections.User, options.Collection())
@@ -114,6 +134,18 @@ func (p *provider) GetUserByEmail(email string) (models.User, error) {
 	return user, nil
 }
 
+// GetUserByVerifiedPhoneNumber to get information of the user who has verified the phone number
+func (p *provider) GetUserByVerifiedPhoneNumber(phoneNumber string) (models.User, error) {
+	var user models.User
+	userCollection := p.db.Collection(models.Collections.User, options.Collection())
+	err := userCollection.FindOne(nil, bson.M{"phone_number": phoneNumber, "phone_number_verified_at": bson.M{"$ne": nil}}).Decode(&user)
+	if err != nil {
+		return user, err
+	}
+
+	return user, nil
+}
+
 // GetUserByID to get user information from database using user ID
 func (p *provider) GetUserByID(id string) (models.User, error) {
 	var user models.User
@@ -0,0 +1,94 @@
+package mongodb
+
+import (
+	"log"
+	"time"
+
+	"github.com/authorizerdev/authorizer/server/db/models"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// AddWebauthnCredential to save webauthn credential information in database
+func (p *provider) AddWebauthnCredential(credential models.WebauthnCredential) (models.WebauthnCredential, error) {
+	if credential.ID == "" {
+		credential.ID = uuid.New().String()
+	}
+
+	credential.CreatedAt = time.Now().Unix()
+	credential.UpdatedAt = time.Now().Unix()
+	credential.Key = credential.ID
+	credentialCollection := p.db.Collection(models.Collections.WebauthnCredential, options.Collection())
+	_, err := credentialCollection.InsertOne(nil, credential)
+	if err != nil {
+		log.Println("error adding webauthn credential:", err)
+		return credential, err
+	}
+
+	return credential, nil
+}
+
+// UpdateWebauthnCredential to update webauthn credential information in database
+func (p *provider) UpdateWebauthnCredential(credential models.WebauthnCredential) (models.WebauthnCredential, error) {
+	credential.UpdatedAt = time.Now().Unix()
+	credentialCollection := p.db.Collection(models.Collections.WebauthnCredential, options.Collection())
+	_, err := credentialCollection.UpdateOne(nil, bson.M{"_id": bson.M{"$eq": credential.ID}}, bson.M{"$set": credential}, options.MergeUpdateOptions())
+	if err != nil {
+		log.Println("error updating webauthn credential:", err)
+		return credential, err
+	}
+
+	return credential, nil
+}
+
+// DeleteWebauthnCredential to delete webauthn credential information from database
+func (p *provider) DeleteWebauthnCredential(credential models.WebauthnCredential) error {
+	credentialCollection := p.db.Collection(models.Collections.WebauthnCredential, options.Collection())
+	_, err := credentialCollection.DeleteOne(nil, bson.M{"_id": credential.ID}, options.Delete())
+	if err != nil {
+		log.Println("error deleting webauthn credential:", err)
+		return err
+	}
+
+	return nil
+}
+
+// ListWebauthnCredentialsByUserID to get list of webauthn credentials of user from database
+func (p *provider) ListWebauthnCredentialsByUserID(userID string) ([]models.WebauthnCredential, error) {
+	credentials := []models.WebauthnCredential{}
+	opts := options.Find()
+	opts.SetSort(bson.M{"created_at": -1})
+
+	credentialCollection := p.db.Collection(models.Collections.WebauthnCredential, options.Collection())
+	cursor, err := credentialCollection.Find(nil, bson.M{"user_id": userID}, opts)
+	if err != nil {
+		log.Println("error getting webauthn credentials:", err)
+		return nil, err
+	}
+	defer cursor.Close(nil)
+
+	for cursor.Next(nil) {
+		var credential models.WebauthnCredential
+		err := cursor.Decode(&credential)
+		if err != nil {
+			return nil, err
+		}
+		credentials = append(credentials, credential)
+	}
+
+	return credentials, nil
+}
+
+// GetWebauthnCredentialByCredentialID to get webauthn credential information from database using credential id
+func (p *provider) GetWebauthnCredentialByCredentialID(credentialID string) (models.WebauthnCredential, error) {
+	var credential models.WebauthnCredential
+
+	credentialCollection := p.db.Collection(models.Collections.WebauthnCredential, options.Collection())
+	err := credentialCollection.FindOne(nil, bson.M{"credential_id": credentialID}).Decode(&credential)
+	if err != nil {
+		return credential, err
+	}
+
+	return credential, nil
+}
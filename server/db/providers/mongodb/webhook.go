@@ -0,0 +1,134 @@
+package mongodb
+
+import (
+	"log"
+	"time"
+
+	"github.com/authorizerdev/authorizer/server/db/models"
+	"github.com/authorizerdev/authorizer/server/graph/model"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// AddWebhook to save webhook information in database
+func (p *provider) AddWebhook(webhook models.Webhook) (models.Webhook, error) {
+	if webhook.ID == "" {
+		webhook.ID = uuid.New().String()
+	}
+
+	webhook.CreatedAt = time.Now().Unix()
+	webhook.UpdatedAt = time.Now().Unix()
+	webhook.Key = webhook.ID
+	webhookCollection := p.db.Collection(models.Collections.Webhook, options.Collection())
+	_, err := webhookCollection.InsertOne(nil, webhook)
+	if err != nil {
+		log.Println("error adding webhook:", err)
+		return webhook, err
+	}
+
+	return webhook, nil
+}
+
+// UpdateWebhook to update webhook information in database
+func (p *provider) UpdateWebhook(webhook models.Webhook) (models.Webhook, error) {
+	webhook.UpdatedAt = time.Now().Unix()
+	webhookCollection := p.db.Collection(models.Collections.Webhook, options.Collection())
+	_, err := webhookCollection.UpdateOne(nil, bson.M{"_id": bson.M{"$eq": webhook.ID}}, bson.M{"$set": webhook}, options.MergeUpdateOptions())
+	if err != nil {
+		log.Println("error updating webhook:", err)
+		return webhook, err
+	}
+
+	return webhook, nil
+}
+
+// DeleteWebhook to delete webhook information from database
+func (p *provider) DeleteWebhook(webhook models.Webhook) error {
+	webhookCollection := p.db.Collection(models.Collections.Webhook, options.Collection())
+	_, err := webhookCollection.DeleteOne(nil, bson.M{"_id": webhook.ID}, options.Delete())
+	if err != nil {
+		log.Println("error deleting webhook:", err)
+		return err
+	}
+
+	return nil
+}
+
+// ListWebhooks to get list of webhooks from database
+func (p *provider) ListWebhooks(pagination model.Pagination) (*model.Webhooks, error) {
+	webhooks := []*model.Webhook{}
+	opts := options.Find()
+	opts.SetLimit(pagination.Limit)
+	opts.SetSkip(pagination.Offset)
+	opts.SetSort(bson.M{"created_at": -1})
+
+	paginationClone := pagination
+
+	webhookCollection := p.db.Collection(models.Collections.Webhook, options.Collection())
+	count, err := webhookCollection.CountDocuments(nil, bson.M{}, options.Count())
+	if err != nil {
+		log.Println("error getting total webhooks:", err)
+		return nil, err
+	}
+
+	paginationClone.Total = count
+
+	cursor, err := webhookCollection.Find(nil, bson.M{}, opts)
+	if err != nil {
+		log.Println("error getting webhooks:", err)
+		return nil, err
+	}
+	defer cursor.Close(nil)
+
+	for cursor.Next(nil) {
+		var webhook models.Webhook
+		err := cursor.Decode(&webhook)
+		if err != nil {
+			return nil, err
+		}
+		webhooks = append(webhooks, webhook.AsAPIWebhook())
+	}
+
+	return &model.Webhooks{
+		Pagination: &paginationClone,
+		Webhooks:   webhooks,
+	}, nil
+}
+
+// GetWebhookByID to get webhook information from database using webhook id
+func (p *provider) GetWebhookByID(id string) (models.Webhook, error) {
+	var webhook models.Webhook
+
+	webhookCollection := p.db.Collection(models.Collections.Webhook, options.Collection())
+	err := webhookCollection.FindOne(nil, bson.M{"_id": id}).Decode(&webhook)
+	if err != nil {
+		return webhook, err
+	}
+
+	return webhook, nil
+}
+
+// GetWebhooksByEventName to get list of webhooks of event from database
+func (p *provider) GetWebhooksByEventName(eventName string) ([]models.Webhook, error) {
+	webhooks := []models.Webhook{}
+
+	webhookCollection := p.db.Collection(models.Collections.Webhook, options.Collection())
+	cursor, err := webhookCollection.Find(nil, bson.M{"event_name": eventName}, options.Find())
+	if err != nil {
+		log.Println("error getting webhooks:", err)
+		return nil, err
+	}
+	defer cursor.Close(nil)
+
+	for cursor.Next(nil) {
+		var webhook models.Webhook
+		err := cursor.Decode(&webhook)
+		if err != nil {
+			return nil, err
+		}
+		webhooks = append(webhooks, webhook)
+	}
+
+	return webhooks, nil
+}
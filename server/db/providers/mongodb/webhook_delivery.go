@@ -0,0 +1,118 @@
+package mongodb
+
+import (
+	"log"
+	"time"
+
+	"github.com/authorizerdev/authorizer/server/constants"
+	"github.com/authorizerdev/authorizer/server/db/models"
+	"github.com/authorizerdev/authorizer/server/graph/model"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// AddWebhookDelivery to save webhook delivery in database
+func (p *provider) AddWebhookDelivery(delivery models.WebhookDelivery) (models.WebhookDelivery, error) {
+	if delivery.ID == "" {
+		delivery.ID = uuid.New().String()
+	}
+
+	delivery.CreatedAt = time.Now().Unix()
+	delivery.UpdatedAt = time.Now().Unix()
+	delivery.Key = delivery.ID
+	deliveryCollection := p.db.Collection(models.Collections.WebhookDelivery, options.Collection())
+	_, err := deliveryCollection.InsertOne(nil, delivery)
+	if err != nil {
+		log.Println("error adding webhook delivery:", err)
+		return delivery, err
+	}
+
+	return delivery, nil
+}
+
+// UpdateWebhookDelivery to update webhook delivery in database
+func (p *provider) UpdateWebhookDelivery(delivery models.WebhookDelivery) (models.WebhookDelivery, error) {
+	delivery.UpdatedAt = time.Now().Unix()
+	deliveryCollection := p.db.Collection(models.Collections.WebhookDelivery, options.Collection())
+	_, err := deliveryCollection.UpdateOne(nil, bson.M{"_id": bson.M{"$eq": delivery.ID}}, bson.M{"$set": delivery}, options.MergeUpdateOptions())
+	if err != nil {
+		log.Println("error updating webhook delivery:", err)
+		return delivery, err
+	}
+
+	return delivery, nil
+}
+
+// ListWebhookDeliveries to get list of webhook deliveries from database, filtered by webhook id if not empty
+func (p *provider) ListWebhookDeliveries(pagination model.Pagination, webhookID string) (*model.WebhookDeliveries, error) {
+	deliveries := []*model.WebhookDelivery{}
+	opts := options.Find()
+	opts.SetLimit(pagination.Limit)
+	opts.SetSkip(pagination.Offset)
+	opts.SetSort(bson.M{"created_at": -1})
+
+	query := bson.M{}
+	if webhookID != "" {
+		query["webhook_id"] = webhookID
+	}
+
+	paginationClone := pagination
+
+	deliveryCollection := p.db.Collection(models.Collections.WebhookDelivery, options.Collection())
+	count, err := deliveryCollection.CountDocuments(nil, query, options.Count())
+	if err != nil {
+		log.Println("error getting total webhook deliveries:", err)
+		return nil, err
+	}
+
+	paginationClone.Total = count
+
+	cursor, err := deliveryCollection.Find(nil, query, opts)
+	if err != nil {
+		log.Println("error getting webhook deliveries:", err)
+		return nil, err
+	}
+	defer cursor.Close(nil)
+
+	for cursor.Next(nil) {
+		var delivery models.WebhookDelivery
+		err := cursor.Decode(&delivery)
+		if err != nil {
+			return nil, err
+		}
+		deliveries = append(deliveries, delivery.AsAPIWebhookDelivery())
+	}
+
+	return &model.WebhookDeliveries{
+		Pagination:        &paginationClone,
+		WebhookDeliveries: deliveries,
+	}, nil
+}
+
+// ListPendingWebhookDeliveries to get list of pending webhook deliveries due for next attempt at given time from database
+func (p *provider) ListPendingWebhookDeliveries(now int64, limit int64) ([]models.WebhookDelivery, error) {
+	deliveries := []models.WebhookDelivery{}
+	opts := options.Find()
+	opts.SetLimit(limit)
+	opts.SetSort(bson.M{"next_attempt_at": 1})
+
+	deliveryCollection := p.db.Collection(models.Collections.WebhookDelivery, options.Collection())
+	cursor, err := deliveryCollection.Find(nil, bson.M{"status": constants.WebhookDeliveryStatusPending, "next_attempt_at": bson.M{"$lte": now}}, opts)
+	if err != nil {
+		log.Println("error getting pending webhook deliveries:", err)
+		return nil, err
+	}
+	defer cursor.Close(nil)
+
+	for cursor.Next(nil) {
+		var delivery models.WebhookDelivery
+		err := cursor.Decode(&delivery)
+		if err != nil {
+			return nil, err
+		}
+		deliveries = append(deliveries, delivery)
+	}
+
+	return deliveries, nil
+}
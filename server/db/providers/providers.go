@@ -10,6 +10,9 @@ type Provider interface {
 	AddUser(user models.User) (models.User, error)
 	// UpdateUser to update user information in database
 	UpdateUser(user models.User) (models.User, error)
+	// UpdateUserTotpBackupCodes to update totp backup codes of user in database, only if they are not changed since the user was read.
+	// It returns false if they were changed, e.g. the same backup code was used by parallel request
+	UpdateUserTotpBackupCodes(user models.User, backupCodes string) (bool, error)
 	// DeleteUser to delete user information from database
 	DeleteUser(user models.User) error
 	// ListUsers to get list of users from database
@@ -18,6 +21,8 @@ type Provider interface {
 	GetUserByEmail(email string) (models.User, error)
 	// GetUserByID to get user information from database using user ID
 	GetUserByID(id string) (models.User, error)
+	// GetUserByVerifiedPhoneNumber to get information of the user who has verified the phone number
+	GetUserByVerifiedPhoneNumber(phoneNumber string) (models.User, error)
 
 	// AddVerification to save verification request in database
 	AddVerificationRequest(verificationRequest models.VerificationRequest) (models.VerificationRequest, error)
@@ -34,6 +39,16 @@ type Provider interface {
 	AddSession(session models.Session) error
 	// DeleteSession to delete session information from database
 	DeleteSession(userId string) error
+	// UpdateSession to update session information in database
+	UpdateSession(session models.Session) (models.Session, error)
+	// DeleteSessionByID to delete single session information from database
+	DeleteSessionByID(sessionID string) error
+	// ListSessionsByUserID to get list of sessions of user from database
+	ListSessionsByUserID(userID string) ([]models.Session, error)
+	// GetSessionByID to get session information from database using session id
+	GetSessionByID(sessionID string) (models.Session, error)
+	// GetSessionByFamilyID to get session information from database using refresh token family id
+	GetSessionByFamilyID(familyID string) (models.Session, error)
 
 	// AddEnv to save environment information in database
 	AddEnv(env models.Env) (models.Env, error)
@@ -41,4 +56,70 @@ type Provider interface {
 	UpdateEnv(env models.Env) (models.Env, error)
 	// GetEnv to get environment information from database
 	GetEnv() (models.Env, error)
+
+	// AddClient to save oauth client information in database
+	AddClient(client models.Client) (models.Client, error)
+	// UpdateClient to update oauth client information in database
+	UpdateClient(client models.Client) (models.Client, error)
+	// DeleteClient to delete oauth client information from database
+	DeleteClient(client models.Client) error
+	// ListClients to get list of oauth clients from database
+	ListClients(pagination model.Pagination) (*model.Clients, error)
+	// GetClientByClientID to get oauth client information from database using client id
+	GetClientByClientID(clientID string) (models.Client, error)
+
+	// AddWebauthnCredential to save webauthn credential information in database
+	AddWebauthnCredential(credential models.WebauthnCredential) (models.WebauthnCredential, error)
+	// UpdateWebauthnCredential to update webauthn credential information in database
+	UpdateWebauthnCredential(credential models.WebauthnCredential) (models.WebauthnCredential, error)
+	// DeleteWebauthnCredential to delete webauthn credential information from database
+	DeleteWebauthnCredential(credential models.WebauthnCredential) error
+	// ListWebauthnCredentialsByUserID to get list of webauthn credentials of user from database
+	ListWebauthnCredentialsByUserID(userID string) ([]models.WebauthnCredential, error)
+	// GetWebauthnCredentialByCredentialID to get webauthn credential information from database using credential id
+	GetWebauthnCredentialByCredentialID(credentialID string) (models.WebauthnCredential, error)
+
+	// AddAuditEvent to save audit event in database
+	AddAuditEvent(event models.AuditEvent) (models.AuditEvent, error)
+	// ListAuditEvents to get list of audit events matching the filter from database
+	ListAuditEvents(pagination model.Pagination, filter models.AuditEventFilter) (*model.AuditLogs, error)
+	// DeleteAuditEventsBefore to delete audit events created before the given unix time from database
+	DeleteAuditEventsBefore(createdAt int64) error
+
+	// AddWebhook to save webhook information in database
+	AddWebhook(webhook models.Webhook) (models.Webhook, error)
+	// UpdateWebhook to update webhook information in database
+	UpdateWebhook(webhook models.Webhook) (models.Webhook, error)
+	// DeleteWebhook to delete webhook information from database
+	DeleteWebhook(webhook models.Webhook) error
+	// ListWebhooks to get list of webhooks from database
+	ListWebhooks(pagination model.Pagination) (*model.Webhooks, error)
+	// GetWebhookByID to get webhook information from database using webhook id
+	GetWebhookByID(id string) (models.Webhook, error)
+	// GetWebhooksByEventName to get list of webhooks of event from database
+	GetWebhooksByEventName(eventName string) ([]models.Webhook, error)
+
+	// AddWebhookDelivery to save webhook delivery in database
+	AddWebhookDelivery(delivery models.WebhookDelivery) (models.WebhookDelivery, error)
+	// UpdateWebhookDelivery to update webhook delivery in database
+	UpdateWebhookDelivery(delivery models.WebhookDelivery) (models.WebhookDelivery, error)
+	// ListWebhookDeliveries to get list of webhook deliveries from database, filtered by webhook id if not empty
+	ListWebhookDeliveries(pagination model.Pagination, webhookID string) (*model.WebhookDeliveries, error)
+	// ListPendingWebhookDeliveries to get list of pending webhook deliveries due for next attempt at given time from database
+	ListPendingWebhookDeliveries(now int64, limit int64) ([]models.WebhookDelivery, error)
+
+	// AddOIDCProvider to save oidc provider information in database
+	AddOIDCProvider(oidcProvider models.OIDCProvider) (models.OIDCProvider, error)
+	// UpdateOIDCProvider to update oidc provider information in database
+	UpdateOIDCProvider(oidcProvider models.OIDCProvider) (models.OIDCProvider, error)
+	// DeleteOIDCProvider to delete oidc provider information from database
+	DeleteOIDCProvider(oidcProvider models.OIDCProvider) error
+	// ListOIDCProviders to get list of oidc providers from database
+	ListOIDCProviders(pagination model.Pagination) (*model.OIDCProviders, error)
+	// ListEnabledOIDCProviders to get list of enabled oidc providers from database
+	ListEnabledOIDCProviders() ([]models.OIDCProvider, error)
+	// GetOIDCProviderByID to get oidc provider information from database using id
+	GetOIDCProviderByID(id string) (models.OIDCProvider, error)
+	// GetOIDCProviderByName to get oidc provider information from database using name
+	GetOIDCProviderByName(name string) (models.OIDCProvider, error)
 }
@@ -0,0 +1,99 @@
+package sql
+
+import (
+	"log"
+	"time"
+
+	"github.com/authorizerdev/authorizer/server/db/models"
+	"github.com/authorizerdev/authorizer/server/graph/model"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// AddAuditEvent to save audit event in database
+func (p *provider) AddAuditEvent(event models.AuditEvent) (models.AuditEvent, error) {
+	if event.ID == "" {
+		event.ID = uuid.New().String()
+	}
+
+	if event.CreatedAt == 0 {
+		event.CreatedAt = time.Now().Unix()
+	}
+
+	event.Key = event.ID
+	result := p.db.Create(&event)
+	if result.Error != nil {
+		log.Println("error adding audit event:", result.Error)
+		return event, result.Error
+	}
+
+	return event, nil
+}
+
+// ListAuditEvents to get list of audit events matching the filter from database
+func (p *provider) ListAuditEvents(pagination model.Pagination, filter models.AuditEventFilter) (*model.AuditLogs, error) {
+	var events []models.AuditEvent
+	result := filterAuditEvents(p.db, filter).Limit(int(pagination.Limit)).Offset(int(pagination.Offset)).Order("created_at DESC").Find(&events)
+	if result.Error != nil {
+		log.Println("error getting audit events:", result.Error)
+		return nil, result.Error
+	}
+
+	responseEvents := []*model.AuditEvent{}
+	// indexing, as api event points to the fields of db event
+	for i := range events {
+		responseEvents = append(responseEvents, events[i].AsAPIAuditEvent())
+	}
+
+	var total int64
+	totalRes := filterAuditEvents(p.db.Model(&models.AuditEvent{}), filter).Count(&total)
+	if totalRes.Error != nil {
+		return nil, totalRes.Error
+	}
+
+	paginationClone := pagination
+	paginationClone.Total = total
+
+	return &model.AuditLogs{
+		Pagination:  &paginationClone,
+		AuditEvents: responseEvents,
+	}, nil
+}
+
+// filterAuditEvents adds the where conditions of filter to the query
+func filterAuditEvents(query *gorm.DB, filter models.AuditEventFilter) *gorm.DB {
+	if filter.Action != "" {
+		query = query.Where("action = ?", filter.Action)
+	}
+	if filter.ActorID != "" {
+		query = query.Where("actor_id = ?", filter.ActorID)
+	}
+	if filter.TargetID != "" {
+		query = query.Where("target_id = ?", filter.TargetID)
+	}
+	if filter.Result != "" {
+		query = query.Where("result = ?", filter.Result)
+	}
+	if filter.IP != "" {
+		query = query.Where("ip = ?", filter.IP)
+	}
+	if filter.From > 0 {
+		query = query.Where("created_at >= ?", filter.From)
+	}
+	if filter.To > 0 {
+		query = query.Where("created_at <= ?", filter.To)
+	}
+
+	return query
+}
+
+// DeleteAuditEventsBefore to delete audit events created before the given unix time from database
+func (p *provider) DeleteAuditEventsBefore(createdAt int64) error {
+	result := p.db.Where("created_at < ?", createdAt).Delete(&models.AuditEvent{})
+	if result.Error != nil {
+		log.Println("error deleting audit events:", result.Error)
+		return result.Error
+	}
+
+	return nil
+}
@@ -0,0 +1,91 @@
+package sql
+
+import (
+	"log"
+	"time"
+
+	"github.com/authorizerdev/authorizer/server/db/models"
+	"github.com/authorizerdev/authorizer/server/graph/model"
+	"github.com/google/uuid"
+)
+
+// AddClient to save oauth client information in database
+func (p *provider) AddClient(client models.Client) (models.Client, error) {
+	if client.ID == "" {
+		client.ID = uuid.New().String()
+	}
+
+	client.Key = client.ID
+	result := p.db.Create(&client)
+	if result.Error != nil {
+		log.Println("error adding client:", result.Error)
+		return client, result.Error
+	}
+
+	return client, nil
+}
+
+// UpdateClient to update oauth client information in database
+func (p *provider) UpdateClient(client models.Client) (models.Client, error) {
+	client.UpdatedAt = time.Now().Unix()
+
+	result := p.db.Save(&client)
+	if result.Error != nil {
+		log.Println("error updating client:", result.Error)
+		return client, result.Error
+	}
+
+	return client, nil
+}
+
+// DeleteClient to delete oauth client information from database
+func (p *provider) DeleteClient(client models.Client) error {
+	result := p.db.Delete(&client)
+	if result.Error != nil {
+		log.Println("error deleting client:", result.Error)
+		return result.Error
+	}
+
+	return nil
+}
+
+// ListClients to get list of oauth clients from database
+func (p *provider) ListClients(pagination model.Pagination) (*model.Clients, error) {
+	var clients []models.Client
+	result := p.db.Limit(int(pagination.Limit)).Offset(int(pagination.Offset)).Order("created_at DESC").Find(&clients)
+	if result.Error != nil {
+		log.Println("error getting clients:", result.Error)
+		return nil, result.Error
+	}
+
+	responseClients := []*model.Client{}
+	for _, client := range clients {
+		responseClients = append(responseClients, client.AsAPIClient())
+	}
+
+	var total int64
+	totalRes := p.db.Model(&models.Client{}).Count(&total)
+	if totalRes.Error != nil {
+		return nil, totalRes.Error
+	}
+
+	paginationClone := pagination
+	paginationClone.Total = total
+
+	return &model.Clients{
+		Pagination: &paginationClone,
+		Clients:    responseClients,
+	}, nil
+}
+
+// GetClientByClientID to get oauth client information from database using client id
+func (p *provider) GetClientByClientID(clientID string) (models.Client, error) {
+	var client models.Client
+
+	result := p.db.Where("client_id = ?", clientID).First(&client)
+	if result.Error != nil {
+		return client, result.Error
+	}
+
+	return client, nil
+}
@@ -0,0 +1,115 @@
+package sql
+
+import (
+	"log"
+	"time"
+
+	"github.com/authorizerdev/authorizer/server/db/models"
+	"github.com/authorizerdev/authorizer/server/graph/model"
+	"github.com/google/uuid"
+)
+
+// AddOIDCProvider to save oidc provider information in database
+func (p *provider) AddOIDCProvider(oidcProvider models.OIDCProvider) (models.OIDCProvider, error) {
+	if oidcProvider.ID == "" {
+		oidcProvider.ID = uuid.New().String()
+	}
+
+	oidcProvider.Key = oidcProvider.ID
+	result := p.db.Create(&oidcProvider)
+	if result.Error != nil {
+		log.Println("error adding oidc provider:", result.Error)
+		return oidcProvider, result.Error
+	}
+
+	return oidcProvider, nil
+}
+
+// UpdateOIDCProvider to update oidc provider information in database
+func (p *provider) UpdateOIDCProvider(oidcProvider models.OIDCProvider) (models.OIDCProvider, error) {
+	oidcProvider.UpdatedAt = time.Now().Unix()
+
+	result := p.db.Save(&oidcProvider)
+	if result.Error != nil {
+		log.Println("error updating oidc provider:", result.Error)
+		return oidcProvider, result.Error
+	}
+
+	return oidcProvider, nil
+}
+
+// DeleteOIDCProvider to delete oidc provider information from database
+func (p *provider) DeleteOIDCProvider(oidcProvider models.OIDCProvider) error {
+	result := p.db.Delete(&oidcProvider)
+	if result.Error != nil {
+		log.Println("error deleting oidc provider:", result.Error)
+		return result.Error
+	}
+
+	return nil
+}
+
+// ListOIDCProviders to get list of oidc providers from database
+func (p *provider) ListOIDCProviders(pagination model.Pagination) (*model.OIDCProviders, error) {
+	var oidcProviders []models.OIDCProvider
+	result := p.db.Limit(int(pagination.Limit)).Offset(int(pagination.Offset)).Order("created_at DESC").Find(&oidcProviders)
+	if result.Error != nil {
+		log.Println("error getting oidc providers:", result.Error)
+		return nil, result.Error
+	}
+
+	responseOIDCProviders := []*model.OIDCProvider{}
+	for i := range oidcProviders {
+		responseOIDCProviders = append(responseOIDCProviders, oidcProviders[i].AsAPIOIDCProvider())
+	}
+
+	var total int64
+	totalRes := p.db.Model(&models.OIDCProvider{}).Count(&total)
+	if totalRes.Error != nil {
+		return nil, totalRes.Error
+	}
+
+	paginationClone := pagination
+	paginationClone.Total = total
+
+	return &model.OIDCProviders{
+		Pagination:    &paginationClone,
+		OidcProviders: responseOIDCProviders,
+	}, nil
+}
+
+// ListEnabledOIDCProviders to get list of enabled oidc providers from database
+func (p *provider) ListEnabledOIDCProviders() ([]models.OIDCProvider, error) {
+	var oidcProviders []models.OIDCProvider
+
+	result := p.db.Where("enabled = ?", true).Order("created_at ASC").Find(&oidcProviders)
+	if result.Error != nil {
+		return oidcProviders, result.Error
+	}
+
+	return oidcProviders, nil
+}
+
+// GetOIDCProviderByID to get oidc provider information from database using id
+func (p *provider) GetOIDCProviderByID(id string) (models.OIDCProvider, error) {
+	var oidcProvider models.OIDCProvider
+
+	result := p.db.Where("id = ?", id).First(&oidcProvider)
+	if result.Error != nil {
+		return oidcProvider, result.Error
+	}
+
+	return oidcProvider, nil
+}
+
+// GetOIDCProviderByName to get oidc provider information from database using name
+func (p *provider) GetOIDCProviderByName(name string) (models.OIDCProvider, error) {
+	var oidcProvider models.OIDCProvider
+
+	result := p.db.Where("name = ?", name).First(&oidcProvider)
+	if result.Error != nil {
+		return oidcProvider, result.Error
+	}
+
+	return oidcProvider, nil
+}
@@ -36,3 +36,58 @@ func (p *provider) DeleteSession(userId string) error {
 	}
 	return nil
 }
+
+// UpdateSession to update session information in database
+func (p *provider) UpdateSession(session models.Session) (models.Session, error) {
+	result := p.db.Save(&session)
+	if result.Error != nil {
+		log.Println("error updating session:", result.Error)
+		return session, result.Error
+	}
+
+	return session, nil
+}
+
+// DeleteSessionByID to delete single session information from database
+func (p *provider) DeleteSessionByID(sessionID string) error {
+	result := p.db.Where("id = ?", sessionID).Delete(&models.Session{})
+	if result.Error != nil {
+		log.Println(`error deleting session:`, result.Error)
+		return result.Error
+	}
+
+	return nil
+}
+
+// ListSessionsByUserID to get list of sessions of user from database
+func (p *provider) ListSessionsByUserID(userID string) ([]models.Session, error) {
+	var sessions []models.Session
+	result := p.db.Where("user_id = ?", userID).Order("created_at DESC").Find(&sessions)
+	if result.Error != nil {
+		return sessions, result.Error
+	}
+
+	return sessions, nil
+}
+
+// GetSessionByID to get session information from database using session id
+func (p *provider) GetSessionByID(sessionID string) (models.Session, error) {
+	var session models.Session
+	result := p.db.Where("id = ?", sessionID).First(&session)
+	if result.Error != nil {
+		return session, result.Error
+	}
+
+	return session, nil
+}
+
+// GetSessionByFamilyID to get session information from database using refresh token family id
+func (p *provider) GetSessionByFamilyID(familyID string) (models.Session, error) {
+	var session models.Session
+	result := p.db.Where("family_id = ?", familyID).Order("created_at DESC").First(&session)
+	if result.Error != nil {
+		return session, result.Error
+	}
+
+	return session, nil
+}
@@ -46,7 +46,7 @@ func NewProvider() (*provider, error) {
 		return nil, err
 	}
 
-	sqlDB.AutoMigrate(&models.User{}, &models.VerificationRequest{}, &models.Session{}, &models.Env{})
+	sqlDB.AutoMigrate(&models.User{}, &models.VerificationRequest{}, &models.Session{}, &models.Env{}, &models.Client{}, &models.WebauthnCredential{}, &models.AuditEvent{}, &models.Webhook{}, &models.WebhookDelivery{}, &models.OIDCProvider{})
 	return &provider{
 		db: sqlDB,
 	}, nil
@@ -52,6 +52,25 @@ func (p *provider) UpdateUser(user models.User) (models.User, error) {
 	return user, nil
 }
 
+// UpdateUserTotpBackupCodes to update totp backup codes of user in database, only if they are not changed since the user was read.
+// It returns false if they were changed, e.g. the same backup code was used by parallel request
+func (p *provider) UpdateUserTotpBackupCodes(user models.User, backupCodes string) (bool, error) {
+	if user.TotpBackupCodes == nil {
+		return false, nil
+	}
+
+	result := p.db.Model(&models.User{}).Where("id = ? AND totp_backup_codes = ?", user.ID, *user.TotpBackupCodes).Updates(map[string]interface{}{
+		"totp_backup_codes": backupCodes,
+		"updated_at":        time.Now().Unix(),
+	})
+	if result.Error != nil {
+		log.Println("error updating user totp backup codes:", result.Error)
+		return false, result.Error
+	}
+
+	return result.RowsAffected == 1, nil
+}
+
 // DeleteUser to delete user information from database
 func (p *provider) DeleteUser(user models.User) error {
 	result := p.db.Delete(&user)
@@ -105,6 +124,18 @@ func (p *provider) GetUserByEmail(email string) (models.User, error) {
 	return user, nil
 }
 
+// GetUserByVerifiedPhoneNumber to get information of the user who has verified the phone number
+func (p *provider) GetUserByVerifiedPhoneNumber(phoneNumber string) (models.User, error) {
+	var user models.User
+	result := p.db.Where("phone_number = ? AND phone_number_verified_at IS NOT NULL", phoneNumber).First(&user)
+
+	if result.Error != nil {
+		return user, result.Error
+	}
+
+	return user, nil
+}
+
 // GetUserByID to get user information from database using user ID
 func (p *provider) GetUserByID(id string) (models.User, error) {
 	var user models.User
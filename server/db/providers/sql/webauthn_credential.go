@@ -0,0 +1,73 @@
+package sql
+
+import (
+	"log"
+	"time"
+
+	"github.com/authorizerdev/authorizer/server/db/models"
+	"github.com/google/uuid"
+)
+
+// AddWebauthnCredential to save webauthn credential information in database
+func (p *provider) AddWebauthnCredential(credential models.WebauthnCredential) (models.WebauthnCredential, error) {
+	if credential.ID == "" {
+		credential.ID = uuid.New().String()
+	}
+
+	credential.Key = credential.ID
+	result := p.db.Create(&credential)
+	if result.Error != nil {
+		log.Println("error adding webauthn credential:", result.Error)
+		return credential, result.Error
+	}
+
+	return credential, nil
+}
+
+// UpdateWebauthnCredential to update webauthn credential information in database
+func (p *provider) UpdateWebauthnCredential(credential models.WebauthnCredential) (models.WebauthnCredential, error) {
+	credential.UpdatedAt = time.Now().Unix()
+
+	result := p.db.Save(&credential)
+	if result.Error != nil {
+		log.Println("error updating webauthn credential:", result.Error)
+		return credential, result.Error
+	}
+
+	return credential, nil
+}
+
+// DeleteWebauthnCredential to delete webauthn credential information from database
+func (p *provider) DeleteWebauthnCredential(credential models.WebauthnCredential) error {
+	result := p.db.Delete(&credential)
+	if result.Error != nil {
+		log.Println("error deleting webauthn credential:", result.Error)
+		return result.Error
+	}
+
+	return nil
+}
+
+// ListWebauthnCredentialsByUserID to get list of webauthn credentials of user from database
+func (p *provider) ListWebauthnCredentialsByUserID(userID string) ([]models.WebauthnCredential, error) {
+	var credentials []models.WebauthnCredential
+	result := p.db.Where("user_id = ?", userID).Order("created_at DESC").Find(&credentials)
+	if result.Error != nil {
+		log.Println("error getting webauthn credentials:", result.Error)
+		return nil, result.Error
+	}
+
+	return credentials, nil
+}
+
+// GetWebauthnCredentialByCredentialID to get webauthn credential information from database using credential id
+func (p *provider) GetWebauthnCredentialByCredentialID(credentialID string) (models.WebauthnCredential, error) {
+	var credential models.WebauthnCredential
+
+	result := p.db.Where("credential_id = ?", credentialID).First(&credential)
+	if result.Error != nil {
+		return credential, result.Error
+	}
+
+	return credential, nil
+}
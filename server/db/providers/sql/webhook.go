@@ -0,0 +1,103 @@
+package sql
+
+import (
+	"log"
+	"time"
+
+	"github.com/authorizerdev/authorizer/server/db/models"
+	"github.com/authorizerdev/authorizer/server/graph/model"
+	"github.com/google/uuid"
+)
+
+// AddWebhook to save webhook information in database
+func (p *provider) AddWebhook(webhook models.Webhook) (models.Webhook, error) {
+	if webhook.ID == "" {
+		webhook.ID = uuid.New().String()
+	}
+
+	webhook.Key = webhook.ID
+	result := p.db.Create(&webhook)
+	if result.Error != nil {
+		log.Println("error adding webhook:", result.Error)
+		return webhook, result.Error
+	}
+
+	return webhook, nil
+}
+
+// UpdateWebhook to update webhook information in database
+func (p *provider) UpdateWebhook(webhook models.Webhook) (models.Webhook, error) {
+	webhook.UpdatedAt = time.Now().Unix()
+
+	result := p.db.Save(&webhook)
+	if result.Error != nil {
+		log.Println("error updating webhook:", result.Error)
+		return webhook, result.Error
+	}
+
+	return webhook, nil
+}
+
+// DeleteWebhook to delete webhook information from database
+func (p *provider) DeleteWebhook(webhook models.Webhook) error {
+	result := p.db.Delete(&webhook)
+	if result.Error != nil {
+		log.Println("error deleting webhook:", result.Error)
+		return result.Error
+	}
+
+	return nil
+}
+
+// ListWebhooks to get list of webhooks from database
+func (p *provider) ListWebhooks(pagination model.Pagination) (*model.Webhooks, error) {
+	var webhooks []models.Webhook
+	result := p.db.Limit(int(pagination.Limit)).Offset(int(pagination.Offset)).Order("created_at DESC").Find(&webhooks)
+	if result.Error != nil {
+		log.Println("error getting webhooks:", result.Error)
+		return nil, result.Error
+	}
+
+	responseWebhooks := []*model.Webhook{}
+	for i := range webhooks {
+		responseWebhooks = append(responseWebhooks, webhooks[i].AsAPIWebhook())
+	}
+
+	var total int64
+	totalRes := p.db.Model(&models.Webhook{}).Count(&total)
+	if totalRes.Error != nil {
+		return nil, totalRes.Error
+	}
+
+	paginationClone := pagination
+	paginationClone.Total = total
+
+	return &model.Webhooks{
+		Pagination: &paginationClone,
+		Webhooks:   responseWebhooks,
+	}, nil
+}
+
+// GetWebhookByID to get webhook information from database using webhook id
+func (p *provider) GetWebhookByID(id string) (models.Webhook, error) {
+	var webhook models.Webhook
+
+	result := p.db.Where("id = ?", id).First(&webhook)
+	if result.Error != nil {
+		return webhook, result.Error
+	}
+
+	return webhook, nil
+}
+
+// GetWebhooksByEventName to get list of webhooks of event from database
+func (p *provider) GetWebhooksByEventName(eventName string) ([]models.Webhook, error) {
+	var webhooks []models.Webhook
+
+	result := p.db.Where("event_name = ?", eventName).Find(&webhooks)
+	if result.Error != nil {
+		return webhooks, result.Error
+	}
+
+	return webhooks, nil
+}
@@ -0,0 +1,91 @@
+package sql
+
+import (
+	"log"
+	"time"
+
+	"github.com/authorizerdev/authorizer/server/constants"
+	"github.com/authorizerdev/authorizer/server/db/models"
+	"github.com/authorizerdev/authorizer/server/graph/model"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// AddWebhookDelivery to save webhook delivery in database
+func (p *provider) AddWebhookDelivery(delivery models.WebhookDelivery) (models.WebhookDelivery, error) {
+	if delivery.ID == "" {
+		delivery.ID = uuid.New().String()
+	}
+
+	delivery.Key = delivery.ID
+	result := p.db.Create(&delivery)
+	if result.Error != nil {
+		log.Println("error adding webhook delivery:", result.Error)
+		return delivery, result.Error
+	}
+
+	return delivery, nil
+}
+
+// UpdateWebhookDelivery to update webhook delivery in database
+func (p *provider) UpdateWebhookDelivery(delivery models.WebhookDelivery) (models.WebhookDelivery, error) {
+	delivery.UpdatedAt = time.Now().Unix()
+
+	result := p.db.Save(&delivery)
+	if result.Error != nil {
+		log.Println("error updating webhook delivery:", result.Error)
+		return delivery, result.Error
+	}
+
+	return delivery, nil
+}
+
+// ListWebhookDeliveries to get list of webhook deliveries from database, filtered by webhook id if not empty
+func (p *provider) ListWebhookDeliveries(pagination model.Pagination, webhookID string) (*model.WebhookDeliveries, error) {
+	var deliveries []models.WebhookDelivery
+	result := filterWebhookDeliveries(p.db, webhookID).Limit(int(pagination.Limit)).Offset(int(pagination.Offset)).Order("created_at DESC").Find(&deliveries)
+	if result.Error != nil {
+		log.Println("error getting webhook deliveries:", result.Error)
+		return nil, result.Error
+	}
+
+	responseDeliveries := []*model.WebhookDelivery{}
+	for i := range deliveries {
+		responseDeliveries = append(responseDeliveries, deliveries[i].AsAPIWebhookDelivery())
+	}
+
+	var total int64
+	totalRes := filterWebhookDeliveries(p.db.Model(&models.WebhookDelivery{}), webhookID).Count(&total)
+	if totalRes.Error != nil {
+		return nil, totalRes.Error
+	}
+
+	paginationClone := pagination
+	paginationClone.Total = total
+
+	return &model.WebhookDeliveries{
+		Pagination:        &paginationClone,
+		WebhookDeliveries: responseDeliveries,
+	}, nil
+}
+
+// ListPendingWebhookDeliveries to get list of pending webhook deliveries due for next attempt at given time from database
+func (p *provider) ListPendingWebhookDeliveries(now int64, limit int64) ([]models.WebhookDelivery, error) {
+	var deliveries []models.WebhookDelivery
+
+	result := p.db.Where("status = ? AND next_attempt_at <= ?", constants.WebhookDeliveryStatusPending, now).Order("next_attempt_at ASC").Limit(int(limit)).Find(&deliveries)
+	if result.Error != nil {
+		return deliveries, result.Error
+	}
+
+	return deliveries, nil
+}
+
+// filterWebhookDeliveries adds the where condition of webhook id to the query
+func filterWebhookDeliveries(query *gorm.DB, webhookID string) *gorm.DB {
+	if webhookID != "" {
+		query = query.Where("webhook_id = ?", webhookID)
+	}
+
+	return query
+}
@@ -0,0 +1,32 @@
+package env
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/authorizerdev/authorizer/server/constants"
+	"github.com/authorizerdev/authorizer/server/envstore"
+)
+
+// defaultAuditLogRetentionDays is the number of days audit events are kept when AUDIT_LOG_RETENTION_DAYS is not set
+const defaultAuditLogRetentionDays = 90
+
+// ValidateAuditLogRetention validates the audit log retention env of the given env store data
+func ValidateAuditLogRetention(storeData envstore.Store) error {
+	value := storeData.StringEnv[constants.EnvKeyAuditLogRetentionDays]
+	if value == "" {
+		return nil
+	}
+
+	number, err := strconv.Atoi(value)
+	if err != nil || number < 0 {
+		return fmt.Errorf("invalid %s %s, it should be a non negative number", constants.EnvKeyAuditLogRetentionDays, value)
+	}
+
+	return nil
+}
+
+// GetAuditLogRetentionDays returns the number of days audit events are kept. 0 means they are kept forever
+func GetAuditLogRetentionDays() int {
+	return getNumber(constants.EnvKeyAuditLogRetentionDays, defaultAuditLogRetentionDays)
+}
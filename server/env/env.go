@@ -112,6 +112,14 @@ func InitEnv() {
 		envData.StringEnv[constants.EnvKeySenderEmail] = os.Getenv("SENDER_EMAIL")
 	}
 
+	if envData.StringEnv[constants.EnvKeySmsWebhookURL] == "" {
+		envData.StringEnv[constants.EnvKeySmsWebhookURL] = os.Getenv("SMS_WEBHOOK_URL")
+	}
+
+	if envData.StringEnv[constants.EnvKeySmsWebhookAuthorization] == "" {
+		envData.StringEnv[constants.EnvKeySmsWebhookAuthorization] = os.Getenv("SMS_WEBHOOK_AUTHORIZATION")
+	}
+
 	if envData.StringEnv[constants.EnvKeyJwtSecret] == "" {
 		envData.StringEnv[constants.EnvKeyJwtSecret] = os.Getenv("JWT_SECRET")
 		if envData.StringEnv[constants.EnvKeyJwtSecret] == "" {
@@ -126,6 +134,118 @@ func InitEnv() {
 		}
 	}
 
+	if envData.StringEnv[constants.EnvKeyJwtPrivateKey] == "" {
+		envData.StringEnv[constants.EnvKeyJwtPrivateKey] = os.Getenv("JWT_PRIVATE_KEY")
+	}
+
+	if envData.StringEnv[constants.EnvKeyJwtPublicKey] == "" {
+		envData.StringEnv[constants.EnvKeyJwtPublicKey] = os.Getenv("JWT_PUBLIC_KEY")
+	}
+
+	if envData.StringEnv[constants.EnvKeyClientID] == "" {
+		envData.StringEnv[constants.EnvKeyClientID] = os.Getenv("CLIENT_ID")
+		if envData.StringEnv[constants.EnvKeyClientID] == "" {
+			envData.StringEnv[constants.EnvKeyClientID] = uuid.New().String()
+		}
+	}
+
+	if len(envData.SliceEnv[constants.EnvKeyClientRedirectURIs]) == 0 {
+		envData.SliceEnv[constants.EnvKeyClientRedirectURIs] = []string{}
+		for _, value := range strings.Split(os.Getenv(constants.EnvKeyClientRedirectURIs), ",") {
+			if strings.TrimSpace(value) != "" {
+				envData.SliceEnv[constants.EnvKeyClientRedirectURIs] = append(envData.SliceEnv[constants.EnvKeyClientRedirectURIs], strings.TrimSpace(value))
+			}
+		}
+	}
+
+	if envData.StringEnv[constants.EnvKeyJwtKeyID] == "" {
+		envData.StringEnv[constants.EnvKeyJwtKeyID] = os.Getenv("JWT_KEY_ID")
+		// key configured before the key ring existed signs tokens without kid header
+		if envData.StringEnv[constants.EnvKeyJwtKeyID] == "" {
+			envData.StringEnv[constants.EnvKeyJwtKeyID] = LegacyJwtKeyID
+		}
+	}
+
+	if envData.StringEnv[constants.EnvKeyJwtKeyGracePeriod] == "" {
+		envData.StringEnv[constants.EnvKeyJwtKeyGracePeriod] = os.Getenv("JWT_KEY_GRACE_PERIOD")
+	}
+
+	if envData.StringEnv[constants.EnvKeyCustomAccessTokenScript] == "" {
+		envData.StringEnv[constants.EnvKeyCustomAccessTokenScript] = os.Getenv("CUSTOM_ACCESS_TOKEN_SCRIPT")
+	}
+
+	for _, key := range []string{constants.EnvKeyAccessTokenExpiryTime, constants.EnvKeyRefreshTokenExpiryTime, constants.EnvKeyVerificationTokenExpiryTime, constants.EnvKeySessionInactivityTimeout} {
+		if envData.StringEnv[key] == "" {
+			envData.StringEnv[key] = strings.TrimSpace(os.Getenv(key))
+		}
+	}
+
+	for _, key := range []string{constants.EnvKeyRoleAccessTokenExpiryTimes, constants.EnvKeyRoleRefreshTokenExpiryTimes} {
+		if len(envData.SliceEnv[key]) == 0 {
+			envData.SliceEnv[key] = []string{}
+			for _, value := range strings.Split(os.Getenv(key), ",") {
+				if strings.TrimSpace(value) != "" {
+					envData.SliceEnv[key] = append(envData.SliceEnv[key], strings.TrimSpace(value))
+				}
+			}
+		}
+	}
+
+	if err := ValidateTokenExpiryTimes(envData); err != nil {
+		panic(err)
+	}
+
+	for _, key := range []string{constants.EnvKeyAccountLockoutThreshold, constants.EnvKeyAccountLockoutDuration} {
+		if envData.StringEnv[key] == "" {
+			envData.StringEnv[key] = strings.TrimSpace(os.Getenv(key))
+		}
+	}
+
+	if len(envData.SliceEnv[constants.EnvKeyRateLimits]) == 0 {
+		envData.SliceEnv[constants.EnvKeyRateLimits] = []string{}
+		for _, value := range strings.Split(os.Getenv(constants.EnvKeyRateLimits), ",") {
+			if strings.TrimSpace(value) != "" {
+				envData.SliceEnv[constants.EnvKeyRateLimits] = append(envData.SliceEnv[constants.EnvKeyRateLimits], strings.TrimSpace(value))
+			}
+		}
+	}
+
+	if err := ValidateRateLimits(envData); err != nil {
+		panic(err)
+	}
+
+	for _, key := range []string{constants.EnvKeyPasswordMinLength, constants.EnvKeyPasswordHistorySize, constants.EnvKeyBreachedPasswordsFile, constants.EnvKeyPasswordHashAlgorithm} {
+		if envData.StringEnv[key] == "" {
+			envData.StringEnv[key] = strings.TrimSpace(os.Getenv(key))
+		}
+	}
+
+	if len(envData.SliceEnv[constants.EnvKeyPasswordCharacterClasses]) == 0 {
+		envData.SliceEnv[constants.EnvKeyPasswordCharacterClasses] = []string{}
+		for _, value := range strings.Split(os.Getenv(constants.EnvKeyPasswordCharacterClasses), ",") {
+			if strings.TrimSpace(value) != "" {
+				envData.SliceEnv[constants.EnvKeyPasswordCharacterClasses] = append(envData.SliceEnv[constants.EnvKeyPasswordCharacterClasses], strings.ToLower(strings.TrimSpace(value)))
+			}
+		}
+	}
+
+	if err := ValidatePasswordPolicy(envData); err != nil {
+		panic(err)
+	}
+
+	if envData.StringEnv[constants.EnvKeyAuditLogRetentionDays] == "" {
+		envData.StringEnv[constants.EnvKeyAuditLogRetentionDays] = strings.TrimSpace(os.Getenv(constants.EnvKeyAuditLogRetentionDays))
+	}
+
+	if err := ValidateAuditLogRetention(envData); err != nil {
+		panic(err)
+	}
+
+	// generate key pair in case of asymmetric JWT_TYPE without keys
+	if _, err := SetJwtKeys(envData.StringEnv); err != nil {
+		panic(err)
+	}
+
 	if envData.StringEnv[constants.EnvKeyJwtRoleClaim] == "" {
 		envData.StringEnv[constants.EnvKeyJwtRoleClaim] = os.Getenv("JWT_ROLE_CLAIM")
 
@@ -169,6 +289,66 @@ func InitEnv() {
 		envData.StringEnv[constants.EnvKeyFacebookClientSecret] = os.Getenv("FACEBOOK_CLIENT_SECRET")
 	}
 
+	if envData.StringEnv[constants.EnvKeyAppleClientID] == "" {
+		envData.StringEnv[constants.EnvKeyAppleClientID] = os.Getenv("APPLE_CLIENT_ID")
+	}
+
+	if envData.StringEnv[constants.EnvKeyAppleTeamID] == "" {
+		envData.StringEnv[constants.EnvKeyAppleTeamID] = os.Getenv("APPLE_TEAM_ID")
+	}
+
+	if envData.StringEnv[constants.EnvKeyAppleKeyID] == "" {
+		envData.StringEnv[constants.EnvKeyAppleKeyID] = os.Getenv("APPLE_KEY_ID")
+	}
+
+	if envData.StringEnv[constants.EnvKeyApplePrivateKey] == "" {
+		envData.StringEnv[constants.EnvKeyApplePrivateKey] = os.Getenv("APPLE_PRIVATE_KEY")
+	}
+
+	if envData.StringEnv[constants.EnvKeyMicrosoftClientID] == "" {
+		envData.StringEnv[constants.EnvKeyMicrosoftClientID] = os.Getenv("MICROSOFT_CLIENT_ID")
+	}
+
+	if envData.StringEnv[constants.EnvKeyMicrosoftClientSecret] == "" {
+		envData.StringEnv[constants.EnvKeyMicrosoftClientSecret] = os.Getenv("MICROSOFT_CLIENT_SECRET")
+	}
+
+	if envData.StringEnv[constants.EnvKeyMicrosoftTenantID] == "" {
+		envData.StringEnv[constants.EnvKeyMicrosoftTenantID] = os.Getenv("MICROSOFT_TENANT_ID")
+	}
+
+	if envData.StringEnv[constants.EnvKeyGitlabClientID] == "" {
+		envData.StringEnv[constants.EnvKeyGitlabClientID] = os.Getenv("GITLAB_CLIENT_ID")
+	}
+
+	if envData.StringEnv[constants.EnvKeyGitlabClientSecret] == "" {
+		envData.StringEnv[constants.EnvKeyGitlabClientSecret] = os.Getenv("GITLAB_CLIENT_SECRET")
+	}
+
+	if envData.StringEnv[constants.EnvKeyLinkedinClientID] == "" {
+		envData.StringEnv[constants.EnvKeyLinkedinClientID] = os.Getenv("LINKEDIN_CLIENT_ID")
+	}
+
+	if envData.StringEnv[constants.EnvKeyLinkedinClientSecret] == "" {
+		envData.StringEnv[constants.EnvKeyLinkedinClientSecret] = os.Getenv("LINKEDIN_CLIENT_SECRET")
+	}
+
+	if envData.StringEnv[constants.EnvKeyDiscordClientID] == "" {
+		envData.StringEnv[constants.EnvKeyDiscordClientID] = os.Getenv("DISCORD_CLIENT_ID")
+	}
+
+	if envData.StringEnv[constants.EnvKeyDiscordClientSecret] == "" {
+		envData.StringEnv[constants.EnvKeyDiscordClientSecret] = os.Getenv("DISCORD_CLIENT_SECRET")
+	}
+
+	if envData.StringEnv[constants.EnvKeyTwitterClientID] == "" {
+		envData.StringEnv[constants.EnvKeyTwitterClientID] = os.Getenv("TWITTER_CLIENT_ID")
+	}
+
+	if envData.StringEnv[constants.EnvKeyTwitterClientSecret] == "" {
+		envData.StringEnv[constants.EnvKeyTwitterClientSecret] = os.Getenv("TWITTER_CLIENT_SECRET")
+	}
+
 	if envData.StringEnv[constants.EnvKeyResetPasswordURL] == "" {
 		envData.StringEnv[constants.EnvKeyResetPasswordURL] = strings.TrimPrefix(os.Getenv("RESET_PASSWORD_URL"), "/")
 	}
@@ -177,11 +357,16 @@ func InitEnv() {
 	envData.BoolEnv[constants.EnvKeyDisableEmailVerification] = os.Getenv("DISABLE_EMAIL_VERIFICATION") == "true"
 	envData.BoolEnv[constants.EnvKeyDisableMagicLinkLogin] = os.Getenv("DISABLE_MAGIC_LINK_LOGIN") == "true"
 	envData.BoolEnv[constants.EnvKeyDisableLoginPage] = os.Getenv("DISABLE_LOGIN_PAGE") == "true"
+	envData.BoolEnv[constants.EnvKeyDisableSecurityAlertEmail] = os.Getenv("DISABLE_SECURITY_ALERT_EMAIL") == "true"
+	envData.BoolEnv[constants.EnvKeyDisableWebauthn] = os.Getenv("DISABLE_WEBAUTHN") == "true"
+	envData.BoolEnv[constants.EnvKeyDisablePasswordEmailCheck] = os.Getenv("DISABLE_PASSWORD_EMAIL_CHECK") == "true"
+	envData.BoolEnv[constants.EnvKeyDisablePhoneLogin] = os.Getenv("DISABLE_PHONE_LOGIN") == "true"
 
 	// no need to add nil check as its already done above
 	if envData.StringEnv[constants.EnvKeySmtpHost] == "" || envData.StringEnv[constants.EnvKeySmtpUsername] == "" || envData.StringEnv[constants.EnvKeySmtpPassword] == "" || envData.StringEnv[constants.EnvKeySenderEmail] == "" && envData.StringEnv[constants.EnvKeySmtpPort] == "" {
 		envData.BoolEnv[constants.EnvKeyDisableEmailVerification] = true
 		envData.BoolEnv[constants.EnvKeyDisableMagicLinkLogin] = true
+		envData.BoolEnv[constants.EnvKeyDisableSecurityAlertEmail] = true
 	}
 
 	if envData.BoolEnv[constants.EnvKeyDisableEmailVerification] {
@@ -0,0 +1,399 @@
+package env
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/authorizerdev/authorizer/server/constants"
+	"github.com/authorizerdev/authorizer/server/crypto"
+	"github.com/authorizerdev/authorizer/server/envstore"
+	"github.com/authorizerdev/authorizer/server/graph/model"
+	"github.com/authorizerdev/authorizer/server/utils"
+	"github.com/google/uuid"
+)
+
+const (
+	// defaultJwtKeyGracePeriod is the duration for which retired keys are valid for verification
+	defaultJwtKeyGracePeriod = 7 * 24 * time.Hour
+	// jwtKeySecretLength is the number of random bytes of generated HMAC secret
+	jwtKeySecretLength = 32
+	// LegacyJwtKeyID is the id of signing key configured before the key ring existed.
+	// Tokens signed with it don't have kid header, hence they are verified with this key
+	// even after it is retired
+	LegacyJwtKeyID = "legacy"
+)
+
+// JwtKeyEnvs are the env keys used for signing & verifying JWT tokens
+var JwtKeyEnvs = []string{
+	constants.EnvKeyJwtKeyID,
+	constants.EnvKeyJwtType,
+	constants.EnvKeyJwtSecret,
+	constants.EnvKeyJwtPrivateKey,
+	constants.EnvKeyJwtPublicKey,
+	constants.EnvKeyJwtKeys,
+}
+
+// jwtKeyRingCache keeps the parsed key ring, so that it is not parsed for every token
+var jwtKeyRingCache = struct {
+	sync.Mutex
+	data string
+	keys []JwtKey
+}{}
+
+// SetJwtKeys makes sure that asymmetric JWT_TYPE has a valid key pair.
+// It generates new key pair in case JWT_PRIVATE_KEY is not present and
+// always derives JWT_PUBLIC_KEY from the private key.
+// Returns true if any of the key was changed
+func SetJwtKeys(stringEnv map[string]string) (bool, error) {
+	algo := stringEnv[constants.EnvKeyJwtType]
+	if !crypto.IsValidJwtAlgo(algo) {
+		return false, fmt.Errorf("invalid JWT_TYPE %s", algo)
+	}
+
+	if !crypto.IsAsymmetricAlgo(algo) {
+		return false, nil
+	}
+
+	if stringEnv[constants.EnvKeyJwtPrivateKey] == "" {
+		privateKey, publicKey, err := crypto.NewKeyPair(algo)
+		if err != nil {
+			return false, err
+		}
+
+		stringEnv[constants.EnvKeyJwtPrivateKey] = privateKey
+		stringEnv[constants.EnvKeyJwtPublicKey] = publicKey
+		return true, nil
+	}
+
+	publicKey, err := crypto.GetPublicKeyFromPrivateKey(algo, stringEnv[constants.EnvKeyJwtPrivateKey])
+	if err != nil {
+		return false, fmt.Errorf("invalid JWT_PRIVATE_KEY for %s: %s", algo, err.Error())
+	}
+
+	if stringEnv[constants.EnvKeyJwtPublicKey] != publicKey {
+		stringEnv[constants.EnvKeyJwtPublicKey] = publicKey
+		return true, nil
+	}
+
+	return false, nil
+}
+
+// JwtKey is the signing key information stored in the key ring
+type JwtKey struct {
+	ID         string `json:"id"`
+	Type       string `json:"type"`
+	Secret     string `json:"secret,omitempty"`
+	PrivateKey string `json:"private_key,omitempty"`
+	PublicKey  string `json:"public_key,omitempty"`
+	Status     string `json:"status"`
+	CreatedAt  int64  `json:"created_at,omitempty"`
+	ExpiresAt  int64  `json:"expires_at,omitempty"`
+}
+
+// GetActiveJwtKey returns the key used for signing new tokens
+func GetActiveJwtKey(stringEnv map[string]string) JwtKey {
+	return JwtKey{
+		ID:         stringEnv[constants.EnvKeyJwtKeyID],
+		Type:       stringEnv[constants.EnvKeyJwtType],
+		Secret:     stringEnv[constants.EnvKeyJwtSecret],
+		PrivateKey: stringEnv[constants.EnvKeyJwtPrivateKey],
+		PublicKey:  stringEnv[constants.EnvKeyJwtPublicKey],
+		Status:     constants.JwtKeyStatusActive,
+	}
+}
+
+// GetJwtKeys returns the pending & retired keys of the key ring.
+// Retired keys whose grace period has ended are omitted
+func GetJwtKeys(stringEnv map[string]string) []JwtKey {
+	keys := []JwtKey{}
+	if stringEnv[constants.EnvKeyJwtKeys] == "" {
+		return keys
+	}
+
+	storedKeys, err := parseJwtKeys(stringEnv[constants.EnvKeyJwtKeys])
+	if err != nil {
+		log.Println("error parsing jwt key ring:", err)
+		return keys
+	}
+
+	now := time.Now().Unix()
+	for _, key := range storedKeys {
+		if key.Status == constants.JwtKeyStatusRetired && key.ExpiresAt <= now {
+			continue
+		}
+		keys = append(keys, key)
+	}
+
+	return keys
+}
+
+// GetJwtKeyByID returns the key that can be used for verifying the token signed with given key id.
+// Empty key id is treated as legacy key, as tokens signed before the key ring existed don't have kid header
+func GetJwtKeyByID(stringEnv map[string]string, id string) (JwtKey, error) {
+	if id == "" {
+		id = LegacyJwtKeyID
+	}
+
+	activeKey := GetActiveJwtKey(stringEnv)
+	if id == activeKey.ID {
+		return activeKey, nil
+	}
+
+	for _, key := range GetJwtKeys(stringEnv) {
+		if key.ID == id && key.Status == constants.JwtKeyStatusRetired {
+			return key, nil
+		}
+	}
+
+	return JwtKey{}, fmt.Errorf("invalid key id %s", id)
+}
+
+// GetJwtKeyGracePeriod returns the duration for which retired keys are used for verification
+func GetJwtKeyGracePeriod(stringEnv map[string]string) time.Duration {
+	gracePeriod, err := time.ParseDuration(stringEnv[constants.EnvKeyJwtKeyGracePeriod])
+	if err != nil || gracePeriod < 0 {
+		return defaultJwtKeyGracePeriod
+	}
+
+	return gracePeriod
+}
+
+// AddJwtKey generates a new pending key for the given algo and adds it to the key ring
+func AddJwtKey(stringEnv map[string]string, algo string) (JwtKey, error) {
+	if !crypto.IsValidJwtAlgo(algo) {
+		return JwtKey{}, fmt.Errorf("invalid jwt type %s", algo)
+	}
+
+	key := JwtKey{
+		ID:        uuid.New().String(),
+		Type:      algo,
+		Status:    constants.JwtKeyStatusPending,
+		CreatedAt: time.Now().Unix(),
+	}
+
+	if crypto.IsHMACAlgo(algo) {
+		secret, err := utils.GenerateRandomString(jwtKeySecretLength)
+		if err != nil {
+			return JwtKey{}, err
+		}
+		key.Secret = secret
+	} else {
+		privateKey, publicKey, err := crypto.NewKeyPair(algo)
+		if err != nil {
+			return JwtKey{}, err
+		}
+		key.PrivateKey = privateKey
+		key.PublicKey = publicKey
+	}
+
+	keys := append(GetJwtKeys(stringEnv), key)
+	if err := setJwtKeys(stringEnv, keys); err != nil {
+		return JwtKey{}, err
+	}
+
+	return key, nil
+}
+
+// PromoteJwtKey makes the pending key active.
+// Previously active key is retired and used for verification till the grace period ends
+func PromoteJwtKey(stringEnv map[string]string, id string) error {
+	keys := GetJwtKeys(stringEnv)
+	index := -1
+	for i, key := range keys {
+		if key.ID == id && key.Status == constants.JwtKeyStatusPending {
+			index = i
+			break
+		}
+	}
+
+	if index == -1 {
+		return fmt.Errorf("pending key %s not found", id)
+	}
+
+	key := keys[index]
+	keys = append(keys[:index], keys[index+1:]...)
+	keys = retireJwtKey(stringEnv, GetActiveJwtKey(stringEnv), keys)
+
+	stringEnv[constants.EnvKeyJwtKeyID] = key.ID
+	stringEnv[constants.EnvKeyJwtType] = key.Type
+	if crypto.IsHMACAlgo(key.Type) {
+		stringEnv[constants.EnvKeyJwtSecret] = key.Secret
+	} else {
+		stringEnv[constants.EnvKeyJwtPrivateKey] = key.PrivateKey
+		stringEnv[constants.EnvKeyJwtPublicKey] = key.PublicKey
+	}
+
+	return setJwtKeys(stringEnv, keys)
+}
+
+// RetireJwtKey removes the pending key from the key ring.
+// Grace period of retired key is shortened to the longest token lifetime,
+// so that tokens already signed with it keep working till they expire.
+// Use RevokeJwtKey to stop verifying them right away
+func RetireJwtKey(stringEnv map[string]string, id string) error {
+	if id == stringEnv[constants.EnvKeyJwtKeyID] {
+		return fmt.Errorf("active key cannot be retired, promote another key first")
+	}
+
+	keys := GetJwtKeys(stringEnv)
+	for i, key := range keys {
+		if key.ID != id {
+			continue
+		}
+
+		if key.Status == constants.JwtKeyStatusPending {
+			return setJwtKeys(stringEnv, append(keys[:i], keys[i+1:]...))
+		}
+
+		if expiresAt := time.Now().Add(GetMaxTokenExpiryTime()).Unix(); expiresAt < key.ExpiresAt {
+			keys[i].ExpiresAt = expiresAt
+		}
+		return setJwtKeys(stringEnv, keys)
+	}
+
+	return fmt.Errorf("key %s not found", id)
+}
+
+// RevokeJwtKey removes the pending or retired key from the key ring right away,
+// tokens signed with it are not valid anymore, e.g. in case the key was leaked
+func RevokeJwtKey(stringEnv map[string]string, id string) error {
+	if id == stringEnv[constants.EnvKeyJwtKeyID] {
+		return fmt.Errorf("active key cannot be revoked, promote another key first")
+	}
+
+	keys := GetJwtKeys(stringEnv)
+	for i, key := range keys {
+		if key.ID == id {
+			return setJwtKeys(stringEnv, append(keys[:i], keys[i+1:]...))
+		}
+	}
+
+	return fmt.Errorf("key %s not found", id)
+}
+
+// GetMaxTokenExpiryTime returns the longest lifetime of any token signed with the current config
+func GetMaxTokenExpiryTime() time.Duration {
+	maxExpiryTime := GetVerificationTokenExpiryTime()
+	for _, tokenType := range []string{constants.TokenTypeAccessToken, constants.TokenTypeRefreshToken} {
+		defaultKey, roleKey, defaultExpiryTime := constants.EnvKeyAccessTokenExpiryTime, constants.EnvKeyRoleAccessTokenExpiryTimes, defaultAccessTokenExpiryTime
+		if tokenType == constants.TokenTypeRefreshToken {
+			defaultKey, roleKey, defaultExpiryTime = constants.EnvKeyRefreshTokenExpiryTime, constants.EnvKeyRoleRefreshTokenExpiryTimes, defaultRefreshTokenExpiryTime
+		}
+
+		expiryTimes := []time.Duration{getDuration(defaultKey, defaultExpiryTime)}
+		roleExpiryTimes, _ := parseRoleExpiryTimes(envstore.EnvInMemoryStoreObj.GetSliceStoreEnvVariable(roleKey))
+		for _, roleExpiryTime := range roleExpiryTimes {
+			expiryTimes = append(expiryTimes, roleExpiryTime)
+		}
+
+		for _, expiryTime := range expiryTimes {
+			if tokenType == constants.TokenTypeRefreshToken {
+				if idleTimeout := getDuration(constants.EnvKeySessionInactivityTimeout, 0); idleTimeout > 0 && idleTimeout < expiryTime {
+					expiryTime = idleTimeout
+				}
+			}
+
+			if expiryTime > maxExpiryTime {
+				maxExpiryTime = expiryTime
+			}
+		}
+	}
+
+	return maxExpiryTime
+}
+
+// RetireChangedJwtKey moves the previously active key to the key ring in case the
+// signing key was changed directly (via _update_env or OS env), so that existing
+// tokens keep working till the grace period ends.
+// Returns true if key ring was changed
+func RetireChangedJwtKey(previousKey JwtKey, stringEnv map[string]string) (bool, error) {
+	if previousKey.ID == "" {
+		return false, nil
+	}
+
+	currentKey := GetActiveJwtKey(stringEnv)
+	if currentKey.Type == previousKey.Type {
+		if crypto.IsHMACAlgo(currentKey.Type) && currentKey.Secret == previousKey.Secret {
+			return false, nil
+		}
+
+		if !crypto.IsHMACAlgo(currentKey.Type) && currentKey.PrivateKey == previousKey.PrivateKey {
+			return false, nil
+		}
+	}
+
+	if currentKey.ID == previousKey.ID {
+		stringEnv[constants.EnvKeyJwtKeyID] = uuid.New().String()
+	}
+
+	keys := retireJwtKey(stringEnv, previousKey, GetJwtKeys(stringEnv))
+	return true, setJwtKeys(stringEnv, keys)
+}
+
+// retireJwtKey adds the given key as retired key to the list of keys
+func retireJwtKey(stringEnv map[string]string, key JwtKey, keys []JwtKey) []JwtKey {
+	gracePeriod := GetJwtKeyGracePeriod(stringEnv)
+	if key.ID == "" || gracePeriod == 0 {
+		return keys
+	}
+
+	key.Status = constants.JwtKeyStatusRetired
+	key.ExpiresAt = time.Now().Add(gracePeriod).Unix()
+	return append(keys, key)
+}
+
+// parseJwtKeys parses the key ring env, the last parsed key ring is cached
+func parseJwtKeys(data string) ([]JwtKey, error) {
+	jwtKeyRingCache.Lock()
+	defer jwtKeyRingCache.Unlock()
+	if data == jwtKeyRingCache.data && jwtKeyRingCache.keys != nil {
+		return jwtKeyRingCache.keys, nil
+	}
+
+	var keys []JwtKey
+	err := json.Unmarshal([]byte(data), &keys)
+	if err != nil {
+		return nil, err
+	}
+
+	jwtKeyRingCache.data = data
+	jwtKeyRingCache.keys = keys
+	return keys, nil
+}
+
+// setJwtKeys stores the key ring in env
+func setJwtKeys(stringEnv map[string]string, keys []JwtKey) error {
+	data, err := json.Marshal(keys)
+	if err != nil {
+		return err
+	}
+
+	stringEnv[constants.EnvKeyJwtKeys] = string(data)
+	return nil
+}
+
+// AsAPIJwtKey returns the key information without secrets
+func (key JwtKey) AsAPIJwtKey() *model.JWTKey {
+	res := &model.JWTKey{
+		ID:     key.ID,
+		Type:   key.Type,
+		Status: key.Status,
+	}
+
+	if key.PublicKey != "" {
+		res.PublicKey = &key.PublicKey
+	}
+
+	if key.CreatedAt != 0 {
+		res.CreatedAt = &key.CreatedAt
+	}
+
+	if key.ExpiresAt != 0 {
+		res.ExpiresAt = &key.ExpiresAt
+	}
+
+	return res
+}
@@ -0,0 +1,69 @@
+package env
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/authorizerdev/authorizer/server/constants"
+	"github.com/authorizerdev/authorizer/server/crypto"
+	"github.com/authorizerdev/authorizer/server/envstore"
+	"github.com/authorizerdev/authorizer/server/utils"
+)
+
+// defaultPasswordMinLength is the minimum length of password when PASSWORD_MIN_LENGTH is not set
+const defaultPasswordMinLength = 6
+
+// ValidatePasswordPolicy validates the password policy & hash algorithm envs of the given env store data
+func ValidatePasswordPolicy(storeData envstore.Store) error {
+	if algorithm := storeData.StringEnv[constants.EnvKeyPasswordHashAlgorithm]; algorithm != "" && !crypto.IsValidPasswordHashAlgorithm(algorithm) {
+		return fmt.Errorf("invalid %s %s, it should be one of argon2id, bcrypt & scrypt", constants.EnvKeyPasswordHashAlgorithm, algorithm)
+	}
+
+	for _, key := range []string{constants.EnvKeyPasswordMinLength, constants.EnvKeyPasswordHistorySize} {
+		value := storeData.StringEnv[key]
+		if value == "" {
+			continue
+		}
+
+		number, err := strconv.Atoi(value)
+		if err != nil || number < 0 {
+			return fmt.Errorf("invalid %s %s, it should be a non negative number", key, value)
+		}
+	}
+
+	for _, characterClass := range storeData.SliceEnv[constants.EnvKeyPasswordCharacterClasses] {
+		if !utils.StringSliceContains(constants.PasswordCharacterClasses, characterClass) {
+			return fmt.Errorf("invalid %s %s, it should be one of %v", constants.EnvKeyPasswordCharacterClasses, characterClass, constants.PasswordCharacterClasses)
+		}
+	}
+
+	if path := storeData.StringEnv[constants.EnvKeyBreachedPasswordsFile]; path != "" {
+		if _, err := os.Stat(path); err != nil {
+			return fmt.Errorf("invalid %s: %s", constants.EnvKeyBreachedPasswordsFile, err.Error())
+		}
+	}
+
+	return nil
+}
+
+// GetPasswordMinLength returns the minimum length of password
+func GetPasswordMinLength() int {
+	return getNumber(constants.EnvKeyPasswordMinLength, defaultPasswordMinLength)
+}
+
+// GetPasswordHistorySize returns the number of last passwords (including the current one)
+// that can not be reused. 0 means password history is not checked
+func GetPasswordHistorySize() int {
+	return getNumber(constants.EnvKeyPasswordHistorySize, 0)
+}
+
+// getNumber returns the non negative number env value, or default value if it is not set or invalid
+func getNumber(key string, defaultValue int) int {
+	number, err := strconv.Atoi(envstore.EnvInMemoryStoreObj.GetStringStoreEnvVariable(key))
+	if err != nil || number < 0 {
+		return defaultValue
+	}
+
+	return number
+}
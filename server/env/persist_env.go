@@ -69,6 +69,18 @@ func PersistEnv() error {
 
 		hasChanged := false
 
+		// following keys were not persisted by older versions, so persist the ones generated on init
+		for _, key := range []string{constants.EnvKeyJwtKeyID, constants.EnvKeyClientID} {
+			if storeData.StringEnv[key] == "" {
+				storeData.StringEnv[key] = envstore.EnvInMemoryStoreObj.GetStringStoreEnvVariable(key)
+				hasChanged = true
+			}
+		}
+
+		// keep the persisted signing key, so that it can be retired with grace period
+		// in case it is changed via env file or OS env
+		previousJwtKey := GetActiveJwtKey(storeData.StringEnv)
+
 		for key, value := range storeData.StringEnv {
 			if key != constants.EnvKeyEncryptionKey {
 				// check only for derivative keys
@@ -122,19 +134,37 @@ func PersistEnv() error {
 				storeData.BoolEnv[constants.EnvKeyDisableMagicLinkLogin] = true
 				hasChanged = true
 			}
+
+			if !storeData.BoolEnv[constants.EnvKeyDisableSecurityAlertEmail] {
+				storeData.BoolEnv[constants.EnvKeyDisableSecurityAlertEmail] = true
+				hasChanged = true
+			}
+		}
+
+		// make sure that key pair is present for asymmetric JWT_TYPE,
+		// as it might have been changed via env file or OS env
+		hasKeysChanged, err := SetJwtKeys(storeData.StringEnv)
+		if err != nil {
+			return err
+		}
+
+		if hasKeysChanged {
+			hasChanged = true
+		}
+
+		hasKeysChanged, err = RetireChangedJwtKey(previousJwtKey, storeData.StringEnv)
+		if err != nil {
+			return err
+		}
+
+		if hasKeysChanged {
+			hasChanged = true
 		}
 
 		envstore.EnvInMemoryStoreObj.UpdateEnvStore(storeData)
 		if hasChanged {
-			encryptedConfig, err := utils.EncryptEnvData(storeData)
-			if err != nil {
-				return err
-			}
-
-			env.EnvData = encryptedConfig
-			_, err = db.Provider.UpdateEnv(env)
+			err = UpdatePersistedEnv(storeData)
 			if err != nil {
-				log.Println("error updating config:", err)
 				return err
 			}
 		}
@@ -143,3 +173,25 @@ func PersistEnv() error {
 
 	return nil
 }
+
+// UpdatePersistedEnv encrypts the given env store data and saves it in the database
+func UpdatePersistedEnv(storeData envstore.Store) error {
+	env, err := db.Provider.GetEnv()
+	if err != nil {
+		return err
+	}
+
+	encryptedConfig, err := utils.EncryptEnvData(storeData)
+	if err != nil {
+		return err
+	}
+
+	env.EnvData = encryptedConfig
+	_, err = db.Provider.UpdateEnv(env)
+	if err != nil {
+		log.Println("error updating config:", err)
+		return err
+	}
+
+	return nil
+}
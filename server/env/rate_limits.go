@@ -0,0 +1,140 @@
+package env
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/authorizerdev/authorizer/server/constants"
+	"github.com/authorizerdev/authorizer/server/envstore"
+)
+
+const (
+	// defaultAccountLockoutThreshold is the number of consecutive failed passwords after which account is locked
+	defaultAccountLockoutThreshold = 5
+	// defaultAccountLockoutDuration is the duration for which account stays locked
+	defaultAccountLockoutDuration = 15 * time.Minute
+)
+
+// RateLimit is the max number of requests allowed in the window
+type RateLimit struct {
+	Limit  int64
+	Window time.Duration
+}
+
+// defaultRateLimits are the limits of action:scope, they can be overridden with RATE_LIMITS
+var defaultRateLimits = map[string]RateLimit{
+	"login:email":                       {Limit: 10, Window: time.Minute},
+	"login:ip":                          {Limit: 50, Window: time.Minute},
+	"admin_login:ip":                    {Limit: 10, Window: time.Minute},
+	"signup:ip":                         {Limit: 20, Window: 15 * time.Minute},
+	"forgot_password:email":             {Limit: 3, Window: 15 * time.Minute},
+	"forgot_password:ip":                {Limit: 20, Window: 15 * time.Minute},
+	"magic_link_login:email":            {Limit: 3, Window: 15 * time.Minute},
+	"magic_link_login:ip":               {Limit: 20, Window: 15 * time.Minute},
+	"resend_verify_email:email":         {Limit: 3, Window: 15 * time.Minute},
+	"resend_verify_email:ip":            {Limit: 20, Window: 15 * time.Minute},
+	"phone_login:email":                 {Limit: 3, Window: 15 * time.Minute},
+	"phone_login:ip":                    {Limit: 20, Window: 15 * time.Minute},
+	"send_phone_verification_otp:email": {Limit: 3, Window: 15 * time.Minute},
+	"send_phone_verification_otp:ip":    {Limit: 20, Window: 15 * time.Minute},
+	"verify_email_otp:email":            {Limit: 10, Window: 15 * time.Minute},
+	"verify_email_otp:ip":               {Limit: 50, Window: 15 * time.Minute},
+	"verify_phone_otp:email":            {Limit: 10, Window: 15 * time.Minute},
+	"verify_phone_otp:ip":               {Limit: 50, Window: 15 * time.Minute},
+	"verify_otp:email":                  {Limit: 10, Window: 15 * time.Minute},
+	"verify_otp:ip":                     {Limit: 50, Window: 15 * time.Minute},
+	"webauthn_login:ip":                 {Limit: 50, Window: time.Minute},
+}
+
+// ValidateRateLimits validates the rate limit & account lockout envs of the given env store data.
+// Rate limits are like login:ip:50/1m, and lockout threshold is number of failed passwords (0 disables lockout)
+func ValidateRateLimits(storeData envstore.Store) error {
+	if _, err := parseRateLimits(storeData.SliceEnv[constants.EnvKeyRateLimits]); err != nil {
+		return fmt.Errorf("invalid %s: %s", constants.EnvKeyRateLimits, err.Error())
+	}
+
+	if value := storeData.StringEnv[constants.EnvKeyAccountLockoutThreshold]; value != "" {
+		threshold, err := strconv.ParseInt(value, 10, 64)
+		if err != nil || threshold < 0 {
+			return fmt.Errorf("invalid %s %s, it should be a non negative number", constants.EnvKeyAccountLockoutThreshold, value)
+		}
+	}
+
+	if value := storeData.StringEnv[constants.EnvKeyAccountLockoutDuration]; value != "" {
+		duration, err := time.ParseDuration(value)
+		if err != nil || duration <= 0 {
+			return fmt.Errorf("invalid %s %s, it should be a positive duration like 15m or 1h", constants.EnvKeyAccountLockoutDuration, value)
+		}
+	}
+
+	return nil
+}
+
+// GetRateLimit returns the rate limit of action for the given scope (email or ip).
+// Limit of 0 means the action is not limited for the scope
+func GetRateLimit(action, scope string) RateLimit {
+	key := action + ":" + scope
+	rateLimits, _ := parseRateLimits(envstore.EnvInMemoryStoreObj.GetSliceStoreEnvVariable(constants.EnvKeyRateLimits))
+	if rateLimit, ok := rateLimits[key]; ok {
+		return rateLimit
+	}
+
+	return defaultRateLimits[key]
+}
+
+// GetAccountLockoutThreshold returns the number of consecutive failed passwords after which account is locked.
+// 0 means account lockout is disabled
+func GetAccountLockoutThreshold() int64 {
+	value := envstore.EnvInMemoryStoreObj.GetStringStoreEnvVariable(constants.EnvKeyAccountLockoutThreshold)
+	threshold, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || threshold < 0 {
+		return defaultAccountLockoutThreshold
+	}
+
+	return threshold
+}
+
+// GetAccountLockoutDuration returns the duration for which account stays locked
+func GetAccountLockoutDuration() time.Duration {
+	return getDuration(constants.EnvKeyAccountLockoutDuration, defaultAccountLockoutDuration)
+}
+
+// parseRateLimits parses the rate limits of format action:scope:limit/window
+func parseRateLimits(values []string) (map[string]RateLimit, error) {
+	res := map[string]RateLimit{}
+	for _, value := range values {
+		parts := strings.Split(strings.TrimSpace(value), ":")
+		if len(parts) != 3 || strings.TrimSpace(parts[0]) == "" {
+			return nil, fmt.Errorf("%s should be of format action:scope:limit/window", value)
+		}
+
+		scope := strings.TrimSpace(parts[1])
+		if scope != constants.RateLimitScopeEmail && scope != constants.RateLimitScopeIP {
+			return nil, fmt.Errorf("%s has invalid scope, it should be email or ip", value)
+		}
+
+		limitParts := strings.SplitN(strings.TrimSpace(parts[2]), "/", 2)
+		if len(limitParts) != 2 {
+			return nil, fmt.Errorf("%s should be of format action:scope:limit/window", value)
+		}
+
+		limit, err := strconv.ParseInt(strings.TrimSpace(limitParts[0]), 10, 64)
+		if err != nil || limit < 0 {
+			return nil, fmt.Errorf("%s has invalid limit", value)
+		}
+
+		window, err := time.ParseDuration(strings.TrimSpace(limitParts[1]))
+		if err != nil || window <= 0 {
+			return nil, fmt.Errorf("%s has invalid window", value)
+		}
+
+		res[strings.TrimSpace(parts[0])+":"+scope] = RateLimit{
+			Limit:  limit,
+			Window: window,
+		}
+	}
+
+	return res, nil
+}
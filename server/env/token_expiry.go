@@ -0,0 +1,116 @@
+package env
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/authorizerdev/authorizer/server/constants"
+	"github.com/authorizerdev/authorizer/server/envstore"
+)
+
+const (
+	// defaultAccessTokenExpiryTime is the lifetime of access & id tokens
+	defaultAccessTokenExpiryTime = 30 * time.Minute
+	// defaultRefreshTokenExpiryTime is the lifetime of refresh tokens
+	defaultRefreshTokenExpiryTime = 365 * 24 * time.Hour
+	// defaultVerificationTokenExpiryTime is the lifetime of email verification, forgot password & magic link tokens
+	defaultVerificationTokenExpiryTime = 30 * time.Minute
+)
+
+// ValidateTokenExpiryTimes validates the token lifetime envs of the given env store data.
+// Lifetimes are durations like 30m or 24h, and role lifetimes are like admin:5m
+func ValidateTokenExpiryTimes(storeData envstore.Store) error {
+	for _, key := range []string{constants.EnvKeyAccessTokenExpiryTime, constants.EnvKeyRefreshTokenExpiryTime, constants.EnvKeyVerificationTokenExpiryTime, constants.EnvKeySessionInactivityTimeout} {
+		value := storeData.StringEnv[key]
+		if value == "" {
+			continue
+		}
+
+		duration, err := time.ParseDuration(value)
+		if err != nil || duration <= 0 {
+			return fmt.Errorf("invalid %s %s, it should be a positive duration like 30m or 24h", key, value)
+		}
+	}
+
+	for _, key := range []string{constants.EnvKeyRoleAccessTokenExpiryTimes, constants.EnvKeyRoleRefreshTokenExpiryTimes} {
+		if _, err := parseRoleExpiryTimes(storeData.SliceEnv[key]); err != nil {
+			return fmt.Errorf("invalid %s: %s", key, err.Error())
+		}
+	}
+
+	return nil
+}
+
+// GetTokenExpiryTime returns the lifetime of access (and id) or refresh token.
+// Lifetimes configured for the token roles and the client (in seconds, 0 means not set)
+// take precedence over the default lifetime, and the shortest of them is used.
+// Refresh token lifetime is also limited by the inactivity timeout, as it is rotated on every use
+func GetTokenExpiryTime(tokenType string, roles []string, clientExpiresIn int64) time.Duration {
+	defaultKey, roleKey, defaultExpiryTime := constants.EnvKeyAccessTokenExpiryTime, constants.EnvKeyRoleAccessTokenExpiryTimes, defaultAccessTokenExpiryTime
+	if tokenType == constants.TokenTypeRefreshToken {
+		defaultKey, roleKey, defaultExpiryTime = constants.EnvKeyRefreshTokenExpiryTime, constants.EnvKeyRoleRefreshTokenExpiryTimes, defaultRefreshTokenExpiryTime
+	}
+
+	var expiryTime time.Duration
+	roleExpiryTimes, _ := parseRoleExpiryTimes(envstore.EnvInMemoryStoreObj.GetSliceStoreEnvVariable(roleKey))
+	for _, role := range roles {
+		if roleExpiryTime, ok := roleExpiryTimes[role]; ok && (expiryTime == 0 || roleExpiryTime < expiryTime) {
+			expiryTime = roleExpiryTime
+		}
+	}
+
+	if clientExpiresIn > 0 {
+		clientExpiryTime := time.Duration(clientExpiresIn) * time.Second
+		if expiryTime == 0 || clientExpiryTime < expiryTime {
+			expiryTime = clientExpiryTime
+		}
+	}
+
+	if expiryTime == 0 {
+		expiryTime = getDuration(defaultKey, defaultExpiryTime)
+	}
+
+	if tokenType == constants.TokenTypeRefreshToken {
+		if idleTimeout := getDuration(constants.EnvKeySessionInactivityTimeout, 0); idleTimeout > 0 && idleTimeout < expiryTime {
+			expiryTime = idleTimeout
+		}
+	}
+
+	return expiryTime
+}
+
+// GetVerificationTokenExpiryTime returns the lifetime of email verification, forgot password & magic link tokens
+func GetVerificationTokenExpiryTime() time.Duration {
+	return getDuration(constants.EnvKeyVerificationTokenExpiryTime, defaultVerificationTokenExpiryTime)
+}
+
+// getDuration returns the duration env value, or default value if it is not set or invalid
+func getDuration(key string, defaultValue time.Duration) time.Duration {
+	duration, err := time.ParseDuration(envstore.EnvInMemoryStoreObj.GetStringStoreEnvVariable(key))
+	if err != nil || duration <= 0 {
+		return defaultValue
+	}
+
+	return duration
+}
+
+// parseRoleExpiryTimes parses the role lifetimes of format role:duration
+func parseRoleExpiryTimes(values []string) (map[string]time.Duration, error) {
+	res := map[string]time.Duration{}
+	for _, value := range values {
+		parts := strings.SplitN(strings.TrimSpace(value), ":", 2)
+		if len(parts) != 2 || strings.TrimSpace(parts[0]) == "" {
+			return nil, fmt.Errorf("%s should be of format role:duration", value)
+		}
+
+		duration, err := time.ParseDuration(strings.TrimSpace(parts[1]))
+		if err != nil || duration <= 0 {
+			return nil, fmt.Errorf("%s has invalid duration", value)
+		}
+
+		res[strings.TrimSpace(parts[0])] = duration
+	}
+
+	return res, nil
+}
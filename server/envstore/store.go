@@ -42,6 +42,10 @@ var EnvInMemoryStoreObj = &EnvInMemoryStore{
 			constants.EnvKeyDisableMagicLinkLogin:      false,
 			constants.EnvKeyDisableEmailVerification:   false,
 			constants.EnvKeyDisableLoginPage:           false,
+			constants.EnvKeyDisableSecurityAlertEmail:  false,
+			constants.EnvKeyDisableWebauthn:            false,
+			constants.EnvKeyDisablePasswordEmailCheck:  false,
+			constants.EnvKeyDisablePhoneLogin:          false,
 		},
 		SliceEnv: map[string][]string{},
 	},
@@ -106,6 +110,24 @@ func (e *EnvInMemoryStore) GetEnvStoreClone() Store {
 	e.mutex.Lock()
 	defer e.mutex.Unlock()
 
-	result := *e.store
+	// copy the maps, so that changes to clone don't modify the current store
+	result := Store{
+		StringEnv: make(map[string]string, len(e.store.StringEnv)),
+		BoolEnv:   make(map[string]bool, len(e.store.BoolEnv)),
+		SliceEnv:  make(map[string][]string, len(e.store.SliceEnv)),
+	}
+
+	for key, value := range e.store.StringEnv {
+		result.StringEnv[key] = value
+	}
+
+	for key, value := range e.store.BoolEnv {
+		result.BoolEnv[key] = value
+	}
+
+	for key, value := range e.store.SliceEnv {
+		result.SliceEnv[key] = value
+	}
+
 	return result
 }
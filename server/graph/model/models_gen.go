@@ -2,6 +2,37 @@
 
 package model
 
+type AddClientInput struct {
+	Name                  string   `json:"name"`
+	RedirectUris          []string `json:"redirect_uris"`
+	GrantTypes            []string `json:"grant_types"`
+	Scopes                []string `json:"scopes"`
+	Roles                 []string `json:"roles"`
+	IsPublic              *bool    `json:"is_public"`
+	AllowPlainPkce        *bool    `json:"allow_plain_pkce"`
+	Trusted               *bool    `json:"trusted"`
+	AccessTokenExpiresIn  *int64   `json:"access_token_expires_in"`
+	RefreshTokenExpiresIn *int64   `json:"refresh_token_expires_in"`
+}
+
+type AddOIDCProviderInput struct {
+	Name         string                 `json:"name"`
+	DisplayName  *string                `json:"display_name"`
+	IssuerURL    string                 `json:"issuer_url"`
+	ClientID     string                 `json:"client_id"`
+	ClientSecret string                 `json:"client_secret"`
+	Scopes       []string               `json:"scopes"`
+	ClaimMapping map[string]interface{} `json:"claim_mapping"`
+	TrustEmail   *bool                  `json:"trust_email"`
+	Enabled      *bool                  `json:"enabled"`
+}
+
+type AddWebhookInput struct {
+	EventName string `json:"event_name"`
+	Endpoint  string `json:"endpoint"`
+	Enabled   *bool  `json:"enabled"`
+}
+
 type AdminLoginInput struct {
 	AdminSecret string `json:"admin_secret"`
 }
@@ -10,52 +41,162 @@ type AdminSignupInput struct {
 	AdminSecret string `json:"admin_secret"`
 }
 
+type AuditEvent struct {
+	ID         string  `json:"id"`
+	ActorID    *string `json:"actor_id"`
+	ActorType  string  `json:"actor_type"`
+	ActorEmail *string `json:"actor_email"`
+	Action     string  `json:"action"`
+	TargetType *string `json:"target_type"`
+	TargetID   *string `json:"target_id"`
+	IP         *string `json:"ip"`
+	UserAgent  *string `json:"user_agent"`
+	Result     string  `json:"result"`
+	Message    *string `json:"message"`
+	CreatedAt  *int64  `json:"created_at"`
+}
+
+type AuditLogs struct {
+	Pagination  *Pagination   `json:"pagination"`
+	AuditEvents []*AuditEvent `json:"audit_events"`
+}
+
+type AuditLogsInput struct {
+	Pagination *PaginationInput `json:"pagination"`
+	Action     *string          `json:"action"`
+	ActorID    *string          `json:"actor_id"`
+	TargetID   *string          `json:"target_id"`
+	Result     *string          `json:"result"`
+	IP         *string          `json:"ip"`
+	From       *int64           `json:"from"`
+	To         *int64           `json:"to"`
+}
+
 type AuthResponse struct {
-	Message     string  `json:"message"`
-	AccessToken *string `json:"access_token"`
-	ExpiresAt   *int64  `json:"expires_at"`
-	User        *User   `json:"user"`
+	Message     string   `json:"message"`
+	AccessToken *string  `json:"access_token"`
+	IDToken     *string  `json:"id_token"`
+	ExpiresAt   *int64   `json:"expires_at"`
+	User        *User    `json:"user"`
+	MfaToken    *string  `json:"mfa_token"`
+	MfaMethods  []string `json:"mfa_methods"`
+}
+
+type Client struct {
+	ID                    string   `json:"id"`
+	ClientID              string   `json:"client_id"`
+	Name                  string   `json:"name"`
+	IsPublic              bool     `json:"is_public"`
+	RedirectUris          []string `json:"redirect_uris"`
+	GrantTypes            []string `json:"grant_types"`
+	Scopes                []string `json:"scopes"`
+	Roles                 []string `json:"roles"`
+	AllowPlainPkce        bool     `json:"allow_plain_pkce"`
+	Trusted               bool     `json:"trusted"`
+	AccessTokenExpiresIn  *int64   `json:"access_token_expires_in"`
+	RefreshTokenExpiresIn *int64   `json:"refresh_token_expires_in"`
+	CreatedAt             *int64   `json:"created_at"`
+	UpdatedAt             *int64   `json:"updated_at"`
+}
+
+type ClientInput struct {
+	ClientID string `json:"client_id"`
+}
+
+type ClientSecretResponse struct {
+	Message      string  `json:"message"`
+	Client       *Client `json:"client"`
+	ClientSecret *string `json:"client_secret"`
+}
+
+type Clients struct {
+	Pagination *Pagination `json:"pagination"`
+	Clients    []*Client   `json:"clients"`
 }
 
 type DeleteUserInput struct {
 	Email string `json:"email"`
 }
 
+type DeleteWebauthnCredentialInput struct {
+	ID string `json:"id"`
+}
+
 type Env struct {
-	AdminSecret                *string  `json:"ADMIN_SECRET"`
-	DatabaseName               *string  `json:"DATABASE_NAME"`
-	DatabaseURL                *string  `json:"DATABASE_URL"`
-	DatabaseType               *string  `json:"DATABASE_TYPE"`
-	CustomAccessTokenScript    *string  `json:"CUSTOM_ACCESS_TOKEN_SCRIPT"`
-	SMTPHost                   *string  `json:"SMTP_HOST"`
-	SMTPPort                   *string  `json:"SMTP_PORT"`
-	SMTPUsername               *string  `json:"SMTP_USERNAME"`
-	SMTPPassword               *string  `json:"SMTP_PASSWORD"`
-	SenderEmail                *string  `json:"SENDER_EMAIL"`
-	JwtType                    *string  `json:"JWT_TYPE"`
-	JwtSecret                  *string  `json:"JWT_SECRET"`
-	AllowedOrigins             []string `json:"ALLOWED_ORIGINS"`
-	AuthorizerURL              *string  `json:"AUTHORIZER_URL"`
-	AppURL                     *string  `json:"APP_URL"`
-	RedisURL                   *string  `json:"REDIS_URL"`
-	CookieName                 *string  `json:"COOKIE_NAME"`
-	ResetPasswordURL           *string  `json:"RESET_PASSWORD_URL"`
-	DisableEmailVerification   *bool    `json:"DISABLE_EMAIL_VERIFICATION"`
-	DisableBasicAuthentication *bool    `json:"DISABLE_BASIC_AUTHENTICATION"`
-	DisableMagicLinkLogin      *bool    `json:"DISABLE_MAGIC_LINK_LOGIN"`
-	DisableLoginPage           *bool    `json:"DISABLE_LOGIN_PAGE"`
-	Roles                      []string `json:"ROLES"`
-	ProtectedRoles             []string `json:"PROTECTED_ROLES"`
-	DefaultRoles               []string `json:"DEFAULT_ROLES"`
-	JwtRoleClaim               *string  `json:"JWT_ROLE_CLAIM"`
-	GoogleClientID             *string  `json:"GOOGLE_CLIENT_ID"`
-	GoogleClientSecret         *string  `json:"GOOGLE_CLIENT_SECRET"`
-	GithubClientID             *string  `json:"GITHUB_CLIENT_ID"`
-	GithubClientSecret         *string  `json:"GITHUB_CLIENT_SECRET"`
-	FacebookClientID           *string  `json:"FACEBOOK_CLIENT_ID"`
-	FacebookClientSecret       *string  `json:"FACEBOOK_CLIENT_SECRET"`
-	OrganizationName           *string  `json:"ORGANIZATION_NAME"`
-	OrganizationLogo           *string  `json:"ORGANIZATION_LOGO"`
+	AdminSecret                 *string  `json:"ADMIN_SECRET"`
+	ClientID                    *string  `json:"CLIENT_ID"`
+	DatabaseName                *string  `json:"DATABASE_NAME"`
+	DatabaseURL                 *string  `json:"DATABASE_URL"`
+	DatabaseType                *string  `json:"DATABASE_TYPE"`
+	CustomAccessTokenScript     *string  `json:"CUSTOM_ACCESS_TOKEN_SCRIPT"`
+	SMTPHost                    *string  `json:"SMTP_HOST"`
+	SMTPPort                    *string  `json:"SMTP_PORT"`
+	SMTPUsername                *string  `json:"SMTP_USERNAME"`
+	SMTPPassword                *string  `json:"SMTP_PASSWORD"`
+	SenderEmail                 *string  `json:"SENDER_EMAIL"`
+	SmsWebhookURL               *string  `json:"SMS_WEBHOOK_URL"`
+	SmsWebhookAuthorization     *string  `json:"SMS_WEBHOOK_AUTHORIZATION"`
+	JwtType                     *string  `json:"JWT_TYPE"`
+	JwtSecret                   *string  `json:"JWT_SECRET"`
+	JwtPrivateKey               *string  `json:"JWT_PRIVATE_KEY"`
+	JwtPublicKey                *string  `json:"JWT_PUBLIC_KEY"`
+	AllowedOrigins              []string `json:"ALLOWED_ORIGINS"`
+	ClientRedirectURIS          []string `json:"CLIENT_REDIRECT_URIS"`
+	AuthorizerURL               *string  `json:"AUTHORIZER_URL"`
+	AppURL                      *string  `json:"APP_URL"`
+	RedisURL                    *string  `json:"REDIS_URL"`
+	CookieName                  *string  `json:"COOKIE_NAME"`
+	ResetPasswordURL            *string  `json:"RESET_PASSWORD_URL"`
+	DisableEmailVerification    *bool    `json:"DISABLE_EMAIL_VERIFICATION"`
+	DisableBasicAuthentication  *bool    `json:"DISABLE_BASIC_AUTHENTICATION"`
+	DisableMagicLinkLogin       *bool    `json:"DISABLE_MAGIC_LINK_LOGIN"`
+	DisableLoginPage            *bool    `json:"DISABLE_LOGIN_PAGE"`
+	DisableSecurityAlertEmail   *bool    `json:"DISABLE_SECURITY_ALERT_EMAIL"`
+	DisableWebauthn             *bool    `json:"DISABLE_WEBAUTHN"`
+	DisablePasswordEmailCheck   *bool    `json:"DISABLE_PASSWORD_EMAIL_CHECK"`
+	DisablePhoneLogin           *bool    `json:"DISABLE_PHONE_LOGIN"`
+	Roles                       []string `json:"ROLES"`
+	ProtectedRoles              []string `json:"PROTECTED_ROLES"`
+	DefaultRoles                []string `json:"DEFAULT_ROLES"`
+	JwtRoleClaim                *string  `json:"JWT_ROLE_CLAIM"`
+	AccessTokenExpiryTime       *string  `json:"ACCESS_TOKEN_EXPIRY_TIME"`
+	RefreshTokenExpiryTime      *string  `json:"REFRESH_TOKEN_EXPIRY_TIME"`
+	VerificationTokenExpiryTime *string  `json:"VERIFICATION_TOKEN_EXPIRY_TIME"`
+	SessionInactivityTimeout    *string  `json:"SESSION_INACTIVITY_TIMEOUT"`
+	RoleAccessTokenExpiryTimes  []string `json:"ROLE_ACCESS_TOKEN_EXPIRY_TIMES"`
+	RoleRefreshTokenExpiryTimes []string `json:"ROLE_REFRESH_TOKEN_EXPIRY_TIMES"`
+	RateLimits                  []string `json:"RATE_LIMITS"`
+	AccountLockoutThreshold     *string  `json:"ACCOUNT_LOCKOUT_THRESHOLD"`
+	AccountLockoutDuration      *string  `json:"ACCOUNT_LOCKOUT_DURATION"`
+	PasswordMinLength           *string  `json:"PASSWORD_MIN_LENGTH"`
+	PasswordCharacterClasses    []string `json:"PASSWORD_CHARACTER_CLASSES"`
+	PasswordHistorySize         *string  `json:"PASSWORD_HISTORY_SIZE"`
+	BreachedPasswordsFile       *string  `json:"BREACHED_PASSWORDS_FILE"`
+	PasswordHashAlgorithm       *string  `json:"PASSWORD_HASH_ALGORITHM"`
+	AuditLogRetentionDays       *string  `json:"AUDIT_LOG_RETENTION_DAYS"`
+	GoogleClientID              *string  `json:"GOOGLE_CLIENT_ID"`
+	GoogleClientSecret          *string  `json:"GOOGLE_CLIENT_SECRET"`
+	GithubClientID              *string  `json:"GITHUB_CLIENT_ID"`
+	GithubClientSecret          *string  `json:"GITHUB_CLIENT_SECRET"`
+	FacebookClientID            *string  `json:"FACEBOOK_CLIENT_ID"`
+	FacebookClientSecret        *string  `json:"FACEBOOK_CLIENT_SECRET"`
+	AppleClientID               *string  `json:"APPLE_CLIENT_ID"`
+	AppleTeamID                 *string  `json:"APPLE_TEAM_ID"`
+	AppleKeyID                  *string  `json:"APPLE_KEY_ID"`
+	ApplePrivateKey             *string  `json:"APPLE_PRIVATE_KEY"`
+	MicrosoftClientID           *string  `json:"MICROSOFT_CLIENT_ID"`
+	MicrosoftClientSecret       *string  `json:"MICROSOFT_CLIENT_SECRET"`
+	MicrosoftTenantID           *string  `json:"MICROSOFT_TENANT_ID"`
+	GitlabClientID              *string  `json:"GITLAB_CLIENT_ID"`
+	GitlabClientSecret          *string  `json:"GITLAB_CLIENT_SECRET"`
+	LinkedinClientID            *string  `json:"LINKEDIN_CLIENT_ID"`
+	LinkedinClientSecret        *string  `json:"LINKEDIN_CLIENT_SECRET"`
+	DiscordClientID             *string  `json:"DISCORD_CLIENT_ID"`
+	DiscordClientSecret         *string  `json:"DISCORD_CLIENT_SECRET"`
+	TwitterClientID             *string  `json:"TWITTER_CLIENT_ID"`
+	TwitterClientSecret         *string  `json:"TWITTER_CLIENT_SECRET"`
+	OrganizationName            *string  `json:"ORGANIZATION_NAME"`
+	OrganizationLogo            *string  `json:"ORGANIZATION_LOGO"`
 }
 
 type Error struct {
@@ -67,11 +208,49 @@ type ForgotPasswordInput struct {
 	Email string `json:"email"`
 }
 
+type GenerateJWTKeyInput struct {
+	Type *string `json:"type"`
+}
+
+type ImportUserError struct {
+	Row   int    `json:"row"`
+	Email string `json:"email"`
+	Error string `json:"error"`
+}
+
+type ImportUsersInput struct {
+	Format string `json:"format"`
+	Data   string `json:"data"`
+}
+
+type ImportUsersResponse struct {
+	Message  string             `json:"message"`
+	Imported int                `json:"imported"`
+	Errors   []*ImportUserError `json:"errors"`
+}
+
 type IsValidJWTQueryInput struct {
 	Jwt   *string  `json:"jwt"`
 	Roles []string `json:"roles"`
 }
 
+type JWTKey struct {
+	ID        string  `json:"id"`
+	Type      string  `json:"type"`
+	Status    string  `json:"status"`
+	PublicKey *string `json:"public_key"`
+	CreatedAt *int64  `json:"created_at"`
+	ExpiresAt *int64  `json:"expires_at"`
+}
+
+type JWTKeyInput struct {
+	ID string `json:"id"`
+}
+
+type ListSessionsInput struct {
+	UserID string `json:"user_id"`
+}
+
 type LoginInput struct {
 	Email    string   `json:"email"`
 	Password string   `json:"password"`
@@ -79,18 +258,56 @@ type LoginInput struct {
 }
 
 type MagicLinkLoginInput struct {
-	Email string   `json:"email"`
-	Roles []string `json:"roles"`
+	Email  string   `json:"email"`
+	Roles  []string `json:"roles"`
+	UseOtp *bool    `json:"use_otp"`
 }
 
 type Meta struct {
-	Version                      string `json:"version"`
-	IsGoogleLoginEnabled         bool   `json:"is_google_login_enabled"`
-	IsFacebookLoginEnabled       bool   `json:"is_facebook_login_enabled"`
-	IsGithubLoginEnabled         bool   `json:"is_github_login_enabled"`
-	IsEmailVerificationEnabled   bool   `json:"is_email_verification_enabled"`
-	IsBasicAuthenticationEnabled bool   `json:"is_basic_authentication_enabled"`
-	IsMagicLinkLoginEnabled      bool   `json:"is_magic_link_login_enabled"`
+	Version                      string              `json:"version"`
+	ClientID                     string              `json:"client_id"`
+	IsGoogleLoginEnabled         bool                `json:"is_google_login_enabled"`
+	IsFacebookLoginEnabled       bool                `json:"is_facebook_login_enabled"`
+	IsGithubLoginEnabled         bool                `json:"is_github_login_enabled"`
+	IsEmailVerificationEnabled   bool                `json:"is_email_verification_enabled"`
+	IsBasicAuthenticationEnabled bool                `json:"is_basic_authentication_enabled"`
+	IsMagicLinkLoginEnabled      bool                `json:"is_magic_link_login_enabled"`
+	IsWebauthnEnabled            bool                `json:"is_webauthn_enabled"`
+	IsPhoneLoginEnabled          bool                `json:"is_phone_login_enabled"`
+	OauthProviders               []string            `json:"oauth_providers"`
+	OidcProviders                []*MetaOIDCProvider `json:"oidc_providers"`
+}
+
+type MetaOIDCProvider struct {
+	Name        string `json:"name"`
+	DisplayName string `json:"display_name"`
+}
+
+type OIDCProvider struct {
+	ID           string                 `json:"id"`
+	Name         string                 `json:"name"`
+	DisplayName  string                 `json:"display_name"`
+	IssuerURL    string                 `json:"issuer_url"`
+	ClientID     string                 `json:"client_id"`
+	Scopes       []string               `json:"scopes"`
+	ClaimMapping map[string]interface{} `json:"claim_mapping"`
+	TrustEmail   bool                   `json:"trust_email"`
+	Enabled      bool                   `json:"enabled"`
+	CreatedAt    *int64                 `json:"created_at"`
+	UpdatedAt    *int64                 `json:"updated_at"`
+}
+
+type OIDCProviderInput struct {
+	ID string `json:"id"`
+}
+
+type OIDCProviders struct {
+	Pagination    *Pagination     `json:"pagination"`
+	OidcProviders []*OIDCProvider `json:"oidc_providers"`
+}
+
+type OTPInput struct {
+	Otp string `json:"otp"`
 }
 
 type PaginatedInput struct {
@@ -109,6 +326,10 @@ type PaginationInput struct {
 	Page  *int64 `json:"page"`
 }
 
+type PhoneLoginInput struct {
+	PhoneNumber string `json:"phone_number"`
+}
+
 type ResendVerifyEmailInput struct {
 	Email      string `json:"email"`
 	Identifier string `json:"identifier"`
@@ -124,6 +345,20 @@ type Response struct {
 	Message string `json:"message"`
 }
 
+type RevokeSessionInput struct {
+	ID string `json:"id"`
+}
+
+type Session struct {
+	ID         string `json:"id"`
+	UserID     string `json:"user_id"`
+	UserAgent  string `json:"user_agent"`
+	IP         string `json:"ip"`
+	CreatedAt  int64  `json:"created_at"`
+	LastUsedAt int64  `json:"last_used_at"`
+	IsCurrent  bool   `json:"is_current"`
+}
+
 type SessionQueryInput struct {
 	Roles []string `json:"roles"`
 }
@@ -143,39 +378,124 @@ type SignUpInput struct {
 	Roles           []string `json:"roles"`
 }
 
+type TestAccessTokenScriptInput struct {
+	UserID *string `json:"user_id"`
+	Email  *string `json:"email"`
+	Script *string `json:"script"`
+}
+
+type TestAccessTokenScriptResponse struct {
+	Claims map[string]interface{} `json:"claims"`
+}
+
+type TotpEnrollResponse struct {
+	Message         string   `json:"message"`
+	Secret          string   `json:"secret"`
+	ProvisioningURI string   `json:"provisioning_uri"`
+	BackupCodes     []string `json:"backup_codes"`
+}
+
+type UnlockUserInput struct {
+	Email string `json:"email"`
+}
+
+type UpdateClientInput struct {
+	ClientID              string   `json:"client_id"`
+	Name                  *string  `json:"name"`
+	RedirectUris          []string `json:"redirect_uris"`
+	GrantTypes            []string `json:"grant_types"`
+	Scopes                []string `json:"scopes"`
+	Roles                 []string `json:"roles"`
+	AllowPlainPkce        *bool    `json:"allow_plain_pkce"`
+	Trusted               *bool    `json:"trusted"`
+	AccessTokenExpiresIn  *int64   `json:"access_token_expires_in"`
+	RefreshTokenExpiresIn *int64   `json:"refresh_token_expires_in"`
+}
+
 type UpdateEnvInput struct {
-	AdminSecret                *string  `json:"ADMIN_SECRET"`
-	CustomAccessTokenScript    *string  `json:"CUSTOM_ACCESS_TOKEN_SCRIPT"`
-	OldAdminSecret             *string  `json:"OLD_ADMIN_SECRET"`
-	SMTPHost                   *string  `json:"SMTP_HOST"`
-	SMTPPort                   *string  `json:"SMTP_PORT"`
-	SMTPUsername               *string  `json:"SMTP_USERNAME"`
-	SMTPPassword               *string  `json:"SMTP_PASSWORD"`
-	SenderEmail                *string  `json:"SENDER_EMAIL"`
-	JwtType                    *string  `json:"JWT_TYPE"`
-	JwtSecret                  *string  `json:"JWT_SECRET"`
-	AllowedOrigins             []string `json:"ALLOWED_ORIGINS"`
-	AuthorizerURL              *string  `json:"AUTHORIZER_URL"`
-	AppURL                     *string  `json:"APP_URL"`
-	RedisURL                   *string  `json:"REDIS_URL"`
-	CookieName                 *string  `json:"COOKIE_NAME"`
-	ResetPasswordURL           *string  `json:"RESET_PASSWORD_URL"`
-	DisableEmailVerification   *bool    `json:"DISABLE_EMAIL_VERIFICATION"`
-	DisableBasicAuthentication *bool    `json:"DISABLE_BASIC_AUTHENTICATION"`
-	DisableMagicLinkLogin      *bool    `json:"DISABLE_MAGIC_LINK_LOGIN"`
-	DisableLoginPage           *bool    `json:"DISABLE_LOGIN_PAGE"`
-	Roles                      []string `json:"ROLES"`
-	ProtectedRoles             []string `json:"PROTECTED_ROLES"`
-	DefaultRoles               []string `json:"DEFAULT_ROLES"`
-	JwtRoleClaim               *string  `json:"JWT_ROLE_CLAIM"`
-	GoogleClientID             *string  `json:"GOOGLE_CLIENT_ID"`
-	GoogleClientSecret         *string  `json:"GOOGLE_CLIENT_SECRET"`
-	GithubClientID             *string  `json:"GITHUB_CLIENT_ID"`
-	GithubClientSecret         *string  `json:"GITHUB_CLIENT_SECRET"`
-	FacebookClientID           *string  `json:"FACEBOOK_CLIENT_ID"`
-	FacebookClientSecret       *string  `json:"FACEBOOK_CLIENT_SECRET"`
-	OrganizationName           *string  `json:"ORGANIZATION_NAME"`
-	OrganizationLogo           *string  `json:"ORGANIZATION_LOGO"`
+	AdminSecret                 *string  `json:"ADMIN_SECRET"`
+	CustomAccessTokenScript     *string  `json:"CUSTOM_ACCESS_TOKEN_SCRIPT"`
+	OldAdminSecret              *string  `json:"OLD_ADMIN_SECRET"`
+	SMTPHost                    *string  `json:"SMTP_HOST"`
+	SMTPPort                    *string  `json:"SMTP_PORT"`
+	SMTPUsername                *string  `json:"SMTP_USERNAME"`
+	SMTPPassword                *string  `json:"SMTP_PASSWORD"`
+	SenderEmail                 *string  `json:"SENDER_EMAIL"`
+	SmsWebhookURL               *string  `json:"SMS_WEBHOOK_URL"`
+	SmsWebhookAuthorization     *string  `json:"SMS_WEBHOOK_AUTHORIZATION"`
+	JwtType                     *string  `json:"JWT_TYPE"`
+	JwtSecret                   *string  `json:"JWT_SECRET"`
+	JwtPrivateKey               *string  `json:"JWT_PRIVATE_KEY"`
+	JwtPublicKey                *string  `json:"JWT_PUBLIC_KEY"`
+	AllowedOrigins              []string `json:"ALLOWED_ORIGINS"`
+	ClientRedirectURIS          []string `json:"CLIENT_REDIRECT_URIS"`
+	AuthorizerURL               *string  `json:"AUTHORIZER_URL"`
+	AppURL                      *string  `json:"APP_URL"`
+	RedisURL                    *string  `json:"REDIS_URL"`
+	CookieName                  *string  `json:"COOKIE_NAME"`
+	ResetPasswordURL            *string  `json:"RESET_PASSWORD_URL"`
+	DisableEmailVerification    *bool    `json:"DISABLE_EMAIL_VERIFICATION"`
+	DisableBasicAuthentication  *bool    `json:"DISABLE_BASIC_AUTHENTICATION"`
+	DisableMagicLinkLogin       *bool    `json:"DISABLE_MAGIC_LINK_LOGIN"`
+	DisableLoginPage            *bool    `json:"DISABLE_LOGIN_PAGE"`
+	DisableSecurityAlertEmail   *bool    `json:"DISABLE_SECURITY_ALERT_EMAIL"`
+	DisableWebauthn             *bool    `json:"DISABLE_WEBAUTHN"`
+	DisablePasswordEmailCheck   *bool    `json:"DISABLE_PASSWORD_EMAIL_CHECK"`
+	DisablePhoneLogin           *bool    `json:"DISABLE_PHONE_LOGIN"`
+	Roles                       []string `json:"ROLES"`
+	ProtectedRoles              []string `json:"PROTECTED_ROLES"`
+	DefaultRoles                []string `json:"DEFAULT_ROLES"`
+	JwtRoleClaim                *string  `json:"JWT_ROLE_CLAIM"`
+	AccessTokenExpiryTime       *string  `json:"ACCESS_TOKEN_EXPIRY_TIME"`
+	RefreshTokenExpiryTime      *string  `json:"REFRESH_TOKEN_EXPIRY_TIME"`
+	VerificationTokenExpiryTime *string  `json:"VERIFICATION_TOKEN_EXPIRY_TIME"`
+	SessionInactivityTimeout    *string  `json:"SESSION_INACTIVITY_TIMEOUT"`
+	RoleAccessTokenExpiryTimes  []string `json:"ROLE_ACCESS_TOKEN_EXPIRY_TIMES"`
+	RoleRefreshTokenExpiryTimes []string `json:"ROLE_REFRESH_TOKEN_EXPIRY_TIMES"`
+	RateLimits                  []string `json:"RATE_LIMITS"`
+	AccountLockoutThreshold     *string  `json:"ACCOUNT_LOCKOUT_THRESHOLD"`
+	AccountLockoutDuration      *string  `json:"ACCOUNT_LOCKOUT_DURATION"`
+	PasswordMinLength           *string  `json:"PASSWORD_MIN_LENGTH"`
+	PasswordCharacterClasses    []string `json:"PASSWORD_CHARACTER_CLASSES"`
+	PasswordHistorySize         *string  `json:"PASSWORD_HISTORY_SIZE"`
+	BreachedPasswordsFile       *string  `json:"BREACHED_PASSWORDS_FILE"`
+	PasswordHashAlgorithm       *string  `json:"PASSWORD_HASH_ALGORITHM"`
+	AuditLogRetentionDays       *string  `json:"AUDIT_LOG_RETENTION_DAYS"`
+	GoogleClientID              *string  `json:"GOOGLE_CLIENT_ID"`
+	GoogleClientSecret          *string  `json:"GOOGLE_CLIENT_SECRET"`
+	GithubClientID              *string  `json:"GITHUB_CLIENT_ID"`
+	GithubClientSecret          *string  `json:"GITHUB_CLIENT_SECRET"`
+	FacebookClientID            *string  `json:"FACEBOOK_CLIENT_ID"`
+	FacebookClientSecret        *string  `json:"FACEBOOK_CLIENT_SECRET"`
+	AppleClientID               *string  `json:"APPLE_CLIENT_ID"`
+	AppleTeamID                 *string  `json:"APPLE_TEAM_ID"`
+	AppleKeyID                  *string  `json:"APPLE_KEY_ID"`
+	ApplePrivateKey             *string  `json:"APPLE_PRIVATE_KEY"`
+	MicrosoftClientID           *string  `json:"MICROSOFT_CLIENT_ID"`
+	MicrosoftClientSecret       *string  `json:"MICROSOFT_CLIENT_SECRET"`
+	MicrosoftTenantID           *string  `json:"MICROSOFT_TENANT_ID"`
+	GitlabClientID              *string  `json:"GITLAB_CLIENT_ID"`
+	GitlabClientSecret          *string  `json:"GITLAB_CLIENT_SECRET"`
+	LinkedinClientID            *string  `json:"LINKEDIN_CLIENT_ID"`
+	LinkedinClientSecret        *string  `json:"LINKEDIN_CLIENT_SECRET"`
+	DiscordClientID             *string  `json:"DISCORD_CLIENT_ID"`
+	DiscordClientSecret         *string  `json:"DISCORD_CLIENT_SECRET"`
+	TwitterClientID             *string  `json:"TWITTER_CLIENT_ID"`
+	TwitterClientSecret         *string  `json:"TWITTER_CLIENT_SECRET"`
+	OrganizationName            *string  `json:"ORGANIZATION_NAME"`
+	OrganizationLogo            *string  `json:"ORGANIZATION_LOGO"`
+}
+
+type UpdateOIDCProviderInput struct {
+	ID           string                 `json:"id"`
+	DisplayName  *string                `json:"display_name"`
+	IssuerURL    *string                `json:"issuer_url"`
+	ClientID     *string                `json:"client_id"`
+	ClientSecret *string                `json:"client_secret"`
+	Scopes       []string               `json:"scopes"`
+	ClaimMapping map[string]interface{} `json:"claim_mapping"`
+	TrustEmail   *bool                  `json:"trust_email"`
+	Enabled      *bool                  `json:"enabled"`
 }
 
 type UpdateProfileInput struct {
@@ -208,6 +528,13 @@ type UpdateUserInput struct {
 	Roles         []*string `json:"roles"`
 }
 
+type UpdateWebhookInput struct {
+	ID        string  `json:"id"`
+	EventName *string `json:"event_name"`
+	Endpoint  *string `json:"endpoint"`
+	Enabled   *bool   `json:"enabled"`
+}
+
 type User struct {
 	ID                  string   `json:"id"`
 	Email               string   `json:"email"`
@@ -226,6 +553,7 @@ type User struct {
 	Roles               []string `json:"roles"`
 	CreatedAt           *int64   `json:"created_at"`
 	UpdatedAt           *int64   `json:"updated_at"`
+	TotpEnabled         *bool    `json:"totp_enabled"`
 }
 
 type Users struct {
@@ -253,6 +581,105 @@ type VerificationRequests struct {
 	VerificationRequests []*VerificationRequest `json:"verification_requests"`
 }
 
+type VerifyDeviceCodeInput struct {
+	UserCode string `json:"user_code"`
+	Deny     *bool  `json:"deny"`
+}
+
 type VerifyEmailInput struct {
 	Token string `json:"token"`
 }
+
+type VerifyEmailOTPInput struct {
+	Email string `json:"email"`
+	Otp   string `json:"otp"`
+}
+
+type VerifyOTPInput struct {
+	MfaToken string `json:"mfa_token"`
+	Otp      string `json:"otp"`
+}
+
+type VerifyPhoneOTPInput struct {
+	PhoneNumber string `json:"phone_number"`
+	Otp         string `json:"otp"`
+}
+
+type WebauthnCredential struct {
+	ID         string `json:"id"`
+	Name       string `json:"name"`
+	MfaEnabled bool   `json:"mfa_enabled"`
+	LastUsedAt *int64 `json:"last_used_at"`
+	CreatedAt  *int64 `json:"created_at"`
+}
+
+type WebauthnLoginInput struct {
+	ID                string   `json:"id"`
+	ClientDataJSON    string   `json:"client_data_json"`
+	AuthenticatorData string   `json:"authenticator_data"`
+	Signature         string   `json:"signature"`
+	UserHandle        *string  `json:"user_handle"`
+	Roles             []string `json:"roles"`
+}
+
+type WebauthnLoginOptionsInput struct {
+	Email    *string `json:"email"`
+	MfaToken *string `json:"mfa_token"`
+}
+
+type WebauthnOptionsResponse struct {
+	Options map[string]interface{} `json:"options"`
+}
+
+type WebauthnRegisterInput struct {
+	Name              *string `json:"name"`
+	MfaEnabled        *bool   `json:"mfa_enabled"`
+	ID                string  `json:"id"`
+	ClientDataJSON    string  `json:"client_data_json"`
+	AttestationObject string  `json:"attestation_object"`
+}
+
+type Webhook struct {
+	ID        string  `json:"id"`
+	EventName string  `json:"event_name"`
+	Endpoint  string  `json:"endpoint"`
+	Secret    *string `json:"secret"`
+	Enabled   bool    `json:"enabled"`
+	CreatedAt *int64  `json:"created_at"`
+	UpdatedAt *int64  `json:"updated_at"`
+}
+
+type WebhookDeliveries struct {
+	Pagination        *Pagination        `json:"pagination"`
+	WebhookDeliveries []*WebhookDelivery `json:"webhook_deliveries"`
+}
+
+type WebhookDeliveriesInput struct {
+	Pagination *PaginationInput `json:"pagination"`
+	WebhookID  *string          `json:"webhook_id"`
+}
+
+type WebhookDelivery struct {
+	ID             string  `json:"id"`
+	WebhookID      string  `json:"webhook_id"`
+	EventName      string  `json:"event_name"`
+	Endpoint       string  `json:"endpoint"`
+	Payload        string  `json:"payload"`
+	Status         string  `json:"status"`
+	Attempts       int64   `json:"attempts"`
+	ResponseStatus *int64  `json:"response_status"`
+	ResponseBody   *string `json:"response_body"`
+	Error          *string `json:"error"`
+	NextAttemptAt  *int64  `json:"next_attempt_at"`
+	CreatedAt      *int64  `json:"created_at"`
+	UpdatedAt      *int64  `json:"updated_at"`
+}
+
+type WebhookInput struct {
+	ID string `json:"id"`
+}
+
+type Webhooks struct {
+	Pagination *Pagination `json:"pagination"`
+	Webhooks   []*Webhook  `json:"webhooks"`
+}
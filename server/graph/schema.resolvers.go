@@ -27,6 +27,10 @@ func (r *mutationResolver) Logout(ctx context.Context) (*model.Response, error)
 	return resolvers.LogoutResolver(ctx)
 }
 
+func (r *mutationResolver) LogoutAll(ctx context.Context) (*model.Response, error) {
+	return resolvers.LogoutAllResolver(ctx)
+}
+
 func (r *mutationResolver) UpdateProfile(ctx context.Context, params model.UpdateProfileInput) (*model.Response, error) {
 	return resolvers.UpdateProfileResolver(ctx, params)
 }
@@ -35,6 +39,26 @@ func (r *mutationResolver) VerifyEmail(ctx context.Context, params model.VerifyE
 	return resolvers.VerifyEmailResolver(ctx, params)
 }
 
+func (r *mutationResolver) VerifyEmailOtp(ctx context.Context, params model.VerifyEmailOTPInput) (*model.AuthResponse, error) {
+	return resolvers.VerifyEmailOtpResolver(ctx, params)
+}
+
+func (r *mutationResolver) SendPhoneVerificationOtp(ctx context.Context) (*model.Response, error) {
+	return resolvers.SendPhoneVerificationOtpResolver(ctx)
+}
+
+func (r *mutationResolver) VerifyPhoneNumber(ctx context.Context, params model.OTPInput) (*model.Response, error) {
+	return resolvers.VerifyPhoneNumberResolver(ctx, params)
+}
+
+func (r *mutationResolver) PhoneLogin(ctx context.Context, params model.PhoneLoginInput) (*model.Response, error) {
+	return resolvers.PhoneLoginResolver(ctx, params)
+}
+
+func (r *mutationResolver) VerifyPhoneOtp(ctx context.Context, params model.VerifyPhoneOTPInput) (*model.AuthResponse, error) {
+	return resolvers.VerifyPhoneOtpResolver(ctx, params)
+}
+
 func (r *mutationResolver) ResendVerifyEmail(ctx context.Context, params model.ResendVerifyEmailInput) (*model.Response, error) {
 	return resolvers.ResendVerifyEmailResolver(ctx, params)
 }
@@ -47,6 +71,50 @@ func (r *mutationResolver) ResetPassword(ctx context.Context, params model.Reset
 	return resolvers.ResetPasswordResolver(ctx, params)
 }
 
+func (r *mutationResolver) VerifyDeviceCode(ctx context.Context, params model.VerifyDeviceCodeInput) (*model.Response, error) {
+	return resolvers.VerifyDeviceCodeResolver(ctx, params)
+}
+
+func (r *mutationResolver) EnrollTotp(ctx context.Context) (*model.TotpEnrollResponse, error) {
+	return resolvers.EnrollTotpResolver(ctx)
+}
+
+func (r *mutationResolver) ConfirmTotp(ctx context.Context, params model.OTPInput) (*model.Response, error) {
+	return resolvers.ConfirmTotpResolver(ctx, params)
+}
+
+func (r *mutationResolver) DisableTotp(ctx context.Context, params model.OTPInput) (*model.Response, error) {
+	return resolvers.DisableTotpResolver(ctx, params)
+}
+
+func (r *mutationResolver) VerifyOtp(ctx context.Context, params model.VerifyOTPInput) (*model.AuthResponse, error) {
+	return resolvers.VerifyOtpResolver(ctx, params)
+}
+
+func (r *mutationResolver) WebauthnRegistrationOptions(ctx context.Context) (*model.WebauthnOptionsResponse, error) {
+	return resolvers.WebauthnRegistrationOptionsResolver(ctx)
+}
+
+func (r *mutationResolver) WebauthnRegister(ctx context.Context, params model.WebauthnRegisterInput) (*model.WebauthnCredential, error) {
+	return resolvers.WebauthnRegisterResolver(ctx, params)
+}
+
+func (r *mutationResolver) WebauthnLoginOptions(ctx context.Context, params *model.WebauthnLoginOptionsInput) (*model.WebauthnOptionsResponse, error) {
+	return resolvers.WebauthnLoginOptionsResolver(ctx, params)
+}
+
+func (r *mutationResolver) WebauthnLogin(ctx context.Context, params model.WebauthnLoginInput) (*model.AuthResponse, error) {
+	return resolvers.WebauthnLoginResolver(ctx, params)
+}
+
+func (r *mutationResolver) DeleteWebauthnCredential(ctx context.Context, params model.DeleteWebauthnCredentialInput) (*model.Response, error) {
+	return resolvers.DeleteWebauthnCredentialResolver(ctx, params)
+}
+
+func (r *mutationResolver) RevokeSession(ctx context.Context, params model.RevokeSessionInput) (*model.Response, error) {
+	return resolvers.RevokeSessionResolver(ctx, params)
+}
+
 func (r *mutationResolver) DeleteUser(ctx context.Context, params model.DeleteUserInput) (*model.Response, error) {
 	return resolvers.DeleteUserResolver(ctx, params)
 }
@@ -55,6 +123,14 @@ func (r *mutationResolver) UpdateUser(ctx context.Context, params model.UpdateUs
 	return resolvers.UpdateUserResolver(ctx, params)
 }
 
+func (r *mutationResolver) UnlockUser(ctx context.Context, params model.UnlockUserInput) (*model.Response, error) {
+	return resolvers.UnlockUserResolver(ctx, params)
+}
+
+func (r *mutationResolver) ImportUsers(ctx context.Context, params model.ImportUsersInput) (*model.ImportUsersResponse, error) {
+	return resolvers.ImportUsersResolver(ctx, params)
+}
+
 func (r *mutationResolver) AdminSignup(ctx context.Context, params model.AdminSignupInput) (*model.Response, error) {
 	return resolvers.AdminSignupResolver(ctx, params)
 }
@@ -71,6 +147,66 @@ func (r *mutationResolver) UpdateEnv(ctx context.Context, params model.UpdateEnv
 	return resolvers.UpdateEnvResolver(ctx, params)
 }
 
+func (r *mutationResolver) GenerateJwtKey(ctx context.Context, params *model.GenerateJWTKeyInput) (*model.JWTKey, error) {
+	return resolvers.GenerateJwtKeyResolver(ctx, params)
+}
+
+func (r *mutationResolver) PromoteJwtKey(ctx context.Context, params model.JWTKeyInput) (*model.Response, error) {
+	return resolvers.PromoteJwtKeyResolver(ctx, params)
+}
+
+func (r *mutationResolver) RetireJwtKey(ctx context.Context, params model.JWTKeyInput) (*model.Response, error) {
+	return resolvers.RetireJwtKeyResolver(ctx, params)
+}
+
+func (r *mutationResolver) RevokeJwtKey(ctx context.Context, params model.JWTKeyInput) (*model.Response, error) {
+	return resolvers.RevokeJwtKeyResolver(ctx, params)
+}
+
+func (r *mutationResolver) AddClient(ctx context.Context, params model.AddClientInput) (*model.ClientSecretResponse, error) {
+	return resolvers.AddClientResolver(ctx, params)
+}
+
+func (r *mutationResolver) UpdateClient(ctx context.Context, params model.UpdateClientInput) (*model.Client, error) {
+	return resolvers.UpdateClientResolver(ctx, params)
+}
+
+func (r *mutationResolver) DeleteClient(ctx context.Context, params model.ClientInput) (*model.Response, error) {
+	return resolvers.DeleteClientResolver(ctx, params)
+}
+
+func (r *mutationResolver) RegenerateClientSecret(ctx context.Context, params model.ClientInput) (*model.ClientSecretResponse, error) {
+	return resolvers.RegenerateClientSecretResolver(ctx, params)
+}
+
+func (r *mutationResolver) AddWebhook(ctx context.Context, params model.AddWebhookInput) (*model.Webhook, error) {
+	return resolvers.AddWebhookResolver(ctx, params)
+}
+
+func (r *mutationResolver) UpdateWebhook(ctx context.Context, params model.UpdateWebhookInput) (*model.Webhook, error) {
+	return resolvers.UpdateWebhookResolver(ctx, params)
+}
+
+func (r *mutationResolver) DeleteWebhook(ctx context.Context, params model.WebhookInput) (*model.Response, error) {
+	return resolvers.DeleteWebhookResolver(ctx, params)
+}
+
+func (r *mutationResolver) RevokeUserSession(ctx context.Context, params model.RevokeSessionInput) (*model.Response, error) {
+	return resolvers.RevokeUserSessionResolver(ctx, params)
+}
+
+func (r *mutationResolver) AddOidcProvider(ctx context.Context, params model.AddOIDCProviderInput) (*model.OIDCProvider, error) {
+	return resolvers.AddOidcProviderResolver(ctx, params)
+}
+
+func (r *mutationResolver) UpdateOidcProvider(ctx context.Context, params model.UpdateOIDCProviderInput) (*model.OIDCProvider, error) {
+	return resolvers.UpdateOidcProviderResolver(ctx, params)
+}
+
+func (r *mutationResolver) DeleteOidcProvider(ctx context.Context, params model.OIDCProviderInput) (*model.Response, error) {
+	return resolvers.DeleteOidcProviderResolver(ctx, params)
+}
+
 func (r *queryResolver) Meta(ctx context.Context) (*model.Meta, error) {
 	return resolvers.MetaResolver(ctx)
 }
@@ -87,6 +223,14 @@ func (r *queryResolver) Profile(ctx context.Context) (*model.User, error) {
 	return resolvers.ProfileResolver(ctx)
 }
 
+func (r *queryResolver) WebauthnCredentials(ctx context.Context) ([]*model.WebauthnCredential, error) {
+	return resolvers.WebauthnCredentialsResolver(ctx)
+}
+
+func (r *queryResolver) Sessions(ctx context.Context) ([]*model.Session, error) {
+	return resolvers.SessionsResolver(ctx)
+}
+
 func (r *queryResolver) Users(ctx context.Context, params *model.PaginatedInput) (*model.Users, error) {
 	return resolvers.UsersResolver(ctx, params)
 }
@@ -103,6 +247,38 @@ func (r *queryResolver) Env(ctx context.Context) (*model.Env, error) {
 	return resolvers.EnvResolver(ctx)
 }
 
+func (r *queryResolver) JwtKeys(ctx context.Context) ([]*model.JWTKey, error) {
+	return resolvers.JwtKeysResolver(ctx)
+}
+
+func (r *queryResolver) Clients(ctx context.Context, params *model.PaginatedInput) (*model.Clients, error) {
+	return resolvers.ClientsResolver(ctx, params)
+}
+
+func (r *queryResolver) AuditLogs(ctx context.Context, params *model.AuditLogsInput) (*model.AuditLogs, error) {
+	return resolvers.AuditLogsResolver(ctx, params)
+}
+
+func (r *queryResolver) Webhooks(ctx context.Context, params *model.PaginatedInput) (*model.Webhooks, error) {
+	return resolvers.WebhooksResolver(ctx, params)
+}
+
+func (r *queryResolver) WebhookDeliveries(ctx context.Context, params *model.WebhookDeliveriesInput) (*model.WebhookDeliveries, error) {
+	return resolvers.WebhookDeliveriesResolver(ctx, params)
+}
+
+func (r *queryResolver) UserSessions(ctx context.Context, params model.ListSessionsInput) ([]*model.Session, error) {
+	return resolvers.UserSessionsResolver(ctx, params)
+}
+
+func (r *queryResolver) OidcProviders(ctx context.Context, params *model.PaginatedInput) (*model.OIDCProviders, error) {
+	return resolvers.OidcProvidersResolver(ctx, params)
+}
+
+func (r *queryResolver) TestAccessTokenScript(ctx context.Context, params model.TestAccessTokenScriptInput) (*model.TestAccessTokenScriptResponse, error) {
+	return resolvers.TestAccessTokenScriptResolver(ctx, params)
+}
+
 // Mutation returns generated.MutationResolver implementation.
 func (r *Resolver) Mutation() generated.MutationResolver { return &mutationResolver{r} }
 
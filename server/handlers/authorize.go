@@ -0,0 +1,197 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/authorizerdev/authorizer/server/audit"
+	"github.com/authorizerdev/authorizer/server/constants"
+	"github.com/authorizerdev/authorizer/server/crypto"
+	"github.com/authorizerdev/authorizer/server/envstore"
+	"github.com/authorizerdev/authorizer/server/sessionstore"
+	"github.com/authorizerdev/authorizer/server/token"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+const (
+	// authorizationCodeStatePrefix is the prefix of session store key for authorization codes
+	authorizationCodeStatePrefix = "authorization_code_"
+	// authorizationCodeExpiry is the lifetime of authorization code
+	authorizationCodeExpiry = 10 * time.Minute
+)
+
+// AuthorizationCode holds the information bound to the issued authorization code
+type AuthorizationCode struct {
+	ClientID            string   `json:"client_id"`
+	RedirectURI         string   `json:"redirect_uri"`
+	CodeChallenge       string   `json:"code_challenge"`
+	CodeChallengeMethod string   `json:"code_challenge_method"`
+	Scope               string   `json:"scope"`
+	Nonce               string   `json:"nonce"`
+	UserID              string   `json:"user_id"`
+	Roles               []string `json:"roles"`
+}
+
+// AuthorizeHandler is the handler for the /authorize route
+// It implements authorization code flow with PKCE (RFC 6749 & RFC 7636).
+// If user is not logged in, login page is shown which redirects back here after login.
+// User consent is not supported yet, so the registered clients must be marked as trusted
+// to get authorization codes, as the code is issued without asking the user
+func AuthorizeHandler() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		responseType := c.Query("response_type")
+		clientID := c.Query("client_id")
+		redirectURI := c.Query("redirect_uri")
+		state := c.Query("state")
+		codeChallenge := c.Query("code_challenge")
+		codeChallengeMethod := c.DefaultQuery("code_challenge_method", crypto.CodeChallengeMethodS256)
+
+		// invalid client & redirect uri errors should not be redirected to the client
+		client, err := getOAuthClient(clientID)
+		if err != nil {
+			oauthErrorResponse(c, http.StatusBadRequest, "invalid_client", err.Error())
+			return
+		}
+
+		if !isValidClientRedirectURI(client, redirectURI) {
+			oauthErrorResponse(c, http.StatusBadRequest, "invalid_request", "invalid redirect_uri")
+			return
+		}
+
+		if responseType != constants.ResponseTypeCode {
+			redirectWithParams(c, redirectURI, map[string]string{
+				"error":             "unsupported_response_type",
+				"error_description": "only code response type is supported",
+				"state":             state,
+			})
+			return
+		}
+
+		if !isClientGrantTypeAllowed(client, constants.GrantTypeAuthorizationCode) {
+			redirectWithParams(c, redirectURI, map[string]string{
+				"error":             "unauthorized_client",
+				"error_description": "client is not allowed to use authorization code grant",
+				"state":             state,
+			})
+			return
+		}
+
+		if client != nil && !client.Trusted {
+			redirectWithParams(c, redirectURI, map[string]string{
+				"error":             "unauthorized_client",
+				"error_description": "authorization code grant is allowed only for trusted clients, as user consent is not supported yet",
+				"state":             state,
+			})
+			return
+		}
+
+		if !isClientScopeAllowed(client, c.Query("scope")) {
+			redirectWithParams(c, redirectURI, map[string]string{
+				"error":             "invalid_scope",
+				"error_description": "requested scope is not allowed for the client",
+				"state":             state,
+			})
+			return
+		}
+
+		if codeChallenge == "" || !isClientCodeChallengeMethodAllowed(client, codeChallengeMethod) {
+			redirectWithParams(c, redirectURI, map[string]string{
+				"error":             "invalid_request",
+				"error_description": "valid code_challenge and code_challenge_method are required",
+				"state":             state,
+			})
+			return
+		}
+
+		claims, err := token.ValidateAccessToken(c)
+		if err != nil {
+			if envstore.EnvInMemoryStoreObj.GetBoolStoreEnvVariable(constants.EnvKeyDisableLoginPage) {
+				redirectWithParams(c, redirectURI, map[string]string{
+					"error":             "login_required",
+					"error_description": "user is not logged in",
+					"state":             state,
+				})
+				return
+			}
+
+			// show login page, which redirects back to the same authorize url
+			authorizerURL := envstore.EnvInMemoryStoreObj.GetStringStoreEnvVariable(constants.EnvKeyAuthorizerURL)
+			c.HTML(http.StatusOK, "app.tmpl", gin.H{
+				"data": map[string]string{
+					"authorizerURL":    authorizerURL,
+					"redirectURL":      authorizerURL + c.Request.URL.RequestURI(),
+					"organizationName": envstore.EnvInMemoryStoreObj.GetStringStoreEnvVariable(constants.EnvKeyOrganizationName),
+					"organizationLogo": envstore.EnvInMemoryStoreObj.GetStringStoreEnvVariable(constants.EnvKeyOrganizationLogo),
+				},
+			})
+			return
+		}
+		userID, _ := claims["id"].(string)
+		userEmail, _ := claims["email"].(string)
+		audit.SetActor(c, constants.AuditActorTypeUser, userID, userEmail)
+		audit.SetTarget(c, constants.AuditTargetTypeClient, clientID)
+
+		roles := []string{}
+		if claimRoles, ok := claims[envstore.EnvInMemoryStoreObj.GetStringStoreEnvVariable(constants.EnvKeyJwtRoleClaim)].([]interface{}); ok {
+			for _, role := range claimRoles {
+				roles = append(roles, role.(string))
+			}
+		}
+
+		authorizationCode := AuthorizationCode{
+			ClientID:            clientID,
+			RedirectURI:         redirectURI,
+			CodeChallenge:       codeChallenge,
+			CodeChallengeMethod: codeChallengeMethod,
+			Scope:               c.Query("scope"),
+			Nonce:               c.Query("nonce"),
+			UserID:              claims["id"].(string),
+			Roles:               roles,
+		}
+		authorizationCodeBytes, err := json.Marshal(authorizationCode)
+		if err != nil {
+			oauthErrorResponse(c, http.StatusInternalServerError, "server_error", err.Error())
+			return
+		}
+
+		code := uuid.New().String()
+		sessionstore.SetState(authorizationCodeStatePrefix+code, string(authorizationCodeBytes), authorizationCodeExpiry)
+
+		redirectWithParams(c, redirectURI, map[string]string{
+			"code":  code,
+			"state": state,
+		})
+	}
+}
+
+// redirectWithParams redirects to the given url with params added to its query string
+func redirectWithParams(c *gin.Context, redirectURL string, params map[string]string) {
+	u, err := url.Parse(redirectURL)
+	if err != nil {
+		oauthErrorResponse(c, http.StatusBadRequest, "invalid_request", "invalid redirect_uri")
+		return
+	}
+
+	query := u.Query()
+	for key, value := range params {
+		if value != "" {
+			query.Set(key, value)
+		}
+	}
+	u.RawQuery = query.Encode()
+
+	c.Redirect(http.StatusFound, u.String())
+}
+
+// oauthErrorResponse sends the error response as per RFC 6749
+func oauthErrorResponse(c *gin.Context, status int, err, description string) {
+	c.Error(fmt.Errorf("%s: %s", err, description))
+	c.JSON(status, gin.H{
+		"error":             err,
+		"error_description": description,
+	})
+}
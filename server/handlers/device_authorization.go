@@ -0,0 +1,56 @@
+package handlers
+
+import (
+	"net/http"
+	"net/url"
+
+	"github.com/authorizerdev/authorizer/server/audit"
+	"github.com/authorizerdev/authorizer/server/constants"
+	"github.com/authorizerdev/authorizer/server/envstore"
+	"github.com/authorizerdev/authorizer/server/token"
+	"github.com/gin-gonic/gin"
+)
+
+// DeviceAuthorizationHandler is the handler for the /oauth/device_authorization route
+// It issues device code & user code for input constrained devices like CLIs and TVs (RFC 8628).
+// User approves the request by entering user code on /app/device page,
+// while device polls the token endpoint with device code
+func DeviceAuthorizationHandler() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Header("Cache-Control", "no-store")
+
+		client, clientID, err := authenticateOAuthClient(c)
+		if err != nil {
+			oauthErrorResponse(c, http.StatusUnauthorized, "invalid_client", err.Error())
+			return
+		}
+		audit.SetActor(c, constants.AuditActorTypeClient, clientID, "")
+
+		if !isClientGrantTypeAllowed(client, constants.GrantTypeDeviceCode) {
+			oauthErrorResponse(c, http.StatusBadRequest, "unauthorized_client", "client is not allowed to use device code grant")
+			return
+		}
+
+		scope := c.PostForm("scope")
+		if !isClientScopeAllowed(client, scope) {
+			oauthErrorResponse(c, http.StatusBadRequest, "invalid_scope", "requested scope is not allowed for the client")
+			return
+		}
+
+		deviceCode, err := token.CreateDeviceCode(clientID, scope)
+		if err != nil {
+			oauthErrorResponse(c, http.StatusInternalServerError, "server_error", err.Error())
+			return
+		}
+
+		verificationURI := envstore.EnvInMemoryStoreObj.GetStringStoreEnvVariable(constants.EnvKeyAuthorizerURL) + "/app/device"
+		c.JSON(http.StatusOK, gin.H{
+			"device_code":               deviceCode.DeviceCode,
+			"user_code":                 deviceCode.UserCode,
+			"verification_uri":          verificationURI,
+			"verification_uri_complete": verificationURI + "?" + url.Values{"user_code": {deviceCode.UserCode}}.Encode(),
+			"expires_in":                int64(token.DeviceCodeExpiry.Seconds()),
+			"interval":                  int64(token.DeviceCodePollingInterval.Seconds()),
+		})
+	}
+}
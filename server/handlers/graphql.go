@@ -1,9 +1,17 @@
 package handlers
 
 import (
+	"context"
+
+	"github.com/99designs/gqlgen/graphql"
 	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/authorizerdev/authorizer/server/audit"
+	"github.com/authorizerdev/authorizer/server/constants"
+	"github.com/authorizerdev/authorizer/server/db/models"
 	"github.com/authorizerdev/authorizer/server/graph"
 	"github.com/authorizerdev/authorizer/server/graph/generated"
+	"github.com/authorizerdev/authorizer/server/token"
+	"github.com/authorizerdev/authorizer/server/utils"
 	"github.com/gin-gonic/gin"
 )
 
@@ -12,8 +20,58 @@ func GraphqlHandler() gin.HandlerFunc {
 	// NewExecutableSchema and Config are in the generated.go file
 	// Resolver is in the resolver.go file
 	h := handler.NewDefaultServer(generated.NewExecutableSchema(generated.Config{Resolvers: &graph.Resolver{}}))
+	h.AroundFields(auditFieldMiddleware)
 
 	return func(c *gin.Context) {
 		h.ServeHTTP(c.Writer, c.Request)
 	}
 }
+
+// auditActorContextKey is the key of the audit actor of request in gin context
+const auditActorContextKey = "AuditActorKey"
+
+// auditFieldMiddleware records the audit event of every mutation.
+// Queries are not recorded, security events of them like refresh token reuse are recorded where they are detected.
+// Actor is identified from the admin or user session before running the resolver,
+// resolvers of unauthenticated operations like login set the actor & target themselves
+func auditFieldMiddleware(ctx context.Context, next graphql.Resolver) (interface{}, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil || fc.Object != "Mutation" {
+		return next(ctx)
+	}
+
+	gc, err := utils.GinContextFromContext(ctx)
+	if err != nil {
+		return next(ctx)
+	}
+
+	event := audit.NewEvent(fc.Field.Name)
+	actor := getAuditActor(gc)
+	event.ActorType = actor.ActorType
+	event.ActorID = actor.ActorID
+	event.ActorEmail = actor.ActorEmail
+
+	res, err := next(audit.NewContext(ctx, event))
+	audit.Log(gc, *event, err)
+	return res, err
+}
+
+// getAuditActor returns the actor of the admin or user session of request.
+// It is resolved once per request, as a request can have multiple mutations
+func getAuditActor(gc *gin.Context) models.AuditEvent {
+	if actor, ok := gc.Get(auditActorContextKey); ok {
+		return actor.(models.AuditEvent)
+	}
+
+	actor := *audit.NewEvent("")
+	if token.IsSuperAdmin(gc) {
+		actor.ActorType = constants.AuditActorTypeAdmin
+	} else if claims, err := token.ValidateAccessToken(gc); err == nil {
+		actor.ActorType = constants.AuditActorTypeUser
+		actor.ActorID, _ = claims["id"].(string)
+		actor.ActorEmail, _ = claims["email"].(string)
+	}
+	gc.Set(auditActorContextKey, actor)
+
+	return actor
+}
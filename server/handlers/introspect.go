@@ -0,0 +1,96 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/authorizerdev/authorizer/server/audit"
+	"github.com/authorizerdev/authorizer/server/constants"
+	"github.com/authorizerdev/authorizer/server/envstore"
+	"github.com/authorizerdev/authorizer/server/token"
+	"github.com/gin-gonic/gin"
+)
+
+// IntrospectHandler is the handler for the /oauth/introspect route
+// It returns the state of the exact access or refresh token as per RFC 7662,
+// so that API gateways can validate tokens without GraphQL calls.
+// Only confidential clients can introspect tokens, as public client ids are not secret
+func IntrospectHandler() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Header("Cache-Control", "no-store")
+
+		client, clientID, err := authenticateOAuthClient(c)
+		if err != nil {
+			oauthErrorResponse(c, http.StatusUnauthorized, "invalid_client", err.Error())
+			return
+		}
+		audit.SetActor(c, constants.AuditActorTypeClient, clientID, "")
+
+		if client == nil || client.IsPublic() {
+			oauthErrorResponse(c, http.StatusUnauthorized, "invalid_client", "only confidential clients can introspect tokens")
+			return
+		}
+
+		tokenString := c.PostForm("token")
+		if tokenString == "" {
+			oauthErrorResponse(c, http.StatusBadRequest, "invalid_request", "token is required")
+			return
+		}
+
+		inactive := gin.H{"active": false}
+		claims, err := token.VerifyJWTToken(tokenString)
+		if err != nil {
+			c.JSON(http.StatusOK, inactive)
+			return
+		}
+
+		res := gin.H{
+			"active": true,
+			"exp":    claims["exp"],
+			"iat":    claims["iat"],
+			"iss":    envstore.EnvInMemoryStoreObj.GetStringStoreEnvVariable(constants.EnvKeyAuthorizerURL),
+		}
+
+		for _, key := range []string{"aud", "jti", "scope"} {
+			if claims[key] != nil {
+				res[key] = claims[key]
+			}
+		}
+
+		switch claims["token_type"] {
+		case constants.TokenTypeAccessToken:
+			if !token.IsAccessTokenActive(claims) {
+				c.JSON(http.StatusOK, inactive)
+				return
+			}
+			res["token_type"] = "Bearer"
+			res["roles"] = claims[envstore.EnvInMemoryStoreObj.GetStringStoreEnvVariable(constants.EnvKeyJwtRoleClaim)]
+		case constants.TokenTypeRefreshToken:
+			userID, _ := claims["id"].(string)
+			if token.IsTokenRevoked(claims) || token.GetRefreshTokenFingerPrint(userID, tokenString) == "" {
+				c.JSON(http.StatusOK, inactive)
+				return
+			}
+			res["token_type"] = constants.TokenTypeRefreshToken
+			res["roles"] = claims["roles"]
+		default:
+			c.JSON(http.StatusOK, inactive)
+			return
+		}
+
+		if clientID, ok := claims["client_id"]; ok {
+			// token issued via client_credentials grant
+			res["client_id"] = clientID
+			res["sub"] = claims["sub"]
+		} else {
+			userID, _ := claims["id"].(string)
+			audit.SetTarget(c, constants.AuditTargetTypeUser, userID)
+			res["client_id"] = claims["aud"]
+			res["sub"] = claims["id"]
+			if claims["email"] != nil {
+				res["username"] = claims["email"]
+			}
+		}
+
+		c.JSON(http.StatusOK, res)
+	}
+}
@@ -0,0 +1,40 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/authorizerdev/authorizer/server/crypto"
+	"github.com/authorizerdev/authorizer/server/env"
+	"github.com/authorizerdev/authorizer/server/envstore"
+	"github.com/gin-gonic/gin"
+)
+
+// JWKsHandler is the handler for the /.well-known/jwks.json route
+// It publishes the public keys of the key ring used to sign and verify the tokens.
+// HMAC keys don't have public key, so they are never published
+func JWKsHandler() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		stringEnv := envstore.EnvInMemoryStoreObj.GetEnvStoreClone().StringEnv
+		jwtKeys := append([]env.JwtKey{env.GetActiveJwtKey(stringEnv)}, env.GetJwtKeys(stringEnv)...)
+
+		keys := []map[string]interface{}{}
+		for _, key := range jwtKeys {
+			if !crypto.IsAsymmetricAlgo(key.Type) {
+				continue
+			}
+
+			jwk, err := crypto.GetPubJWK(key.Type, key.ID, key.PublicKey)
+			if err != nil {
+				c.JSON(http.StatusInternalServerError, gin.H{
+					"error": err.Error(),
+				})
+				return
+			}
+			keys = append(keys, jwk)
+		}
+
+		c.JSON(http.StatusOK, gin.H{
+			"keys": keys,
+		})
+	}
+}
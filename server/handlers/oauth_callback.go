@@ -10,6 +10,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/authorizerdev/authorizer/server/audit"
 	"github.com/authorizerdev/authorizer/server/constants"
 	"github.com/authorizerdev/authorizer/server/cookie"
 	"github.com/authorizerdev/authorizer/server/db"
@@ -19,6 +20,7 @@ import (
 	"github.com/authorizerdev/authorizer/server/sessionstore"
 	"github.com/authorizerdev/authorizer/server/token"
 	"github.com/authorizerdev/authorizer/server/utils"
+	"github.com/authorizerdev/authorizer/server/webhook"
 	"github.com/coreos/go-oidc/v3/oidc"
 	"github.com/gin-gonic/gin"
 	"golang.org/x/oauth2"
@@ -31,15 +33,16 @@ func OAuthCallbackHandler() gin.HandlerFunc {
 		state := c.Request.FormValue("state")
 
 		sessionState := sessionstore.GetSocailLoginState(state)
-		if sessionState == "" {
+		if sessionState == "" || sessionState != provider {
 			c.JSON(400, gin.H{"error": "invalid oauth state"})
+			return
 		}
 		sessionstore.RemoveSocialLoginState(state)
 		// contains random token, redirect url, role
 		sessionSplit := strings.Split(state, "___")
 
-		// TODO validate redirect url
-		if len(sessionSplit) < 2 {
+		// redirect url is validated by oauth_login, it is validated again in case the allowed urls were changed since then
+		if len(sessionSplit) != 3 || !isValidAppRedirectURL(sessionSplit[1]) {
 			c.JSON(400, gin.H{"error": "invalid redirect url"})
 			return
 		}
@@ -57,8 +60,23 @@ func OAuthCallbackHandler() gin.HandlerFunc {
 			user, err = processGithubUserInfo(code)
 		case constants.SignupMethodFacebook:
 			user, err = processFacebookUserInfo(code)
+		case constants.SignupMethodApple:
+			// name of user is only posted with the first authorization
+			user, err = processAppleUserInfo(code, c.Request.FormValue("user"))
+		case constants.SignupMethodMicrosoft:
+			user, err = processMicrosoftUserInfo(code)
+		case constants.SignupMethodGitlab:
+			user, err = processGitlabUserInfo(code)
+		case constants.SignupMethodLinkedin:
+			user, err = processLinkedinUserInfo(code)
+		case constants.SignupMethodDiscord:
+			user, err = processDiscordUserInfo(code)
+		case constants.SignupMethodTwitter:
+			codeVerifier := sessionstore.GetState(oauthCodeVerifierStatePrefix + state)
+			sessionstore.RemoveState(oauthCodeVerifierStatePrefix + state)
+			user, err = processTwitterUserInfo(code, codeVerifier)
 		default:
-			err = fmt.Errorf(`invalid oauth provider`)
+			user, err = processOIDCUserInfo(provider, code)
 		}
 
 		if err != nil {
@@ -88,16 +106,18 @@ func OAuthCallbackHandler() gin.HandlerFunc {
 			now := time.Now().Unix()
 			user.EmailVerifiedAt = &now
 			user, _ = db.Provider.AddUser(user)
+			webhook.Trigger(constants.WebhookEventUserSignup, user)
 		} else {
 			// user exists in db, check if method was google
 			// if not append google to existing signup method and save it
 
 			signupMethod := existingUser.SignupMethods
-			if !strings.Contains(signupMethod, provider) {
+			if !utils.StringSliceContains(strings.Split(signupMethod, ","), provider) {
 				signupMethod = signupMethod + "," + provider
 			}
+			// keep the existing user data, e.g. second factor and the profile fields not returned by provider
+			user = mergeOAuthUserProfile(existingUser, user)
 			user.SignupMethods = signupMethod
-			user.Password = existingUser.Password
 
 			// There multiple scenarios with roles here in social login
 			// 1. user has access to protected roles + roles and trying to login
@@ -131,18 +151,38 @@ func OAuthCallbackHandler() gin.HandlerFunc {
 			} else {
 				user.Roles = existingUser.Roles
 			}
-			user.Key = existingUser.Key
-			user.ID = existingUser.ID
 			user, err = db.Provider.UpdateUser(user)
 		}
 
 		user, _ = db.Provider.GetUserByEmail(user.Email)
+		audit.SetActor(c, constants.AuditActorTypeUser, user.ID, user.Email)
+
+		// in case of second factor, app completes the login using mfa_token with verify_otp / webauthn_login mutation
+		if mfaMethods := utils.GetMfaMethods(user); len(mfaMethods) > 0 {
+			challenge, err := token.CreateMfaChallenge(user.ID, inputRoles)
+			if err != nil {
+				c.JSON(500, gin.H{"error": err.Error()})
+				return
+			}
+
+			redirectWithParams(c, redirectURL, map[string]string{
+				"mfa_token":   challenge.Token,
+				"mfa_methods": strings.Join(mfaMethods, ","),
+			})
+			return
+		}
 
-		authToken, _ := token.CreateAuthToken(user, inputRoles)
+		authToken, _ := token.CreateAuthToken(user, inputRoles, token.AuthTokenOptions{})
 		sessionstore.SetUserSession(user.ID, authToken.FingerPrint, authToken.RefreshToken.Token)
-		cookie.SetCookie(c, authToken.AccessToken.Token, authToken.RefreshToken.Token, authToken.FingerPrintHash)
-		utils.SaveSessionInDB(user.ID, c)
+		cookie.SetCookie(c, authToken.AccessToken.Token, authToken.RefreshToken.Token, authToken.FingerPrintHash, authToken.AccessToken.ExpiresAt, authToken.RefreshToken.ExpiresAt)
+		utils.SaveSessionInDB(user.ID, authToken.FamilyID, c)
+		webhook.Trigger(constants.WebhookEventUserLogin, user)
 
+		// form_post callback should be redirected with GET, temporary redirect would post the form to app
+		if c.Request.Method == http.MethodPost {
+			c.Redirect(http.StatusSeeOther, redirectURL)
+			return
+		}
 		c.Redirect(http.StatusTemporaryRedirect, redirectURL)
 	}
 }
@@ -271,3 +311,435 @@ func processFacebookUserInfo(code string) (models.User, error) {
 
 	return user, nil
 }
+
+func processOIDCUserInfo(provider string, code string) (models.User, error) {
+	user := models.User{}
+	oidcProvider, err := db.Provider.GetOIDCProviderByName(provider)
+	if err != nil || !oidcProvider.Enabled {
+		return user, fmt.Errorf(`invalid oauth provider`)
+	}
+
+	config, p, err := oauth.GetOIDCProviderConfig(oidcProvider)
+	if err != nil {
+		return user, err
+	}
+
+	ctx := context.Background()
+	oauth2Token, err := config.Exchange(ctx, code)
+	if err != nil {
+		return user, fmt.Errorf("invalid %s exchange code: %s", provider, err.Error())
+	}
+
+	verifier := p.Verifier(&oidc.Config{ClientID: config.ClientID})
+
+	// Extract the ID Token from OAuth2 token.
+	rawIDToken, ok := oauth2Token.Extra("id_token").(string)
+	if !ok {
+		return user, fmt.Errorf("unable to extract id_token")
+	}
+
+	// Parse and verify ID Token payload.
+	idToken, err := verifier.Verify(ctx, rawIDToken)
+	if err != nil {
+		return user, fmt.Errorf("unable to verify id_token: %s", err.Error())
+	}
+
+	claims := map[string]interface{}{}
+	if err := idToken.Claims(&claims); err != nil {
+		return user, fmt.Errorf("unable to extract claims")
+	}
+
+	claimMapping := oidcProvider.GetClaimMapping()
+	// some providers only return the profile claims from userinfo endpoint
+	if _, ok := claims[claimMapping["email"]]; !ok {
+		userInfo, err := p.UserInfo(ctx, oauth2.StaticTokenSource(oauth2Token))
+		if err != nil {
+			return user, fmt.Errorf("unable to get user info: %s", err.Error())
+		}
+		userInfoClaims := map[string]interface{}{}
+		if err := userInfo.Claims(&userInfoClaims); err != nil {
+			return user, fmt.Errorf("unable to extract claims")
+		}
+		for claim, value := range userInfoClaims {
+			if _, ok := claims[claim]; !ok {
+				claims[claim] = value
+			}
+		}
+	}
+
+	getClaim := func(field string) *string {
+		value, ok := claims[claimMapping[field]]
+		if !ok || value == nil {
+			return nil
+		}
+		str := fmt.Sprintf("%v", value)
+		if str == "" {
+			return nil
+		}
+		return &str
+	}
+
+	email := getClaim("email")
+	if email == nil {
+		return user, fmt.Errorf("email claim not found")
+	}
+	// unverified emails can not be trusted to identify the existing user,
+	// email of provider without email_verified claim is only trusted if admin opted in
+	emailVerified := getClaim("email_verified")
+	if (emailVerified == nil && !oidcProvider.TrustEmail) || (emailVerified != nil && *emailVerified != "true") {
+		return user, fmt.Errorf("email not verified by %s", provider)
+	}
+
+	user = models.User{
+		Email:       strings.ToLower(*email),
+		GivenName:   getClaim("given_name"),
+		FamilyName:  getClaim("family_name"),
+		MiddleName:  getClaim("middle_name"),
+		Nickname:    getClaim("nickname"),
+		Picture:     getClaim("picture"),
+		Gender:      getClaim("gender"),
+		Birthdate:   getClaim("birthdate"),
+		PhoneNumber: getClaim("phone_number"),
+	}
+
+	return user, nil
+}
+
+// mergeOAuthUserProfile updates the existing user with the profile fields returned by oauth provider
+func mergeOAuthUserProfile(existingUser models.User, profile models.User) models.User {
+	user := existingUser
+	if profile.GivenName != nil {
+		user.GivenName = profile.GivenName
+	}
+	if profile.FamilyName != nil {
+		user.FamilyName = profile.FamilyName
+	}
+	if profile.MiddleName != nil {
+		user.MiddleName = profile.MiddleName
+	}
+	if profile.Nickname != nil {
+		user.Nickname = profile.Nickname
+	}
+	if profile.Gender != nil {
+		user.Gender = profile.Gender
+	}
+	if profile.Birthdate != nil {
+		user.Birthdate = profile.Birthdate
+	}
+	if profile.Picture != nil {
+		user.Picture = profile.Picture
+	}
+	// phone number is unique and can be verified by user, hence it is only set if missing
+	if user.PhoneNumber == nil {
+		user.PhoneNumber = profile.PhoneNumber
+	}
+
+	return user
+}
+
+// getOAuthUserInfo gets the user info of the access token from provider's api
+func getOAuthUserInfo(provider, userInfoURL string, token *oauth2.Token, userInfo interface{}) error {
+	req, err := http.NewRequest("GET", userInfoURL, nil)
+	if err != nil {
+		return fmt.Errorf("error creating %s user info request: %s", provider, err.Error())
+	}
+	token.SetAuthHeader(req)
+
+	client := http.Client{}
+	response, err := client.Do(req)
+	if err != nil {
+		log.Printf("error processing %s user info: %s", provider, err.Error())
+		return err
+	}
+	defer response.Body.Close()
+
+	body, err := ioutil.ReadAll(response.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read %s response body: %s", provider, err.Error())
+	}
+	if response.StatusCode != http.StatusOK {
+		return fmt.Errorf("failed to get %s user info: %s", provider, string(body))
+	}
+
+	return json.Unmarshal(body, userInfo)
+}
+
+// stringPointer returns the pointer of non empty string, nil otherwise
+func stringPointer(value string) *string {
+	if strings.TrimSpace(value) == "" {
+		return nil
+	}
+
+	return &value
+}
+
+func processAppleUserInfo(code string, userInfo string) (models.User, error) {
+	user := models.User{}
+	clientSecret, err := oauth.GetAppleClientSecret()
+	if err != nil {
+		return user, err
+	}
+
+	// client secret is short lived, hence the config is copied with new client secret for each exchange
+	config := *oauth.OAuthProviders.AppleConfig
+	config.ClientSecret = clientSecret
+	ctx := context.Background()
+	oauth2Token, err := config.Exchange(ctx, code)
+	if err != nil {
+		return user, fmt.Errorf("invalid apple exchange code: %s", err.Error())
+	}
+
+	verifier := oauth.OIDCProviders.AppleOIDC.Verifier(&oidc.Config{ClientID: config.ClientID})
+
+	// Extract the ID Token from OAuth2 token.
+	rawIDToken, ok := oauth2Token.Extra("id_token").(string)
+	if !ok {
+		return user, fmt.Errorf("unable to extract id_token")
+	}
+
+	// Parse and verify ID Token payload.
+	idToken, err := verifier.Verify(ctx, rawIDToken)
+	if err != nil {
+		return user, fmt.Errorf("unable to verify id_token: %s", err.Error())
+	}
+
+	// email_verified is sent as string or boolean by apple
+	claims := struct {
+		Email         string      `json:"email"`
+		EmailVerified interface{} `json:"email_verified"`
+	}{}
+	if err := idToken.Claims(&claims); err != nil {
+		return user, fmt.Errorf("unable to extract claims")
+	}
+	if claims.Email == "" {
+		return user, fmt.Errorf("email not found in apple id_token")
+	}
+	if fmt.Sprintf("%v", claims.EmailVerified) != "true" {
+		return user, fmt.Errorf("email not verified by apple")
+	}
+
+	appleUser := struct {
+		Name struct {
+			FirstName string `json:"firstName"`
+			LastName  string `json:"lastName"`
+		} `json:"name"`
+	}{}
+	if userInfo != "" {
+		json.Unmarshal([]byte(userInfo), &appleUser)
+	}
+
+	user = models.User{
+		GivenName:  stringPointer(appleUser.Name.FirstName),
+		FamilyName: stringPointer(appleUser.Name.LastName),
+		Email:      strings.ToLower(claims.Email),
+	}
+
+	return user, nil
+}
+
+func processMicrosoftUserInfo(code string) (models.User, error) {
+	user := models.User{}
+	ctx := context.Background()
+	oauth2Token, err := oauth.OAuthProviders.MicrosoftConfig.Exchange(ctx, code)
+	if err != nil {
+		return user, fmt.Errorf("invalid microsoft exchange code: %s", err.Error())
+	}
+
+	verifier := oauth.OIDCProviders.MicrosoftOIDC.Verifier(&oidc.Config{ClientID: oauth.OAuthProviders.MicrosoftConfig.ClientID})
+
+	// Extract the ID Token from OAuth2 token.
+	rawIDToken, ok := oauth2Token.Extra("id_token").(string)
+	if !ok {
+		return user, fmt.Errorf("unable to extract id_token")
+	}
+
+	// Parse and verify ID Token payload.
+	idToken, err := verifier.Verify(ctx, rawIDToken)
+	if err != nil {
+		return user, fmt.Errorf("unable to verify id_token: %s", err.Error())
+	}
+
+	// mail & user principal name can be set to any address by the tenant admin, hence only the email
+	// with domain verified by the tenant (xms_edov optional claim) identifies the user.
+	// xms_edov is sent as string or boolean
+	claims := struct {
+		Email                    string      `json:"email"`
+		EmailDomainOwnerVerified interface{} `json:"xms_edov"`
+		GivenName                string      `json:"given_name"`
+		FamilyName               string      `json:"family_name"`
+	}{}
+	if err := idToken.Claims(&claims); err != nil {
+		return user, fmt.Errorf("unable to extract claims")
+	}
+	if claims.Email == "" {
+		return user, fmt.Errorf("email not found in microsoft id_token")
+	}
+	if fmt.Sprintf("%v", claims.EmailDomainOwnerVerified) != "true" {
+		return user, fmt.Errorf("email not verified by microsoft")
+	}
+
+	user = models.User{
+		GivenName:  stringPointer(claims.GivenName),
+		FamilyName: stringPointer(claims.FamilyName),
+		Email:      strings.ToLower(claims.Email),
+	}
+
+	return user, nil
+}
+
+func processGitlabUserInfo(code string) (models.User, error) {
+	user := models.User{}
+	token, err := oauth.OAuthProviders.GitlabConfig.Exchange(context.Background(), code)
+	if err != nil {
+		return user, fmt.Errorf("invalid gitlab exchange code: %s", err.Error())
+	}
+
+	userRawData := struct {
+		Name        string `json:"name"`
+		Username    string `json:"username"`
+		Email       string `json:"email"`
+		ConfirmedAt string `json:"confirmed_at"`
+		AvatarURL   string `json:"avatar_url"`
+	}{}
+	err = getOAuthUserInfo(constants.SignupMethodGitlab, oauth.UserInfoURLs[constants.SignupMethodGitlab], token, &userRawData)
+	if err != nil {
+		return user, err
+	}
+	if userRawData.Email == "" {
+		return user, fmt.Errorf("email not found in gitlab account")
+	}
+	if userRawData.ConfirmedAt == "" {
+		return user, fmt.Errorf("email not verified by gitlab")
+	}
+
+	name := strings.SplitN(strings.TrimSpace(userRawData.Name), " ", 2)
+	firstName := name[0]
+	lastName := ""
+	if len(name) > 1 {
+		lastName = name[1]
+	}
+
+	user = models.User{
+		GivenName:  stringPointer(firstName),
+		FamilyName: stringPointer(lastName),
+		Nickname:   stringPointer(userRawData.Username),
+		Picture:    stringPointer(userRawData.AvatarURL),
+		Email:      strings.ToLower(userRawData.Email),
+	}
+
+	return user, nil
+}
+
+func processLinkedinUserInfo(code string) (models.User, error) {
+	user := models.User{}
+	token, err := oauth.OAuthProviders.LinkedinConfig.Exchange(context.Background(), code)
+	if err != nil {
+		return user, fmt.Errorf("invalid linkedin exchange code: %s", err.Error())
+	}
+
+	userRawData := struct {
+		GivenName     string `json:"given_name"`
+		FamilyName    string `json:"family_name"`
+		Picture       string `json:"picture"`
+		Email         string `json:"email"`
+		EmailVerified bool   `json:"email_verified"`
+	}{}
+	err = getOAuthUserInfo(constants.SignupMethodLinkedin, oauth.UserInfoURLs[constants.SignupMethodLinkedin], token, &userRawData)
+	if err != nil {
+		return user, err
+	}
+	if userRawData.Email == "" {
+		return user, fmt.Errorf("email not found in linkedin account")
+	}
+	if !userRawData.EmailVerified {
+		return user, fmt.Errorf("email not verified by linkedin")
+	}
+
+	user = models.User{
+		GivenName:  stringPointer(userRawData.GivenName),
+		FamilyName: stringPointer(userRawData.FamilyName),
+		Picture:    stringPointer(userRawData.Picture),
+		Email:      strings.ToLower(userRawData.Email),
+	}
+
+	return user, nil
+}
+
+func processDiscordUserInfo(code string) (models.User, error) {
+	user := models.User{}
+	token, err := oauth.OAuthProviders.DiscordConfig.Exchange(context.Background(), code)
+	if err != nil {
+		return user, fmt.Errorf("invalid discord exchange code: %s", err.Error())
+	}
+
+	userRawData := struct {
+		ID         string `json:"id"`
+		Username   string `json:"username"`
+		GlobalName string `json:"global_name"`
+		Avatar     string `json:"avatar"`
+		Email      string `json:"email"`
+		Verified   bool   `json:"verified"`
+	}{}
+	err = getOAuthUserInfo(constants.SignupMethodDiscord, oauth.UserInfoURLs[constants.SignupMethodDiscord], token, &userRawData)
+	if err != nil {
+		return user, err
+	}
+	if userRawData.Email == "" {
+		return user, fmt.Errorf("email not found in discord account")
+	}
+	if !userRawData.Verified {
+		return user, fmt.Errorf("email not verified by discord")
+	}
+
+	picture := ""
+	if userRawData.Avatar != "" {
+		picture = fmt.Sprintf(constants.DiscordAvatarURL, userRawData.ID, userRawData.Avatar)
+	}
+
+	user = models.User{
+		GivenName: stringPointer(userRawData.GlobalName),
+		Nickname:  stringPointer(userRawData.Username),
+		Picture:   stringPointer(picture),
+		Email:     strings.ToLower(userRawData.Email),
+	}
+
+	return user, nil
+}
+
+func processTwitterUserInfo(code string, codeVerifier string) (models.User, error) {
+	user := models.User{}
+	if codeVerifier == "" {
+		return user, fmt.Errorf("invalid twitter code verifier")
+	}
+
+	token, err := oauth.OAuthProviders.TwitterConfig.Exchange(context.Background(), code, oauth2.SetAuthURLParam("code_verifier", codeVerifier))
+	if err != nil {
+		return user, fmt.Errorf("invalid twitter exchange code: %s", err.Error())
+	}
+
+	userRawData := struct {
+		Data struct {
+			Name            string `json:"name"`
+			Username        string `json:"username"`
+			ProfileImageURL string `json:"profile_image_url"`
+			ConfirmedEmail  string `json:"confirmed_email"`
+		} `json:"data"`
+	}{}
+	err = getOAuthUserInfo(constants.SignupMethodTwitter, oauth.UserInfoURLs[constants.SignupMethodTwitter], token, &userRawData)
+	if err != nil {
+		return user, err
+	}
+	// email is only returned if app has the permission to request email of users
+	if userRawData.Data.ConfirmedEmail == "" {
+		return user, fmt.Errorf("email not found in twitter account")
+	}
+
+	user = models.User{
+		GivenName: stringPointer(userRawData.Data.Name),
+		Nickname:  stringPointer(userRawData.Data.Username),
+		Picture:   stringPointer(userRawData.Data.ProfileImageURL),
+		Email:     strings.ToLower(userRawData.Data.ConfirmedEmail),
+	}
+
+	return user, nil
+}
@@ -0,0 +1,129 @@
+package handlers
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/authorizerdev/authorizer/server/constants"
+	"github.com/authorizerdev/authorizer/server/crypto"
+	"github.com/authorizerdev/authorizer/server/db"
+	"github.com/authorizerdev/authorizer/server/db/models"
+	"github.com/authorizerdev/authorizer/server/envstore"
+	"github.com/authorizerdev/authorizer/server/utils"
+	"github.com/gin-gonic/gin"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// getOAuthClient returns the registered client for given client id.
+// nil client is returned for first party apps identified by CLIENT_ID
+func getOAuthClient(clientID string) (*models.Client, error) {
+	if clientID == "" {
+		return nil, fmt.Errorf("client_id is required")
+	}
+
+	if clientID == envstore.EnvInMemoryStoreObj.GetStringStoreEnvVariable(constants.EnvKeyClientID) {
+		return nil, nil
+	}
+
+	client, err := db.Provider.GetClientByClientID(clientID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid client_id")
+	}
+
+	return &client, nil
+}
+
+// isValidClientRedirectURI validates the redirect uri.
+// It needs an exact match with one of the redirect uris of registered client,
+// or CLIENT_REDIRECT_URIS in case of first party apps
+func isValidClientRedirectURI(client *models.Client, redirectURI string) bool {
+	if redirectURI == "" {
+		return false
+	}
+
+	if client == nil {
+		return utils.StringSliceContains(envstore.EnvInMemoryStoreObj.GetSliceStoreEnvVariable(constants.EnvKeyClientRedirectURIs), redirectURI)
+	}
+
+	return utils.StringSliceContains(client.GetRedirectURIs(), redirectURI)
+}
+
+// isValidAppRedirectURL validates the redirect url of first party app, e.g. after social login.
+// It should be an absolute http(s) url, which is either one of CLIENT_REDIRECT_URIS,
+// on the authorizer url or on one of ALLOWED_ORIGINS
+func isValidAppRedirectURL(redirectURL string) bool {
+	u, err := url.Parse(redirectURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" || u.User != nil {
+		return false
+	}
+
+	if isValidClientRedirectURI(nil, redirectURL) {
+		return true
+	}
+
+	authorizerURL, err := url.Parse(envstore.EnvInMemoryStoreObj.GetStringStoreEnvVariable(constants.EnvKeyAuthorizerURL))
+	if err == nil && authorizerURL.Scheme == u.Scheme && authorizerURL.Host == u.Host {
+		return true
+	}
+
+	return utils.IsValidOrigin(redirectURL)
+}
+
+// isClientCodeChallengeMethodAllowed checks if the client can use given PKCE method.
+// plain method is allowed only for registered clients that opted in
+func isClientCodeChallengeMethodAllowed(client *models.Client, method string) bool {
+	if method == crypto.CodeChallengeMethodPlain {
+		return client != nil && client.AllowPlainPKCE
+	}
+
+	return crypto.IsValidCodeChallengeMethod(method)
+}
+
+// isClientGrantTypeAllowed checks if the client can use given grant type
+func isClientGrantTypeAllowed(client *models.Client, grantType string) bool {
+	if client == nil {
+		return grantType == constants.GrantTypeAuthorizationCode || grantType == constants.GrantTypeRefreshToken || grantType == constants.GrantTypeDeviceCode
+	}
+
+	return utils.StringSliceContains(client.GetGrantTypes(), grantType)
+}
+
+// isClientScopeAllowed checks if all the space separated scopes are allowed for the client
+func isClientScopeAllowed(client *models.Client, scope string) bool {
+	if client == nil {
+		return true
+	}
+
+	for _, s := range strings.Fields(scope) {
+		if !utils.StringSliceContains(client.GetScopes(), s) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// authenticateOAuthClient authenticates the client making token request.
+// Credentials are accepted via HTTP basic auth or request body (client_secret_post).
+// Confidential clients must send valid secret, public clients only send client_id
+func authenticateOAuthClient(c *gin.Context) (*models.Client, string, error) {
+	clientID, clientSecret, hasBasicAuth := c.Request.BasicAuth()
+	if !hasBasicAuth {
+		clientID = c.PostForm("client_id")
+		clientSecret = c.PostForm("client_secret")
+	}
+
+	client, err := getOAuthClient(clientID)
+	if err != nil {
+		return nil, clientID, err
+	}
+
+	if client != nil && !client.IsPublic() {
+		if clientSecret == "" || bcrypt.CompareHashAndPassword([]byte(client.ClientSecret), []byte(clientSecret)) != nil {
+			return nil, clientID, fmt.Errorf("invalid client credentials")
+		}
+	}
+
+	return client, clientID, nil
+}
@@ -1,26 +1,38 @@
 package handlers
 
 import (
+	"log"
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/authorizerdev/authorizer/server/constants"
+	"github.com/authorizerdev/authorizer/server/crypto"
+	"github.com/authorizerdev/authorizer/server/db"
 	"github.com/authorizerdev/authorizer/server/envstore"
 	"github.com/authorizerdev/authorizer/server/oauth"
 	"github.com/authorizerdev/authorizer/server/sessionstore"
 	"github.com/authorizerdev/authorizer/server/utils"
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
+	"golang.org/x/oauth2"
+)
+
+const (
+	// oauthCodeVerifierStatePrefix is the prefix of session store key for PKCE code verifier of oauth state
+	oauthCodeVerifierStatePrefix = "oauth_code_verifier_"
+	// oauthCodeVerifierExpiry is the time within which the user should complete the login with provider
+	oauthCodeVerifierExpiry = 10 * time.Minute
 )
 
 // OAuthLoginHandler set host in the oauth state that is useful for redirecting to oauth_callback
 func OAuthLoginHandler() gin.HandlerFunc {
 	return func(c *gin.Context) {
-		// TODO validate redirect URL
 		redirectURL := c.Query("redirectURL")
 		roles := c.Query("roles")
 
-		if redirectURL == "" {
+		// redirect url is part of the oauth state, hence it can not contain the state separator
+		if !isValidAppRedirectURL(redirectURL) || strings.Contains(redirectURL, "___") {
 			c.JSON(400, gin.H{
 				"error": "invalid redirect url",
 			})
@@ -77,10 +89,88 @@ func OAuthLoginHandler() gin.HandlerFunc {
 			oauth.OAuthProviders.FacebookConfig.RedirectURL = envstore.EnvInMemoryStoreObj.GetStringStoreEnvVariable(constants.EnvKeyAuthorizerURL) + "/oauth_callback/facebook"
 			url := oauth.OAuthProviders.FacebookConfig.AuthCodeURL(oauthStateString)
 			c.Redirect(http.StatusTemporaryRedirect, url)
+		case constants.SignupMethodApple:
+			if oauth.OAuthProviders.AppleConfig == nil {
+				isProviderConfigured = false
+				break
+			}
+			sessionstore.SetSocailLoginState(oauthStateString, constants.SignupMethodApple)
+			oauth.OAuthProviders.AppleConfig.RedirectURL = envstore.EnvInMemoryStoreObj.GetStringStoreEnvVariable(constants.EnvKeyAuthorizerURL) + "/oauth_callback/apple"
+			// apple requires form_post response mode when name or email scope is requested
+			url := oauth.OAuthProviders.AppleConfig.AuthCodeURL(oauthStateString, oauth2.SetAuthURLParam("response_mode", "form_post"))
+			c.Redirect(http.StatusTemporaryRedirect, url)
+		case constants.SignupMethodMicrosoft:
+			if oauth.OAuthProviders.MicrosoftConfig == nil {
+				isProviderConfigured = false
+				break
+			}
+			sessionstore.SetSocailLoginState(oauthStateString, constants.SignupMethodMicrosoft)
+			oauth.OAuthProviders.MicrosoftConfig.RedirectURL = envstore.EnvInMemoryStoreObj.GetStringStoreEnvVariable(constants.EnvKeyAuthorizerURL) + "/oauth_callback/microsoft"
+			url := oauth.OAuthProviders.MicrosoftConfig.AuthCodeURL(oauthStateString)
+			c.Redirect(http.StatusTemporaryRedirect, url)
+		case constants.SignupMethodGitlab:
+			if oauth.OAuthProviders.GitlabConfig == nil {
+				isProviderConfigured = false
+				break
+			}
+			sessionstore.SetSocailLoginState(oauthStateString, constants.SignupMethodGitlab)
+			oauth.OAuthProviders.GitlabConfig.RedirectURL = envstore.EnvInMemoryStoreObj.GetStringStoreEnvVariable(constants.EnvKeyAuthorizerURL) + "/oauth_callback/gitlab"
+			url := oauth.OAuthProviders.GitlabConfig.AuthCodeURL(oauthStateString)
+			c.Redirect(http.StatusTemporaryRedirect, url)
+		case constants.SignupMethodLinkedin:
+			if oauth.OAuthProviders.LinkedinConfig == nil {
+				isProviderConfigured = false
+				break
+			}
+			sessionstore.SetSocailLoginState(oauthStateString, constants.SignupMethodLinkedin)
+			oauth.OAuthProviders.LinkedinConfig.RedirectURL = envstore.EnvInMemoryStoreObj.GetStringStoreEnvVariable(constants.EnvKeyAuthorizerURL) + "/oauth_callback/linkedin"
+			url := oauth.OAuthProviders.LinkedinConfig.AuthCodeURL(oauthStateString)
+			c.Redirect(http.StatusTemporaryRedirect, url)
+		case constants.SignupMethodDiscord:
+			if oauth.OAuthProviders.DiscordConfig == nil {
+				isProviderConfigured = false
+				break
+			}
+			sessionstore.SetSocailLoginState(oauthStateString, constants.SignupMethodDiscord)
+			oauth.OAuthProviders.DiscordConfig.RedirectURL = envstore.EnvInMemoryStoreObj.GetStringStoreEnvVariable(constants.EnvKeyAuthorizerURL) + "/oauth_callback/discord"
+			url := oauth.OAuthProviders.DiscordConfig.AuthCodeURL(oauthStateString)
+			c.Redirect(http.StatusTemporaryRedirect, url)
+		case constants.SignupMethodTwitter:
+			if oauth.OAuthProviders.TwitterConfig == nil {
+				isProviderConfigured = false
+				break
+			}
+			// twitter requires PKCE, code verifier is used in the code exchange of oauth_callback
+			codeVerifier, err := utils.GenerateRandomString(32)
+			if err != nil {
+				c.JSON(500, gin.H{
+					"error": err.Error(),
+				})
+				return
+			}
+			sessionstore.SetSocailLoginState(oauthStateString, constants.SignupMethodTwitter)
+			sessionstore.SetState(oauthCodeVerifierStatePrefix+oauthStateString, codeVerifier, oauthCodeVerifierExpiry)
+			oauth.OAuthProviders.TwitterConfig.RedirectURL = envstore.EnvInMemoryStoreObj.GetStringStoreEnvVariable(constants.EnvKeyAuthorizerURL) + "/oauth_callback/twitter"
+			url := oauth.OAuthProviders.TwitterConfig.AuthCodeURL(oauthStateString, oauth2.SetAuthURLParam("code_challenge", crypto.NewS256CodeChallenge(codeVerifier)), oauth2.SetAuthURLParam("code_challenge_method", crypto.CodeChallengeMethodS256))
+			c.Redirect(http.StatusTemporaryRedirect, url)
 		default:
-			c.JSON(422, gin.H{
-				"message": "Invalid oauth provider",
-			})
+			// generic OpenID Connect providers configured by admin
+			oidcProvider, err := db.Provider.GetOIDCProviderByName(provider)
+			if err != nil || !oidcProvider.Enabled {
+				c.JSON(422, gin.H{
+					"message": "Invalid oauth provider",
+				})
+				return
+			}
+			config, _, err := oauth.GetOIDCProviderConfig(oidcProvider)
+			if err != nil {
+				log.Println("error getting oidc provider config:", err)
+				isProviderConfigured = false
+				break
+			}
+			sessionstore.SetSocailLoginState(oauthStateString, oidcProvider.Name)
+			url := config.AuthCodeURL(oauthStateString)
+			c.Redirect(http.StatusTemporaryRedirect, url)
 		}
 
 		if !isProviderConfigured {
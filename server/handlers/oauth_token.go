@@ -0,0 +1,309 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/authorizerdev/authorizer/server/audit"
+	"github.com/authorizerdev/authorizer/server/constants"
+	"github.com/authorizerdev/authorizer/server/crypto"
+	"github.com/authorizerdev/authorizer/server/db"
+	"github.com/authorizerdev/authorizer/server/db/models"
+	"github.com/authorizerdev/authorizer/server/sessionstore"
+	"github.com/authorizerdev/authorizer/server/token"
+	"github.com/authorizerdev/authorizer/server/utils"
+	"github.com/authorizerdev/authorizer/server/webhook"
+	"github.com/gin-gonic/gin"
+)
+
+// OAuthTokenHandler is the handler for the /oauth/token route
+// It exchanges authorization code or refresh token for new set of tokens,
+// and issues tokens to confidential clients via client credentials grant
+func OAuthTokenHandler() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		// token responses should never be cached
+		c.Header("Cache-Control", "no-store")
+		c.Header("Pragma", "no-cache")
+
+		client, clientID, err := authenticateOAuthClient(c)
+		if err != nil {
+			oauthErrorResponse(c, http.StatusUnauthorized, "invalid_client", err.Error())
+			return
+		}
+		audit.SetActor(c, constants.AuditActorTypeClient, clientID, "")
+
+		grantType := c.PostForm("grant_type")
+		if utils.IsValidClientGrantType(grantType) && !isClientGrantTypeAllowed(client, grantType) {
+			oauthErrorResponse(c, http.StatusBadRequest, "unauthorized_client", "client is not allowed to use "+grantType+" grant")
+			return
+		}
+
+		switch grantType {
+		case constants.GrantTypeAuthorizationCode:
+			authorizationCodeGrant(c, client, clientID)
+		case constants.GrantTypeRefreshToken:
+			refreshTokenGrant(c, client, clientID)
+		case constants.GrantTypeClientCredentials:
+			clientCredentialsGrant(c, client)
+		case constants.GrantTypeDeviceCode:
+			deviceCodeGrant(c, client, clientID)
+		default:
+			oauthErrorResponse(c, http.StatusBadRequest, "unsupported_grant_type", "grant_type must be authorization_code, refresh_token, client_credentials or device_code")
+		}
+	}
+}
+
+// authorizationCodeGrant exchanges the authorization code for tokens after verifying PKCE code verifier
+func authorizationCodeGrant(c *gin.Context, client *models.Client, clientID string) {
+	code := c.PostForm("code")
+	if code == "" {
+		oauthErrorResponse(c, http.StatusBadRequest, "invalid_request", "code is required")
+		return
+	}
+
+	// authorization code can be used only once, hence it is removed while reading
+	codeData := sessionstore.PopState(authorizationCodeStatePrefix + code)
+	if codeData == "" {
+		oauthErrorResponse(c, http.StatusBadRequest, "invalid_grant", "invalid or expired code")
+		return
+	}
+
+	var authorizationCode AuthorizationCode
+	if err := json.Unmarshal([]byte(codeData), &authorizationCode); err != nil {
+		oauthErrorResponse(c, http.StatusBadRequest, "invalid_grant", "invalid code")
+		return
+	}
+
+	if authorizationCode.ClientID != clientID || authorizationCode.RedirectURI != c.PostForm("redirect_uri") {
+		oauthErrorResponse(c, http.StatusBadRequest, "invalid_grant", "client_id or redirect_uri does not match")
+		return
+	}
+
+	if !crypto.VerifyCodeChallenge(c.PostForm("code_verifier"), authorizationCode.CodeChallenge, authorizationCode.CodeChallengeMethod) {
+		oauthErrorResponse(c, http.StatusBadRequest, "invalid_grant", "invalid code_verifier")
+		return
+	}
+
+	user, err := db.Provider.GetUserByID(authorizationCode.UserID)
+	if err != nil {
+		oauthErrorResponse(c, http.StatusBadRequest, "invalid_grant", "user not found")
+		return
+	}
+	audit.SetTarget(c, constants.AuditTargetTypeUser, user.ID)
+
+	authToken, err := token.CreateAuthToken(user, authorizationCode.Roles, token.AuthTokenOptions{
+		Nonce:  authorizationCode.Nonce,
+		Client: client,
+	})
+	if err != nil {
+		oauthErrorResponse(c, http.StatusInternalServerError, "server_error", err.Error())
+		return
+	}
+	sessionstore.SetUserSession(user.ID, authToken.FingerPrint, authToken.RefreshToken.Token)
+	utils.SaveSessionInDB(user.ID, authToken.FamilyID, c)
+	webhook.Trigger(constants.WebhookEventUserLogin, user)
+
+	tokenResponse(c, authToken, authorizationCode.Scope)
+}
+
+// refreshTokenGrant exchanges the refresh token for new set of tokens.
+// Old refresh token is invalidated as refresh tokens are rotated on every use
+func refreshTokenGrant(c *gin.Context, client *models.Client, clientID string) {
+	refreshToken := c.PostForm("refresh_token")
+	if refreshToken == "" {
+		oauthErrorResponse(c, http.StatusBadRequest, "invalid_request", "refresh_token is required")
+		return
+	}
+
+	claims, err := token.VerifyJWTToken(refreshToken)
+	if err != nil || claims["token_type"] != constants.TokenTypeRefreshToken {
+		oauthErrorResponse(c, http.StatusBadRequest, "invalid_grant", "invalid refresh_token")
+		return
+	}
+
+	// refresh token can only be used by the client it was issued to
+	if claims["aud"] != clientID {
+		oauthErrorResponse(c, http.StatusBadRequest, "invalid_grant", "refresh_token was issued to another client")
+		return
+	}
+
+	// refresh token that was already rotated might be stolen, hence revoke its family
+	if token.IsRefreshTokenReused(claims) {
+		token.HandleRefreshTokenReuse(c, claims)
+		oauthErrorResponse(c, http.StatusBadRequest, "invalid_grant", "invalid refresh_token")
+		return
+	}
+
+	userID := claims["id"].(string)
+	// parallel requests using the refresh token rotated within the grace period
+	// get the tokens of the latest session instead of rotating it again
+	rotated := !token.LockRefreshTokenRotation(claims)
+	// refresh token issued via token endpoint is not bound to cookie fingerprint,
+	// hence find the session using refresh token
+	fingerPrint := token.GetRefreshTokenFingerPrint(userID, refreshToken)
+	if !rotated && fingerPrint == "" {
+		oauthErrorResponse(c, http.StatusBadRequest, "invalid_grant", "invalid refresh_token")
+		return
+	}
+
+	user, err := db.Provider.GetUserByID(userID)
+	if err != nil {
+		oauthErrorResponse(c, http.StatusBadRequest, "invalid_grant", "user not found")
+		return
+	}
+	audit.SetTarget(c, constants.AuditTargetTypeUser, user.ID)
+
+	roles := []string{}
+	if claimRoles, ok := claims["roles"].([]interface{}); ok {
+		for _, role := range claimRoles {
+			roles = append(roles, role.(string))
+		}
+	}
+
+	var authTime int64
+	if v, ok := claims["auth_time"].(float64); ok {
+		authTime = int64(v)
+	}
+
+	if rotated {
+		authToken, err := token.GetRefreshTokenFamilyAuthToken(user, roles, claims, token.AuthTokenOptions{
+			AuthTime: authTime,
+			Client:   client,
+		})
+		if err != nil {
+			oauthErrorResponse(c, http.StatusBadRequest, "invalid_grant", "invalid refresh_token")
+			return
+		}
+		utils.UpdateSessionLastUsedInDB(authToken.FamilyID)
+
+		tokenResponse(c, authToken, "")
+		return
+	}
+
+	sessionstore.DeleteUserSession(userID, fingerPrint)
+	authToken, err := token.CreateAuthToken(user, roles, token.AuthTokenOptions{
+		AuthTime: authTime,
+		Client:   client,
+		FamilyID: token.GetRefreshTokenFamilyID(claims),
+	})
+	if err != nil {
+		oauthErrorResponse(c, http.StatusInternalServerError, "server_error", err.Error())
+		return
+	}
+	sessionstore.SetUserSession(user.ID, authToken.FingerPrint, authToken.RefreshToken.Token)
+	utils.UpdateSessionLastUsedInDB(authToken.FamilyID)
+
+	tokenResponse(c, authToken, "")
+}
+
+// clientCredentialsGrant issues access token for the client itself.
+// Only confidential clients can use it, and no refresh token is issued as per RFC 6749
+func clientCredentialsGrant(c *gin.Context, client *models.Client) {
+	if client.IsPublic() {
+		oauthErrorResponse(c, http.StatusBadRequest, "unauthorized_client", "public clients can not use client_credentials grant")
+		return
+	}
+
+	scopes := client.GetScopes()
+	if scope := c.PostForm("scope"); scope != "" {
+		if !isClientScopeAllowed(client, scope) {
+			oauthErrorResponse(c, http.StatusBadRequest, "invalid_scope", "requested scope is not allowed for the client")
+			return
+		}
+		scopes = strings.Fields(scope)
+	}
+
+	accessToken, expiresAt, err := token.CreateClientAccessToken(*client, scopes)
+	if err != nil {
+		oauthErrorResponse(c, http.StatusInternalServerError, "server_error", err.Error())
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"access_token": accessToken,
+		"token_type":   "Bearer",
+		"expires_in":   expiresAt - time.Now().Unix(),
+		"scope":        strings.Join(scopes, " "),
+	})
+}
+
+// deviceCodeGrant exchanges the device code for tokens once user approves it.
+// Till then device gets authorization_pending error, and slow_down error if it polls too often
+func deviceCodeGrant(c *gin.Context, client *models.Client, clientID string) {
+	code := c.PostForm("device_code")
+	if code == "" {
+		oauthErrorResponse(c, http.StatusBadRequest, "invalid_request", "device_code is required")
+		return
+	}
+
+	deviceCode, err := token.GetDeviceCode(code)
+	if err != nil {
+		oauthErrorResponse(c, http.StatusBadRequest, "expired_token", err.Error())
+		return
+	}
+
+	if deviceCode.ClientID != clientID {
+		oauthErrorResponse(c, http.StatusBadRequest, "invalid_grant", "device_code was issued to another client")
+		return
+	}
+
+	switch deviceCode.Status {
+	case constants.DeviceCodeStatusDenied:
+		token.RemoveDeviceCode(deviceCode)
+		oauthErrorResponse(c, http.StatusBadRequest, "access_denied", "user denied the request")
+		return
+	case constants.DeviceCodeStatusPending:
+		now := time.Now().Unix()
+		tooFast := now-deviceCode.LastPolledAt < int64(token.DeviceCodePollingInterval.Seconds())
+		deviceCode.LastPolledAt = now
+		token.SaveDeviceCode(deviceCode)
+		if tooFast {
+			oauthErrorResponse(c, http.StatusBadRequest, "slow_down", "polling too frequently")
+			return
+		}
+		oauthErrorResponse(c, http.StatusBadRequest, "authorization_pending", "user has not approved the request yet")
+		return
+	}
+
+	// device code can be used only once
+	token.RemoveDeviceCode(deviceCode)
+
+	user, err := db.Provider.GetUserByID(deviceCode.UserID)
+	if err != nil {
+		oauthErrorResponse(c, http.StatusBadRequest, "invalid_grant", "user not found")
+		return
+	}
+	audit.SetTarget(c, constants.AuditTargetTypeUser, user.ID)
+
+	authToken, err := token.CreateAuthToken(user, deviceCode.Roles, token.AuthTokenOptions{
+		Client: client,
+	})
+	if err != nil {
+		oauthErrorResponse(c, http.StatusInternalServerError, "server_error", err.Error())
+		return
+	}
+	sessionstore.SetUserSession(user.ID, authToken.FingerPrint, authToken.RefreshToken.Token)
+	utils.SaveSessionInDB(user.ID, authToken.FamilyID, c)
+	webhook.Trigger(constants.WebhookEventUserLogin, user)
+
+	tokenResponse(c, authToken, deviceCode.Scope)
+}
+
+// tokenResponse sends the successful token response as per RFC 6749
+func tokenResponse(c *gin.Context, authToken *token.Token, scope string) {
+	res := gin.H{
+		"access_token":  authToken.AccessToken.Token,
+		"token_type":    "Bearer",
+		"expires_in":    authToken.AccessToken.ExpiresAt - time.Now().Unix(),
+		"refresh_token": authToken.RefreshToken.Token,
+		"id_token":      authToken.IDToken.Token,
+	}
+
+	if scope != "" {
+		res["scope"] = scope
+	}
+
+	c.JSON(http.StatusOK, res)
+}
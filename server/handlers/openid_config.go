@@ -0,0 +1,43 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/authorizerdev/authorizer/server/constants"
+	"github.com/authorizerdev/authorizer/server/crypto"
+	"github.com/authorizerdev/authorizer/server/envstore"
+	"github.com/gin-gonic/gin"
+)
+
+// OpenIDConfigurationHandler is the handler for the /.well-known/openid-configuration route
+// It returns the OpenID Connect discovery document describing the endpoints & supported features
+func OpenIDConfigurationHandler() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		issuer := envstore.EnvInMemoryStoreObj.GetStringStoreEnvVariable(constants.EnvKeyAuthorizerURL)
+		jwtType := envstore.EnvInMemoryStoreObj.GetStringStoreEnvVariable(constants.EnvKeyJwtType)
+
+		c.JSON(http.StatusOK, gin.H{
+			"issuer":                                issuer,
+			"authorization_endpoint":                issuer + "/authorize",
+			"token_endpoint":                        issuer + "/oauth/token",
+			"device_authorization_endpoint":         issuer + "/oauth/device_authorization",
+			"jwks_uri":                              issuer + "/.well-known/jwks.json",
+			"userinfo_endpoint":                     issuer + "/userinfo",
+			"introspection_endpoint":                issuer + "/oauth/introspect",
+			"revocation_endpoint":                   issuer + "/oauth/revoke",
+			"response_types_supported":              []string{constants.ResponseTypeCode},
+			"grant_types_supported":                 []string{constants.GrantTypeAuthorizationCode, constants.GrantTypeRefreshToken, constants.GrantTypeClientCredentials, constants.GrantTypeDeviceCode},
+			"code_challenge_methods_supported":      []string{crypto.CodeChallengeMethodS256, crypto.CodeChallengeMethodPlain},
+			"token_endpoint_auth_methods_supported": []string{"none", "client_secret_basic", "client_secret_post"},
+			"subject_types_supported":               []string{"public"},
+			"id_token_signing_alg_values_supported": []string{jwtType},
+			"scopes_supported":                      []string{"openid", "email", "profile"},
+			"claims_supported": []string{
+				"iss", "sub", "aud", "exp", "iat", "auth_time", "nonce",
+				"email", "email_verified", "given_name", "family_name", "middle_name",
+				"nickname", "preferred_username", "gender", "birthdate",
+				"phone_number", "phone_number_verified", "picture", "updated_at",
+			},
+		})
+	}
+}
@@ -0,0 +1,58 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/authorizerdev/authorizer/server/audit"
+	"github.com/authorizerdev/authorizer/server/constants"
+	"github.com/authorizerdev/authorizer/server/token"
+	"github.com/gin-gonic/gin"
+)
+
+// RevokeHandler is the handler for the /oauth/revoke route
+// It revokes the exact access or refresh token as per RFC 7009.
+// Revoking refresh token also ends the session, hence access tokens issued with it.
+// Invalid tokens are ignored and responded with success as suggested by RFC
+func RevokeHandler() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		_, clientID, err := authenticateOAuthClient(c)
+		if err != nil {
+			oauthErrorResponse(c, http.StatusUnauthorized, "invalid_client", err.Error())
+			return
+		}
+		audit.SetActor(c, constants.AuditActorTypeClient, clientID, "")
+
+		tokenString := c.PostForm("token")
+		if tokenString == "" {
+			oauthErrorResponse(c, http.StatusBadRequest, "invalid_request", "token is required")
+			return
+		}
+
+		claims, err := token.VerifyJWTToken(tokenString)
+		if err != nil {
+			c.Status(http.StatusOK)
+			return
+		}
+
+		// client can only revoke the tokens issued to it
+		if claims["aud"] != clientID {
+			oauthErrorResponse(c, http.StatusBadRequest, "unauthorized_client", "token was issued to another client")
+			return
+		}
+
+		userID, _ := claims["id"].(string)
+		audit.SetTarget(c, constants.AuditTargetTypeUser, userID)
+
+		switch claims["token_type"] {
+		case constants.TokenTypeAccessToken:
+			token.RevokeToken(claims)
+		case constants.TokenTypeRefreshToken:
+			if fingerPrint := token.GetRefreshTokenFingerPrint(userID, tokenString); fingerPrint != "" {
+				token.DeleteUserSession(userID, fingerPrint)
+			}
+			token.RevokeToken(claims)
+		}
+
+		c.Status(http.StatusOK)
+	}
+}
@@ -0,0 +1,32 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/authorizerdev/authorizer/server/audit"
+	"github.com/authorizerdev/authorizer/server/constants"
+	"github.com/authorizerdev/authorizer/server/db"
+	"github.com/authorizerdev/authorizer/server/token"
+	"github.com/gin-gonic/gin"
+)
+
+// UserInfoHandler is the handler for the /userinfo route
+// It returns the OpenID Connect profile claims of the user for the given access token
+func UserInfoHandler() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		claims, err := token.ValidateAccessToken(c)
+		if err != nil {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+			return
+		}
+
+		user, err := db.Provider.GetUserByID(claims["id"].(string))
+		if err != nil {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+			return
+		}
+		audit.SetActor(c, constants.AuditActorTypeUser, user.ID, user.Email)
+
+		c.JSON(http.StatusOK, token.GetUserInfoClaims(user))
+	}
+}
@@ -5,11 +5,14 @@ import (
 	"strings"
 	"time"
 
+	"github.com/authorizerdev/authorizer/server/audit"
+	"github.com/authorizerdev/authorizer/server/constants"
 	"github.com/authorizerdev/authorizer/server/cookie"
 	"github.com/authorizerdev/authorizer/server/db"
 	"github.com/authorizerdev/authorizer/server/sessionstore"
 	"github.com/authorizerdev/authorizer/server/token"
 	"github.com/authorizerdev/authorizer/server/utils"
+	"github.com/authorizerdev/authorizer/server/webhook"
 	"github.com/gin-gonic/gin"
 )
 
@@ -39,6 +42,7 @@ func VerifyEmailHandler() gin.HandlerFunc {
 			return
 		}
 
+		audit.SetActor(c, constants.AuditActorTypeUser, "", claim.Email)
 		user, err := db.Provider.GetUserByEmail(claim.Email)
 		if err != nil {
 			c.JSON(400, gin.H{
@@ -46,18 +50,45 @@ func VerifyEmailHandler() gin.HandlerFunc {
 			})
 			return
 		}
+		audit.SetActor(c, constants.AuditActorTypeUser, user.ID, user.Email)
 
 		// update email_verified_at in users table
 		if user.EmailVerifiedAt == nil {
 			now := time.Now().Unix()
 			user.EmailVerifiedAt = &now
 			db.Provider.UpdateUser(user)
+			webhook.Trigger(constants.WebhookEventUserEmailVerified, user)
 		}
 		// delete from verification table
 		db.Provider.DeleteVerificationRequest(verificationRequest)
 
 		roles := strings.Split(user.Roles, ",")
-		authToken, err := token.CreateAuthToken(user, roles)
+		// in case of second factor, app completes the login using mfa_token with verify_otp / webauthn_login mutation
+		if mfaMethods := utils.GetMfaMethods(user); len(mfaMethods) > 0 {
+			// mfa token can complete the login, hence it is sent only to the allowed redirect url
+			if !isValidAppRedirectURL(claim.RedirectURL) {
+				c.JSON(400, gin.H{
+					"message": "invalid redirect url",
+				})
+				return
+			}
+
+			challenge, err := token.CreateMfaChallenge(user.ID, roles)
+			if err != nil {
+				c.JSON(400, gin.H{
+					"message": err.Error(),
+				})
+				return
+			}
+
+			redirectWithParams(c, claim.RedirectURL, map[string]string{
+				"mfa_token":   challenge.Token,
+				"mfa_methods": strings.Join(mfaMethods, ","),
+			})
+			return
+		}
+
+		authToken, err := token.CreateAuthToken(user, roles, token.AuthTokenOptions{})
 		if err != nil {
 			c.JSON(400, gin.H{
 				"message": err.Error(),
@@ -65,8 +96,9 @@ func VerifyEmailHandler() gin.HandlerFunc {
 			return
 		}
 		sessionstore.SetUserSession(user.ID, authToken.FingerPrint, authToken.RefreshToken.Token)
-		cookie.SetCookie(c, authToken.AccessToken.Token, authToken.RefreshToken.Token, authToken.FingerPrintHash)
-		utils.SaveSessionInDB(user.ID, c)
+		cookie.SetCookie(c, authToken.AccessToken.Token, authToken.RefreshToken.Token, authToken.FingerPrintHash, authToken.AccessToken.ExpiresAt, authToken.RefreshToken.ExpiresAt)
+		utils.SaveSessionInDB(user.ID, authToken.FamilyID, c)
+		webhook.Trigger(constants.WebhookEventUserLogin, user)
 
 		c.Redirect(http.StatusTemporaryRedirect, claim.RedirectURL)
 	}
@@ -2,7 +2,9 @@ package main
 
 import (
 	"flag"
+	"log"
 
+	"github.com/authorizerdev/authorizer/server/audit"
 	"github.com/authorizerdev/authorizer/server/constants"
 	"github.com/authorizerdev/authorizer/server/db"
 	"github.com/authorizerdev/authorizer/server/env"
@@ -10,6 +12,8 @@ import (
 	"github.com/authorizerdev/authorizer/server/oauth"
 	"github.com/authorizerdev/authorizer/server/routes"
 	"github.com/authorizerdev/authorizer/server/sessionstore"
+	"github.com/authorizerdev/authorizer/server/userimport"
+	"github.com/authorizerdev/authorizer/server/webhook"
 )
 
 var VERSION string
@@ -18,6 +22,8 @@ func main() {
 	envstore.ARG_DB_URL = flag.String("database_url", "", "Database connection string")
 	envstore.ARG_DB_TYPE = flag.String("database_type", "", "Database type, possible values are postgres,mysql,sqlite")
 	envstore.ARG_ENV_FILE = flag.String("env_file", "", "Env file path")
+	importUsersFile := flag.String("import_users", "", "Users export file (json or csv) to import instead of starting the server")
+	importUsersFormat := flag.String("import_format", "", "Format of users export file, possible values are json,csv. Defaults to the file extension")
 	flag.Parse()
 
 	envstore.EnvInMemoryStoreObj.UpdateEnvVariable(constants.StringStoreIdentifier, constants.EnvKeyVersion, VERSION)
@@ -26,8 +32,22 @@ func main() {
 	db.InitDB()
 	env.PersistEnv()
 
+	if *importUsersFile != "" {
+		result, err := userimport.ImportFile(*importUsersFile, *importUsersFormat)
+		if err != nil {
+			log.Fatalln("error importing users:", err)
+		}
+		for _, importError := range result.Errors {
+			log.Printf("row %d (%s): %s\n", importError.Row, importError.Email, importError.Error)
+		}
+		log.Printf("%d users imported, %d failed\n", result.Imported, len(result.Errors))
+		return
+	}
+
 	sessionstore.InitSession()
 	oauth.InitOAuth()
+	webhook.StartRetryWorker()
+	audit.StartRetentionWorker()
 
 	router := routes.InitRouter()
 
@@ -0,0 +1,29 @@
+package middlewares
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/authorizerdev/authorizer/server/audit"
+	"github.com/gin-gonic/gin"
+)
+
+// AuditMiddleware is a middleware to record the audit event of the handler with given action.
+// Handler sets the actor & target, and error status codes mark the event as failed
+// with the last error of gin context as message
+func AuditMiddleware(action string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		event := audit.NewEvent(action)
+		audit.SetGinContext(c, event)
+		c.Next()
+
+		var err error
+		if status := c.Writer.Status(); status >= http.StatusBadRequest {
+			err = fmt.Errorf("%d %s", status, http.StatusText(status))
+			if len(c.Errors) > 0 {
+				err = c.Errors.Last()
+			}
+		}
+		audit.Log(c, *event, err)
+	}
+}
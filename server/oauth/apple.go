@@ -0,0 +1,41 @@
+package oauth
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/authorizerdev/authorizer/server/constants"
+	"github.com/authorizerdev/authorizer/server/crypto"
+	"github.com/authorizerdev/authorizer/server/envstore"
+	"github.com/golang-jwt/jwt"
+)
+
+// appleClientSecretExpiry is the validity of client secret JWT, it is only used for single code exchange
+const appleClientSecretExpiry = 5 * time.Minute
+
+// GetAppleClientSecret returns the client secret for sign in with apple.
+// Apple does not issue static client secret, instead it is the ES256 JWT
+// signed with the private key downloaded from apple developer account.
+// Ref: https://developer.apple.com/documentation/sign_in_with_apple/generate_and_validate_tokens
+func GetAppleClientSecret() (string, error) {
+	// private key is multi line, it can be set with escaped new lines in env
+	privateKey := strings.ReplaceAll(envstore.EnvInMemoryStoreObj.GetStringStoreEnvVariable(constants.EnvKeyApplePrivateKey), `\n`, "\n")
+	key, err := crypto.ParsePrivateKey("ES256", privateKey)
+	if err != nil {
+		return "", fmt.Errorf("invalid apple private key: %s", err.Error())
+	}
+
+	now := time.Now()
+	claims := jwt.StandardClaims{
+		Issuer:    envstore.EnvInMemoryStoreObj.GetStringStoreEnvVariable(constants.EnvKeyAppleTeamID),
+		Subject:   envstore.EnvInMemoryStoreObj.GetStringStoreEnvVariable(constants.EnvKeyAppleClientID),
+		Audience:  "https://appleid.apple.com",
+		IssuedAt:  now.Unix(),
+		ExpiresAt: now.Add(appleClientSecretExpiry).Unix(),
+	}
+	t := jwt.NewWithClaims(jwt.SigningMethodES256, claims)
+	t.Header["kid"] = envstore.EnvInMemoryStoreObj.GetStringStoreEnvVariable(constants.EnvKeyAppleKeyID)
+
+	return t.SignedString(key)
+}
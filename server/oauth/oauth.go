@@ -2,26 +2,39 @@ package oauth
 
 import (
 	"context"
+	"fmt"
 	"log"
+	"strings"
 
 	"github.com/authorizerdev/authorizer/server/constants"
 	"github.com/authorizerdev/authorizer/server/envstore"
+	"github.com/authorizerdev/authorizer/server/utils"
 	"github.com/coreos/go-oidc/v3/oidc"
 	"golang.org/x/oauth2"
 	facebookOAuth2 "golang.org/x/oauth2/facebook"
 	githubOAuth2 "golang.org/x/oauth2/github"
+	gitlabOAuth2 "golang.org/x/oauth2/gitlab"
+	linkedinOAuth2 "golang.org/x/oauth2/linkedin"
 )
 
 // OAuthProviders is a struct that contains reference all the OAuth providers
 type OAuthProvider struct {
-	GoogleConfig   *oauth2.Config
-	GithubConfig   *oauth2.Config
-	FacebookConfig *oauth2.Config
+	GoogleConfig    *oauth2.Config
+	GithubConfig    *oauth2.Config
+	FacebookConfig  *oauth2.Config
+	AppleConfig     *oauth2.Config
+	MicrosoftConfig *oauth2.Config
+	GitlabConfig    *oauth2.Config
+	LinkedinConfig  *oauth2.Config
+	DiscordConfig   *oauth2.Config
+	TwitterConfig   *oauth2.Config
 }
 
 // OIDCProviders is a struct that contains reference all the OpenID providers
 type OIDCProvider struct {
-	GoogleOIDC *oidc.Provider
+	GoogleOIDC    *oidc.Provider
+	AppleOIDC     *oidc.Provider
+	MicrosoftOIDC *oidc.Provider
 }
 
 var (
@@ -31,10 +44,37 @@ var (
 	OIDCProviders OIDCProvider
 )
 
+var (
+	// discordEndpoint is discord's OAuth 2.0 endpoint
+	discordEndpoint = oauth2.Endpoint{
+		AuthURL:  "https://discord.com/oauth2/authorize",
+		TokenURL: "https://discord.com/api/oauth2/token",
+	}
+	// twitterEndpoint is twitter's OAuth 2.0 endpoint, it requires PKCE
+	twitterEndpoint = oauth2.Endpoint{
+		AuthURL:  "https://twitter.com/i/oauth2/authorize",
+		TokenURL: "https://api.twitter.com/2/oauth2/token",
+	}
+	// microsoftMultiTenants are the tenants of microsoft accounts from any organization,
+	// their id_token issuer is not fixed and email is not verified by the tenant
+	microsoftMultiTenants = []string{"common", "organizations", "consumers"}
+
+	// UserInfoURLs are the user info api urls of oauth providers by signup method,
+	// they can be changed to use other api servers, e.g. stub server in tests
+	UserInfoURLs = map[string]string{
+		constants.SignupMethodGitlab:   constants.GitlabUserInfoURL,
+		constants.SignupMethodLinkedin: constants.LinkedinUserInfoURL,
+		constants.SignupMethodDiscord:  constants.DiscordUserInfoURL,
+		constants.SignupMethodTwitter:  constants.TwitterUserInfoURL,
+	}
+)
+
 // InitOAuth initializes the OAuth providers based on EnvData
 func InitOAuth() {
 	ctx := context.Background()
-	if envstore.EnvInMemoryStoreObj.GetStringStoreEnvVariable(constants.EnvKeyGoogleClientID) != "" && envstore.EnvInMemoryStoreObj.GetStringStoreEnvVariable(constants.EnvKeyGoogleClientSecret) != "" {
+	authorizerURL := envstore.EnvInMemoryStoreObj.GetStringStoreEnvVariable(constants.EnvKeyAuthorizerURL)
+	enabledProviders := utils.GetConfiguredOAuthProviders()
+	if utils.StringSliceContains(enabledProviders, constants.SignupMethodGoogle) {
 		p, err := oidc.NewProvider(ctx, "https://accounts.google.com")
 		if err != nil {
 			log.Fatalln("error creating oidc provider for google:", err)
@@ -43,26 +83,120 @@ func InitOAuth() {
 		OAuthProviders.GoogleConfig = &oauth2.Config{
 			ClientID:     envstore.EnvInMemoryStoreObj.GetStringStoreEnvVariable(constants.EnvKeyGoogleClientID),
 			ClientSecret: envstore.EnvInMemoryStoreObj.GetStringStoreEnvVariable(constants.EnvKeyGoogleClientSecret),
-			RedirectURL:  envstore.EnvInMemoryStoreObj.GetStringStoreEnvVariable(constants.EnvKeyAuthorizerURL) + "/oauth_callback/google",
+			RedirectURL:  authorizerURL + "/oauth_callback/google",
 			Endpoint:     OIDCProviders.GoogleOIDC.Endpoint(),
 			Scopes:       []string{oidc.ScopeOpenID, "profile", "email"},
 		}
 	}
-	if envstore.EnvInMemoryStoreObj.GetStringStoreEnvVariable(constants.EnvKeyGithubClientID) != "" && envstore.EnvInMemoryStoreObj.GetStringStoreEnvVariable(constants.EnvKeyGithubClientSecret) != "" {
+	if utils.StringSliceContains(enabledProviders, constants.SignupMethodGithub) {
 		OAuthProviders.GithubConfig = &oauth2.Config{
 			ClientID:     envstore.EnvInMemoryStoreObj.GetStringStoreEnvVariable(constants.EnvKeyGithubClientID),
 			ClientSecret: envstore.EnvInMemoryStoreObj.GetStringStoreEnvVariable(constants.EnvKeyGithubClientSecret),
-			RedirectURL:  envstore.EnvInMemoryStoreObj.GetStringStoreEnvVariable(constants.EnvKeyAuthorizerURL) + "/oauth_callback/github",
+			RedirectURL:  authorizerURL + "/oauth_callback/github",
 			Endpoint:     githubOAuth2.Endpoint,
 		}
 	}
-	if envstore.EnvInMemoryStoreObj.GetStringStoreEnvVariable(constants.EnvKeyFacebookClientID) != "" && envstore.EnvInMemoryStoreObj.GetStringStoreEnvVariable(constants.EnvKeyGoogleClientID) != "" {
+	if utils.StringSliceContains(enabledProviders, constants.SignupMethodFacebook) {
 		OAuthProviders.FacebookConfig = &oauth2.Config{
 			ClientID:     envstore.EnvInMemoryStoreObj.GetStringStoreEnvVariable(constants.EnvKeyFacebookClientID),
 			ClientSecret: envstore.EnvInMemoryStoreObj.GetStringStoreEnvVariable(constants.EnvKeyFacebookClientSecret),
-			RedirectURL:  envstore.EnvInMemoryStoreObj.GetStringStoreEnvVariable(constants.EnvKeyAuthorizerURL) + "/oauth_callback/facebook",
+			RedirectURL:  authorizerURL + "/oauth_callback/facebook",
 			Endpoint:     facebookOAuth2.Endpoint,
 			Scopes:       []string{"public_profile", "email"},
 		}
 	}
+	if utils.StringSliceContains(enabledProviders, constants.SignupMethodApple) {
+		p, err := oidc.NewProvider(ctx, "https://appleid.apple.com")
+		if err != nil {
+			log.Fatalln("error creating oidc provider for apple:", err)
+		}
+		OIDCProviders.AppleOIDC = p
+		// client secret is generated for each code exchange, see GetAppleClientSecret
+		OAuthProviders.AppleConfig = &oauth2.Config{
+			ClientID:    envstore.EnvInMemoryStoreObj.GetStringStoreEnvVariable(constants.EnvKeyAppleClientID),
+			RedirectURL: authorizerURL + "/oauth_callback/apple",
+			Endpoint:    OIDCProviders.AppleOIDC.Endpoint(),
+			Scopes:      []string{"name", "email"},
+		}
+	}
+	if utils.StringSliceContains(enabledProviders, constants.SignupMethodMicrosoft) {
+		tenantID := envstore.EnvInMemoryStoreObj.GetStringStoreEnvVariable(constants.EnvKeyMicrosoftTenantID)
+		if utils.StringSliceContains(microsoftMultiTenants, strings.ToLower(tenantID)) {
+			log.Printf("microsoft login is disabled, %s should be the directory (tenant) id instead of %s\n", constants.EnvKeyMicrosoftTenantID, tenantID)
+		} else {
+			p, err := oidc.NewProvider(ctx, fmt.Sprintf("https://login.microsoftonline.com/%s/v2.0", tenantID))
+			if err != nil {
+				log.Fatalln("error creating oidc provider for microsoft:", err)
+			}
+			OIDCProviders.MicrosoftOIDC = p
+			OAuthProviders.MicrosoftConfig = &oauth2.Config{
+				ClientID:     envstore.EnvInMemoryStoreObj.GetStringStoreEnvVariable(constants.EnvKeyMicrosoftClientID),
+				ClientSecret: envstore.EnvInMemoryStoreObj.GetStringStoreEnvVariable(constants.EnvKeyMicrosoftClientSecret),
+				RedirectURL:  authorizerURL + "/oauth_callback/microsoft",
+				Endpoint:     OIDCProviders.MicrosoftOIDC.Endpoint(),
+				Scopes:       []string{oidc.ScopeOpenID, "profile", "email"},
+			}
+		}
+	}
+	if utils.StringSliceContains(enabledProviders, constants.SignupMethodGitlab) {
+		OAuthProviders.GitlabConfig = &oauth2.Config{
+			ClientID:     envstore.EnvInMemoryStoreObj.GetStringStoreEnvVariable(constants.EnvKeyGitlabClientID),
+			ClientSecret: envstore.EnvInMemoryStoreObj.GetStringStoreEnvVariable(constants.EnvKeyGitlabClientSecret),
+			RedirectURL:  authorizerURL + "/oauth_callback/gitlab",
+			Endpoint:     gitlabOAuth2.Endpoint,
+			Scopes:       []string{"read_user"},
+		}
+	}
+	if utils.StringSliceContains(enabledProviders, constants.SignupMethodLinkedin) {
+		OAuthProviders.LinkedinConfig = &oauth2.Config{
+			ClientID:     envstore.EnvInMemoryStoreObj.GetStringStoreEnvVariable(constants.EnvKeyLinkedinClientID),
+			ClientSecret: envstore.EnvInMemoryStoreObj.GetStringStoreEnvVariable(constants.EnvKeyLinkedinClientSecret),
+			RedirectURL:  authorizerURL + "/oauth_callback/linkedin",
+			Endpoint:     linkedinOAuth2.Endpoint,
+			Scopes:       []string{oidc.ScopeOpenID, "profile", "email"},
+		}
+	}
+	if utils.StringSliceContains(enabledProviders, constants.SignupMethodDiscord) {
+		OAuthProviders.DiscordConfig = &oauth2.Config{
+			ClientID:     envstore.EnvInMemoryStoreObj.GetStringStoreEnvVariable(constants.EnvKeyDiscordClientID),
+			ClientSecret: envstore.EnvInMemoryStoreObj.GetStringStoreEnvVariable(constants.EnvKeyDiscordClientSecret),
+			RedirectURL:  authorizerURL + "/oauth_callback/discord",
+			Endpoint:     discordEndpoint,
+			Scopes:       []string{"identify", "email"},
+		}
+	}
+	if utils.StringSliceContains(enabledProviders, constants.SignupMethodTwitter) {
+		OAuthProviders.TwitterConfig = &oauth2.Config{
+			ClientID:     envstore.EnvInMemoryStoreObj.GetStringStoreEnvVariable(constants.EnvKeyTwitterClientID),
+			ClientSecret: envstore.EnvInMemoryStoreObj.GetStringStoreEnvVariable(constants.EnvKeyTwitterClientSecret),
+			RedirectURL:  authorizerURL + "/oauth_callback/twitter",
+			Endpoint:     twitterEndpoint,
+			Scopes:       []string{"users.read", "tweet.read", "users.email"},
+		}
+	}
+}
+
+// GetEnabledProviders returns the built in social login providers initialized with InitOAuth
+func GetEnabledProviders() []string {
+	providers := []string{}
+	for _, provider := range []struct {
+		name   string
+		config *oauth2.Config
+	}{
+		{constants.SignupMethodGoogle, OAuthProviders.GoogleConfig},
+		{constants.SignupMethodGithub, OAuthProviders.GithubConfig},
+		{constants.SignupMethodFacebook, OAuthProviders.FacebookConfig},
+		{constants.SignupMethodApple, OAuthProviders.AppleConfig},
+		{constants.SignupMethodMicrosoft, OAuthProviders.MicrosoftConfig},
+		{constants.SignupMethodGitlab, OAuthProviders.GitlabConfig},
+		{constants.SignupMethodLinkedin, OAuthProviders.LinkedinConfig},
+		{constants.SignupMethodDiscord, OAuthProviders.DiscordConfig},
+		{constants.SignupMethodTwitter, OAuthProviders.TwitterConfig},
+	} {
+		if provider.config != nil {
+			providers = append(providers, provider.name)
+		}
+	}
+
+	return providers
 }
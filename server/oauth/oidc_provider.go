@@ -0,0 +1,66 @@
+package oauth
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/authorizerdev/authorizer/server/constants"
+	"github.com/authorizerdev/authorizer/server/db/models"
+	"github.com/authorizerdev/authorizer/server/envstore"
+	"github.com/authorizerdev/authorizer/server/utils"
+	"github.com/coreos/go-oidc/v3/oidc"
+	"golang.org/x/oauth2"
+)
+
+var (
+	// discoveredProviders caches the discovery document and keys of OpenID Connect providers by issuer url
+	discoveredProviders      = map[string]*oidc.Provider{}
+	discoveredProvidersMutex sync.Mutex
+)
+
+// DiscoverOIDCProvider returns the OpenID Connect provider for the issuer url
+// using its discovery document. Discovered providers are cached by issuer url
+func DiscoverOIDCProvider(issuerURL string) (*oidc.Provider, error) {
+	issuerURL = strings.TrimSuffix(issuerURL, "/")
+
+	discoveredProvidersMutex.Lock()
+	defer discoveredProvidersMutex.Unlock()
+
+	if p, ok := discoveredProviders[issuerURL]; ok {
+		return p, nil
+	}
+
+	p, err := oidc.NewProvider(context.Background(), issuerURL)
+	if err != nil {
+		return nil, fmt.Errorf("error discovering oidc provider: %s", err.Error())
+	}
+	discoveredProviders[issuerURL] = p
+
+	return p, nil
+}
+
+// GetOIDCProviderConfig returns the oauth2 config and discovered OpenID Connect provider
+// for the generic OpenID Connect provider configured by admin
+func GetOIDCProviderConfig(oidcProvider models.OIDCProvider) (*oauth2.Config, *oidc.Provider, error) {
+	p, err := DiscoverOIDCProvider(oidcProvider.IssuerURL)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	clientSecret, err := utils.DecryptAES([]byte(oidcProvider.ClientSecret))
+	if err != nil {
+		return nil, nil, fmt.Errorf("error decrypting client secret: %s", err.Error())
+	}
+
+	config := &oauth2.Config{
+		ClientID:     oidcProvider.ClientID,
+		ClientSecret: string(clientSecret),
+		RedirectURL:  envstore.EnvInMemoryStoreObj.GetStringStoreEnvVariable(constants.EnvKeyAuthorizerURL) + "/oauth_callback/" + oidcProvider.Name,
+		Endpoint:     p.Endpoint(),
+		Scopes:       oidcProvider.GetScopes(),
+	}
+
+	return config, p, nil
+}
@@ -0,0 +1,96 @@
+package passwordpolicy
+
+import (
+	"bufio"
+	"crypto/sha1"
+	"encoding/hex"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/authorizerdev/authorizer/server/constants"
+	"github.com/authorizerdev/authorizer/server/envstore"
+)
+
+// IsBreached checks if SHA-1 hash of the password is listed in BREACHED_PASSWORDS_FILE.
+// The file has one hex encoded hash per line, optionally followed by :count
+// (format of the Have I Been Pwned downloads), and it must be sorted by hash.
+// The file is binary searched on disk, so that large lists are not loaded in memory
+func IsBreached(password string) (bool, error) {
+	path := envstore.EnvInMemoryStoreObj.GetStringStoreEnvVariable(constants.EnvKeyBreachedPasswordsFile)
+	if path == "" {
+		return false, nil
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return false, err
+	}
+	defer file.Close()
+
+	stat, err := file.Stat()
+	if err != nil {
+		return false, err
+	}
+
+	sum := sha1.Sum([]byte(password))
+	hash := strings.ToUpper(hex.EncodeToString(sum[:]))
+
+	// find the first line (starting at or after offset) with hash >= password hash
+	low, high := int64(0), stat.Size()
+	for low < high {
+		mid := low + (high-low)/2
+		lineHash, ok, err := readHashAfter(file, mid)
+		if err != nil {
+			return false, err
+		}
+
+		if !ok || lineHash >= hash {
+			high = mid
+		} else {
+			low = mid + 1
+		}
+	}
+
+	lineHash, ok, err := readHashAfter(file, low)
+	if err != nil {
+		return false, err
+	}
+
+	return ok && lineHash == hash, nil
+}
+
+// readHashAfter returns the hash of first line starting at or after the offset
+func readHashAfter(file *os.File, offset int64) (string, bool, error) {
+	start := offset
+	if offset > 0 {
+		// line starts at offset only if previous character is new line
+		start = offset - 1
+	}
+
+	if _, err := file.Seek(start, io.SeekStart); err != nil {
+		return "", false, err
+	}
+
+	reader := bufio.NewReader(file)
+	if offset > 0 {
+		if _, err := reader.ReadString('\n'); err != nil {
+			if err == io.EOF {
+				return "", false, nil
+			}
+			return "", false, err
+		}
+	}
+
+	line, err := reader.ReadString('\n')
+	if err != nil && err != io.EOF {
+		return "", false, err
+	}
+
+	line = strings.TrimSpace(line)
+	if line == "" {
+		return "", false, nil
+	}
+
+	return strings.ToUpper(strings.TrimSpace(strings.SplitN(line, ":", 2)[0])), true, nil
+}
@@ -0,0 +1,134 @@
+package passwordpolicy
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/authorizerdev/authorizer/server/constants"
+	"github.com/authorizerdev/authorizer/server/db/models"
+	"github.com/authorizerdev/authorizer/server/env"
+	"github.com/authorizerdev/authorizer/server/envstore"
+	"github.com/authorizerdev/authorizer/server/utils"
+)
+
+// Validate validates the new password of user against the password policy:
+// minimum length, required character classes, email inside the password,
+// reuse of the last PASSWORD_HISTORY_SIZE passwords and breached passwords list
+func Validate(password string, user models.User) error {
+	if minLength := env.GetPasswordMinLength(); len([]rune(password)) < minLength {
+		return fmt.Errorf(`password must be at least %d characters long`, minLength)
+	}
+
+	for _, characterClass := range envstore.EnvInMemoryStoreObj.GetSliceStoreEnvVariable(constants.EnvKeyPasswordCharacterClasses) {
+		if !containsCharacterClass(password, characterClass) {
+			return fmt.Errorf(`password must contain at least one %s character`, characterClass)
+		}
+	}
+
+	if !envstore.EnvInMemoryStoreObj.GetBoolStoreEnvVariable(constants.EnvKeyDisablePasswordEmailCheck) && containsEmail(password, user.Email) {
+		return fmt.Errorf(`password must not contain the email`)
+	}
+
+	if historySize := env.GetPasswordHistorySize(); historySize > 0 {
+		for _, hash := range getHistory(user, historySize) {
+			if utils.VerifyPassword(hash, password) {
+				return fmt.Errorf(`password must not be one of the last %d passwords`, historySize)
+			}
+		}
+	}
+
+	breached, err := IsBreached(password)
+	if err != nil {
+		return err
+	}
+	if breached {
+		return fmt.Errorf(`password has appeared in a data breach, please choose a different password`)
+	}
+
+	return nil
+}
+
+// UpdateHistory moves the current password of user to the password history,
+// it should be called before setting the new password
+func UpdateHistory(user *models.User) {
+	historySize := env.GetPasswordHistorySize()
+	if historySize <= 1 || user.Password == nil || *user.Password == "" {
+		user.PasswordHistory = nil
+		return
+	}
+
+	// current password is part of the history size
+	history := append([]string{*user.Password}, getPreviousPasswords(*user)...)
+	if len(history) > historySize-1 {
+		history = history[:historySize-1]
+	}
+
+	passwordHistory := strings.Join(history, " ")
+	user.PasswordHistory = &passwordHistory
+}
+
+// getHistory returns the hashes of current and previous passwords of user limited to size
+func getHistory(user models.User, size int) []string {
+	history := []string{}
+	if user.Password != nil && *user.Password != "" {
+		history = append(history, *user.Password)
+	}
+	history = append(history, getPreviousPasswords(user)...)
+	if len(history) > size {
+		history = history[:size]
+	}
+
+	return history
+}
+
+// getPreviousPasswords returns the hashes of previous passwords of user
+func getPreviousPasswords(user models.User) []string {
+	if user.PasswordHistory == nil || *user.PasswordHistory == "" {
+		return []string{}
+	}
+
+	return strings.Fields(*user.PasswordHistory)
+}
+
+// containsCharacterClass checks if password has at least one character of the class
+func containsCharacterClass(password, characterClass string) bool {
+	for _, r := range password {
+		switch characterClass {
+		case constants.PasswordCharacterClassLowercase:
+			if unicode.IsLower(r) {
+				return true
+			}
+		case constants.PasswordCharacterClassUppercase:
+			if unicode.IsUpper(r) {
+				return true
+			}
+		case constants.PasswordCharacterClassNumber:
+			if unicode.IsDigit(r) {
+				return true
+			}
+		case constants.PasswordCharacterClassSpecial:
+			if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// containsEmail checks if password contains the email or its local part.
+// Local parts shorter than 3 characters are ignored
+func containsEmail(password, email string) bool {
+	email = strings.ToLower(email)
+	localPart := email
+	if i := strings.LastIndex(email, "@"); i >= 0 {
+		localPart = email[:i]
+	}
+
+	if len(localPart) < 3 {
+		return false
+	}
+
+	return strings.Contains(strings.ToLower(password), localPart)
+}
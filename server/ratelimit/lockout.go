@@ -0,0 +1,67 @@
+package ratelimit
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/authorizerdev/authorizer/server/env"
+	"github.com/authorizerdev/authorizer/server/sessionstore"
+)
+
+// CheckAccountLock returns account locked error if the user is locked after failed logins
+func CheckAccountLock(userID string) error {
+	lockedUntil, err := strconv.ParseInt(sessionstore.GetState(accountLockedKey(userID)), 10, 64)
+	if err != nil {
+		return nil
+	}
+
+	retryAfter := time.Until(time.Unix(lockedUntil, 0))
+	if retryAfter <= 0 {
+		return nil
+	}
+
+	return AccountLockedError(retryAfter)
+}
+
+// RecordFailedLogin counts the failed login of the user and locks the account
+// once ACCOUNT_LOCKOUT_THRESHOLD consecutive failures are reached.
+// It returns true if the account is locked by this failure
+func RecordFailedLogin(userID string) bool {
+	threshold := env.GetAccountLockoutThreshold()
+	if threshold <= 0 {
+		return false
+	}
+
+	duration := env.GetAccountLockoutDuration()
+	count, err := sessionstore.IncrementState(failedLoginsKey(userID), duration)
+	if err != nil {
+		// failures can not be counted, hence the login is treated as locked
+		return true
+	}
+	if count < threshold {
+		return false
+	}
+
+	sessionstore.SetState(accountLockedKey(userID), strconv.FormatInt(time.Now().Add(duration).Unix(), 10), duration)
+	sessionstore.RemoveState(failedLoginsKey(userID))
+	return true
+}
+
+// ResetFailedLogins resets the failed login count of the user after successful login
+func ResetFailedLogins(userID string) {
+	sessionstore.RemoveState(failedLoginsKey(userID))
+}
+
+// UnlockAccount removes the lock & failed login count of the user
+func UnlockAccount(userID string) {
+	sessionstore.RemoveState(accountLockedKey(userID))
+	sessionstore.RemoveState(failedLoginsKey(userID))
+}
+
+func failedLoginsKey(userID string) string {
+	return "failed_logins_" + userID
+}
+
+func accountLockedKey(userID string) string {
+	return "account_locked_" + userID
+}
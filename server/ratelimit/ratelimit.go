@@ -0,0 +1,66 @@
+package ratelimit
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/authorizerdev/authorizer/server/constants"
+	"github.com/authorizerdev/authorizer/server/env"
+	"github.com/authorizerdev/authorizer/server/sessionstore"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// Check counts the request of action for the given identifier (email, or phone number for phone actions)
+// and ip address, and returns too many requests error if any of the limits is exceeded.
+// Empty identifier or ip is not counted
+func Check(action, identifier, ip string) error {
+	scopes := []struct {
+		scope string
+		value string
+	}{
+		{constants.RateLimitScopeEmail, strings.ToLower(strings.TrimSpace(identifier))},
+		{constants.RateLimitScopeIP, strings.TrimSpace(ip)},
+	}
+
+	for _, s := range scopes {
+		if s.value == "" {
+			continue
+		}
+
+		rateLimit := env.GetRateLimit(action, s.scope)
+		if rateLimit.Limit <= 0 {
+			continue
+		}
+
+		count, err := sessionstore.IncrementState(fmt.Sprintf("rate_limit_%s_%s_%s", action, s.scope, s.value), rateLimit.Window)
+		if err != nil || count > rateLimit.Limit {
+			return TooManyRequestsError(rateLimit.Window)
+		}
+	}
+
+	return nil
+}
+
+// TooManyRequestsError returns the error with TOO_MANY_REQUESTS code,
+// so that clients can differentiate throttling from invalid credentials
+func TooManyRequestsError(retryAfter time.Duration) error {
+	return newError(`too many requests, please try again later`, constants.ErrorCodeTooManyRequests, retryAfter)
+}
+
+// AccountLockedError returns the error with ACCOUNT_LOCKED code
+func AccountLockedError(retryAfter time.Duration) error {
+	return newError(`account is locked due to too many failed login attempts, please try again later`, constants.ErrorCodeAccountLocked, retryAfter)
+}
+
+// newError returns graphql error with code & retry_after (in seconds) extensions
+func newError(message, code string, retryAfter time.Duration) error {
+	return &gqlerror.Error{
+		Message: message,
+		Extensions: map[string]interface{}{
+			"code":        code,
+			"retry_after": int64(math.Ceil(retryAfter.Seconds())),
+		},
+	}
+}
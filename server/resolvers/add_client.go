@@ -0,0 +1,167 @@
+package resolvers
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/authorizerdev/authorizer/server/audit"
+	"github.com/authorizerdev/authorizer/server/constants"
+	"github.com/authorizerdev/authorizer/server/db"
+	"github.com/authorizerdev/authorizer/server/db/models"
+	"github.com/authorizerdev/authorizer/server/envstore"
+	"github.com/authorizerdev/authorizer/server/graph/model"
+	"github.com/authorizerdev/authorizer/server/token"
+	"github.com/authorizerdev/authorizer/server/utils"
+	"github.com/google/uuid"
+)
+
+// AddClientResolver is a resolver for add client mutation
+// It registers new oauth client and returns its secret, which is shown only once
+// This is admin only mutation
+func AddClientResolver(ctx context.Context, params model.AddClientInput) (*model.ClientSecretResponse, error) {
+	gc, err := utils.GinContextFromContext(ctx)
+	var res *model.ClientSecretResponse
+	if err != nil {
+		return res, err
+	}
+
+	if !token.IsSuperAdmin(gc) {
+		return res, fmt.Errorf("unauthorized")
+	}
+
+	if strings.TrimSpace(params.Name) == "" {
+		return res, fmt.Errorf("name is required")
+	}
+
+	grantTypes := params.GrantTypes
+	if len(grantTypes) == 0 {
+		grantTypes = constants.DefaultClientGrantTypes
+	}
+
+	scopes := params.Scopes
+	if len(scopes) == 0 {
+		scopes = constants.DefaultClientScopes
+	}
+
+	client := models.Client{
+		ClientID: uuid.New().String(),
+		Name:     strings.TrimSpace(params.Name),
+	}
+
+	err = setClientInfo(&client, params.RedirectUris, grantTypes, scopes, params.Roles, params.AllowPlainPkce, params.Trusted, params.AccessTokenExpiresIn, params.RefreshTokenExpiresIn)
+	if err != nil {
+		return res, err
+	}
+
+	var clientSecret *string
+	if params.IsPublic == nil || !*params.IsPublic {
+		secret, hash, err := generateClientSecret()
+		if err != nil {
+			return res, err
+		}
+		client.ClientSecret = hash
+		clientSecret = &secret
+	}
+
+	client, err = db.Provider.AddClient(client)
+	if err != nil {
+		log.Println("error adding client:", err)
+		return res, err
+	}
+	audit.SetTarget(ctx, constants.AuditTargetTypeClient, client.ClientID)
+
+	res = &model.ClientSecretResponse{
+		Message:      `client added successfully`,
+		Client:       client.AsAPIClient(),
+		ClientSecret: clientSecret,
+	}
+
+	return res, nil
+}
+
+// setClientInfo validates & sets the redirect uris, grant types, scopes, roles, PKCE methods and token lifetimes of client.
+// nil or empty values are ignored, but client must end up with at least one redirect uri
+func setClientInfo(client *models.Client, redirectURIs, grantTypes, scopes, roles []string, allowPlainPKCE, trusted *bool, accessTokenExpiresIn, refreshTokenExpiresIn *int64) error {
+	if redirectURIs != nil {
+		if len(redirectURIs) == 0 {
+			return fmt.Errorf("at least one redirect uri is required")
+		}
+
+		for _, uri := range redirectURIs {
+			if !utils.IsValidClientRedirectURI(uri) || strings.Contains(uri, ",") {
+				return fmt.Errorf("invalid redirect uri %s", uri)
+			}
+		}
+		client.RedirectURIs = strings.Join(redirectURIs, ",")
+	}
+
+	if len(client.GetRedirectURIs()) == 0 {
+		return fmt.Errorf("at least one redirect uri is required")
+	}
+
+	if len(grantTypes) > 0 {
+		for _, grantType := range grantTypes {
+			if !utils.IsValidClientGrantType(grantType) {
+				return fmt.Errorf("invalid grant type %s", grantType)
+			}
+		}
+		client.GrantTypes = strings.Join(grantTypes, ",")
+	}
+
+	if len(scopes) > 0 {
+		for _, scope := range scopes {
+			if scope == "" || strings.ContainsAny(scope, ", ") {
+				return fmt.Errorf("invalid scope %s", scope)
+			}
+		}
+		client.Scopes = strings.Join(scopes, ",")
+	}
+
+	if roles != nil {
+		if !utils.IsValidRoles(envstore.EnvInMemoryStoreObj.GetSliceStoreEnvVariable(constants.EnvKeyRoles), roles) {
+			return fmt.Errorf("invalid list of roles")
+		}
+		client.Roles = strings.Join(roles, ",")
+	}
+
+	if allowPlainPKCE != nil {
+		client.AllowPlainPKCE = *allowPlainPKCE
+	}
+
+	if trusted != nil {
+		client.Trusted = *trusted
+	}
+
+	if accessTokenExpiresIn != nil {
+		if *accessTokenExpiresIn < 0 {
+			return fmt.Errorf("access token lifetime can not be negative")
+		}
+		client.AccessTokenExpiresIn = *accessTokenExpiresIn
+	}
+
+	if refreshTokenExpiresIn != nil {
+		if *refreshTokenExpiresIn < 0 {
+			return fmt.Errorf("refresh token lifetime can not be negative")
+		}
+		client.RefreshTokenExpiresIn = *refreshTokenExpiresIn
+	}
+
+	return nil
+}
+
+// generateClientSecret generates new client secret & its hash
+func generateClientSecret() (string, string, error) {
+	secret, err := utils.GenerateRandomString(32)
+	if err != nil {
+		return "", "", err
+	}
+
+	hash, err := utils.EncryptPassword(secret)
+	if err != nil {
+		return "", "", err
+	}
+
+	return secret, hash, nil
+}
@@ -0,0 +1,148 @@
+package resolvers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/authorizerdev/authorizer/server/audit"
+	"github.com/authorizerdev/authorizer/server/constants"
+	"github.com/authorizerdev/authorizer/server/db"
+	"github.com/authorizerdev/authorizer/server/db/models"
+	"github.com/authorizerdev/authorizer/server/graph/model"
+	"github.com/authorizerdev/authorizer/server/oauth"
+	"github.com/authorizerdev/authorizer/server/token"
+	"github.com/authorizerdev/authorizer/server/utils"
+)
+
+// AddOidcProviderResolver is a resolver for add oidc provider mutation
+// This is admin only mutation
+func AddOidcProviderResolver(ctx context.Context, params model.AddOIDCProviderInput) (*model.OIDCProvider, error) {
+	gc, err := utils.GinContextFromContext(ctx)
+	var res *model.OIDCProvider
+	if err != nil {
+		return res, err
+	}
+
+	if !token.IsSuperAdmin(gc) {
+		return res, fmt.Errorf("unauthorized")
+	}
+
+	name := strings.TrimSpace(params.Name)
+	if !utils.IsValidOIDCProviderName(name) {
+		return res, fmt.Errorf("invalid name, it should contain only lowercase letters, numbers, - and _ and should not be one of %s", strings.Join(constants.SignupMethods, ", "))
+	}
+
+	if _, err := db.Provider.GetOIDCProviderByName(name); err == nil {
+		return res, fmt.Errorf("oidc provider with name %s already exists", name)
+	}
+
+	if strings.TrimSpace(params.ClientID) == "" || strings.TrimSpace(params.ClientSecret) == "" {
+		return res, fmt.Errorf("client id and client secret are required")
+	}
+
+	issuerURL, err := validateOIDCProviderIssuerURL(params.IssuerURL)
+	if err != nil {
+		return res, err
+	}
+
+	scopes, err := validateOIDCProviderScopes(params.Scopes)
+	if err != nil {
+		return res, err
+	}
+
+	claimMapping, err := validateOIDCProviderClaimMapping(params.ClaimMapping)
+	if err != nil {
+		return res, err
+	}
+
+	clientSecret, err := utils.EncryptAES([]byte(strings.TrimSpace(params.ClientSecret)))
+	if err != nil {
+		return res, err
+	}
+
+	displayName := ""
+	if params.DisplayName != nil {
+		displayName = strings.TrimSpace(*params.DisplayName)
+	}
+
+	oidcProvider := models.OIDCProvider{
+		Name:         name,
+		DisplayName:  displayName,
+		IssuerURL:    issuerURL,
+		ClientID:     strings.TrimSpace(params.ClientID),
+		ClientSecret: string(clientSecret),
+		Scopes:       scopes,
+		ClaimMapping: claimMapping,
+		TrustEmail:   params.TrustEmail != nil && *params.TrustEmail,
+		Enabled:      params.Enabled == nil || *params.Enabled,
+	}
+
+	oidcProvider, err = db.Provider.AddOIDCProvider(oidcProvider)
+	if err != nil {
+		log.Println("error adding oidc provider:", err)
+		return res, err
+	}
+	utils.ResetMetaOIDCProviders()
+	audit.SetTarget(ctx, constants.AuditTargetTypeOIDCProvider, oidcProvider.ID)
+
+	return oidcProvider.AsAPIOIDCProvider(), nil
+}
+
+// validateOIDCProviderIssuerURL validates that the issuer url serves the OpenID Connect discovery document
+func validateOIDCProviderIssuerURL(issuerURL string) (string, error) {
+	issuerURL = strings.TrimSuffix(strings.TrimSpace(issuerURL), "/")
+	if !utils.IsValidWebhookEndpoint(issuerURL) {
+		return "", fmt.Errorf("invalid issuer url, it should be an absolute http or https url")
+	}
+
+	if _, err := oauth.DiscoverOIDCProvider(issuerURL); err != nil {
+		return "", fmt.Errorf("invalid issuer url: %s", err.Error())
+	}
+
+	return issuerURL, nil
+}
+
+// validateOIDCProviderScopes validates the scopes and returns them comma separated,
+// openid scope is required to get the id_token
+func validateOIDCProviderScopes(scopes []string) (string, error) {
+	res := []string{}
+	for _, scope := range scopes {
+		scope = strings.TrimSpace(scope)
+		if scope == "" || strings.ContainsAny(scope, ", ") {
+			return "", fmt.Errorf("invalid scope %s", scope)
+		}
+		res = append(res, scope)
+	}
+
+	if len(res) > 0 && !utils.StringSliceContains(res, "openid") {
+		return "", fmt.Errorf("openid scope is required")
+	}
+
+	return strings.Join(res, ","), nil
+}
+
+// validateOIDCProviderClaimMapping validates the claim mapping of user fields and returns it as json
+func validateOIDCProviderClaimMapping(claimMapping map[string]interface{}) (string, error) {
+	res := map[string]string{}
+	for field, claim := range claimMapping {
+		if !utils.StringSliceContains(constants.OIDCProviderClaimMappingFields, field) {
+			return "", fmt.Errorf("invalid claim mapping field %s, it should be one of %s", field, strings.Join(constants.OIDCProviderClaimMappingFields, ", "))
+		}
+
+		claimName, ok := claim.(string)
+		if !ok || strings.TrimSpace(claimName) == "" {
+			return "", fmt.Errorf("invalid claim name for %s, it should be a non empty string", field)
+		}
+		res[field] = strings.TrimSpace(claimName)
+	}
+
+	claimMappingJSON, err := json.Marshal(res)
+	if err != nil {
+		return "", err
+	}
+
+	return string(claimMappingJSON), nil
+}
@@ -0,0 +1,70 @@
+package resolvers
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/authorizerdev/authorizer/server/audit"
+	"github.com/authorizerdev/authorizer/server/constants"
+	"github.com/authorizerdev/authorizer/server/db"
+	"github.com/authorizerdev/authorizer/server/db/models"
+	"github.com/authorizerdev/authorizer/server/graph/model"
+	"github.com/authorizerdev/authorizer/server/token"
+	"github.com/authorizerdev/authorizer/server/utils"
+	"github.com/authorizerdev/authorizer/server/webhook"
+)
+
+// AddWebhookResolver is a resolver for add webhook mutation
+// This is admin only mutation, and the only one returning the signing secret
+func AddWebhookResolver(ctx context.Context, params model.AddWebhookInput) (*model.Webhook, error) {
+	gc, err := utils.GinContextFromContext(ctx)
+	var res *model.Webhook
+	if err != nil {
+		return res, err
+	}
+
+	if !token.IsSuperAdmin(gc) {
+		return res, fmt.Errorf("unauthorized")
+	}
+
+	if !webhook.IsValidEvent(params.EventName) {
+		return res, fmt.Errorf("invalid event name %s, it should be one of %s", params.EventName, strings.Join(constants.WebhookEvents, ", "))
+	}
+
+	endpoint := strings.TrimSpace(params.Endpoint)
+	if !utils.IsValidWebhookEndpoint(endpoint) {
+		return res, fmt.Errorf("invalid endpoint, it should be an absolute http or https url")
+	}
+
+	secret, err := utils.GenerateRandomString(32)
+	if err != nil {
+		return res, err
+	}
+
+	encryptedSecret, err := utils.EncryptAES([]byte(secret))
+	if err != nil {
+		return res, err
+	}
+
+	newWebhook := models.Webhook{
+		EventName: params.EventName,
+		Endpoint:  endpoint,
+		Secret:    string(encryptedSecret),
+		Enabled:   params.Enabled == nil || *params.Enabled,
+	}
+
+	newWebhook, err = db.Provider.AddWebhook(newWebhook)
+	if err != nil {
+		log.Println("error adding webhook:", err)
+		return res, err
+	}
+	audit.SetTarget(ctx, constants.AuditTargetTypeWebhook, newWebhook.ID)
+
+	// secret is only returned here, so that endpoint can verify the signature of deliveries
+	res = newWebhook.AsAPIWebhook()
+	res.Secret = &secret
+
+	return res, nil
+}
@@ -4,10 +4,12 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/authorizerdev/authorizer/server/audit"
 	"github.com/authorizerdev/authorizer/server/constants"
 	"github.com/authorizerdev/authorizer/server/cookie"
 	"github.com/authorizerdev/authorizer/server/envstore"
 	"github.com/authorizerdev/authorizer/server/graph/model"
+	"github.com/authorizerdev/authorizer/server/ratelimit"
 	"github.com/authorizerdev/authorizer/server/utils"
 )
 
@@ -20,10 +22,15 @@ func AdminLoginResolver(ctx context.Context, params model.AdminLoginInput) (*mod
 		return res, err
 	}
 
+	if err := ratelimit.Check(constants.RateLimitActionAdminLogin, "", utils.GetIP(gc.Request)); err != nil {
+		return res, err
+	}
+
 	adminSecret := envstore.EnvInMemoryStoreObj.GetStringStoreEnvVariable(constants.EnvKeyAdminSecret)
 	if params.AdminSecret != adminSecret {
 		return res, fmt.Errorf(`invalid admin secret`)
 	}
+	audit.SetActor(ctx, constants.AuditActorTypeAdmin, "", "")
 
 	hashedKey, err := utils.EncryptPassword(adminSecret)
 	if err != nil {
@@ -0,0 +1,65 @@
+package resolvers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/authorizerdev/authorizer/server/constants"
+	"github.com/authorizerdev/authorizer/server/db"
+	"github.com/authorizerdev/authorizer/server/db/models"
+	"github.com/authorizerdev/authorizer/server/graph/model"
+	"github.com/authorizerdev/authorizer/server/token"
+	"github.com/authorizerdev/authorizer/server/utils"
+)
+
+// AuditLogsResolver is a resolver for audit logs query
+// This is admin only query
+func AuditLogsResolver(ctx context.Context, params *model.AuditLogsInput) (*model.AuditLogs, error) {
+	gc, err := utils.GinContextFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if !token.IsSuperAdmin(gc) {
+		return nil, fmt.Errorf("unauthorized")
+	}
+
+	filter := models.AuditEventFilter{}
+	paginatedInput := &model.PaginatedInput{}
+	if params != nil {
+		paginatedInput.Pagination = params.Pagination
+		if params.Action != nil {
+			filter.Action = *params.Action
+		}
+		if params.ActorID != nil {
+			filter.ActorID = *params.ActorID
+		}
+		if params.TargetID != nil {
+			filter.TargetID = *params.TargetID
+		}
+		if params.Result != nil {
+			if *params.Result != constants.AuditResultSuccess && *params.Result != constants.AuditResultFailure {
+				return nil, fmt.Errorf("invalid result %s, it should be success or failure", *params.Result)
+			}
+			filter.Result = *params.Result
+		}
+		if params.IP != nil {
+			filter.IP = *params.IP
+		}
+		if params.From != nil {
+			filter.From = *params.From
+		}
+		if params.To != nil {
+			filter.To = *params.To
+		}
+	}
+
+	pagination := utils.GetPagination(paginatedInput)
+
+	res, err := db.Provider.ListAuditEvents(pagination, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
@@ -0,0 +1,57 @@
+package resolvers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/authorizerdev/authorizer/server/db"
+	"github.com/authorizerdev/authorizer/server/graph/model"
+	"github.com/authorizerdev/authorizer/server/token"
+	"github.com/authorizerdev/authorizer/server/utils"
+)
+
+// ConfirmTotpResolver is a resolver for confirm totp mutation
+// It enables TOTP for login once user has entered valid code from authenticator app
+func ConfirmTotpResolver(ctx context.Context, params model.OTPInput) (*model.Response, error) {
+	gc, err := utils.GinContextFromContext(ctx)
+	var res *model.Response
+	if err != nil {
+		return res, err
+	}
+
+	claims, err := token.ValidateAccessToken(gc)
+	if err != nil {
+		return res, err
+	}
+
+	user, err := db.Provider.GetUserByID(fmt.Sprintf("%v", claims["id"]))
+	if err != nil {
+		return res, err
+	}
+
+	if user.TotpSecret == nil {
+		return res, fmt.Errorf("totp is not enrolled")
+	}
+
+	if user.IsTotpEnabled() {
+		return res, fmt.Errorf("totp is already enabled")
+	}
+
+	if !validateTotpCode(user, params.Otp) {
+		return res, fmt.Errorf("invalid otp")
+	}
+
+	now := time.Now().Unix()
+	user.TotpVerifiedAt = &now
+	_, err = db.Provider.UpdateUser(user)
+	if err != nil {
+		return res, err
+	}
+
+	res = &model.Response{
+		Message: `TOTP enabled successfully`,
+	}
+
+	return res, nil
+}
@@ -0,0 +1,46 @@
+package resolvers
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/authorizerdev/authorizer/server/audit"
+	"github.com/authorizerdev/authorizer/server/constants"
+	"github.com/authorizerdev/authorizer/server/db"
+	"github.com/authorizerdev/authorizer/server/graph/model"
+	"github.com/authorizerdev/authorizer/server/token"
+	"github.com/authorizerdev/authorizer/server/utils"
+)
+
+// DeleteClientResolver is a resolver for delete client mutation
+// This is admin only mutation
+func DeleteClientResolver(ctx context.Context, params model.ClientInput) (*model.Response, error) {
+	gc, err := utils.GinContextFromContext(ctx)
+	var res *model.Response
+	if err != nil {
+		return res, err
+	}
+
+	if !token.IsSuperAdmin(gc) {
+		return res, fmt.Errorf("unauthorized")
+	}
+	audit.SetTarget(ctx, constants.AuditTargetTypeClient, params.ClientID)
+
+	client, err := db.Provider.GetClientByClientID(params.ClientID)
+	if err != nil {
+		return res, fmt.Errorf("client not found")
+	}
+
+	err = db.Provider.DeleteClient(client)
+	if err != nil {
+		log.Println("error deleting client:", err)
+		return res, err
+	}
+
+	res = &model.Response{
+		Message: `client deleted successfully`,
+	}
+
+	return res, nil
+}
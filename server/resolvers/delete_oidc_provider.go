@@ -0,0 +1,48 @@
+package resolvers
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/authorizerdev/authorizer/server/audit"
+	"github.com/authorizerdev/authorizer/server/constants"
+	"github.com/authorizerdev/authorizer/server/db"
+	"github.com/authorizerdev/authorizer/server/graph/model"
+	"github.com/authorizerdev/authorizer/server/token"
+	"github.com/authorizerdev/authorizer/server/utils"
+)
+
+// DeleteOidcProviderResolver is a resolver for delete oidc provider mutation
+// Users who signed up with the provider are kept, they can login with other signup methods.
+// This is admin only mutation
+func DeleteOidcProviderResolver(ctx context.Context, params model.OIDCProviderInput) (*model.Response, error) {
+	gc, err := utils.GinContextFromContext(ctx)
+	var res *model.Response
+	if err != nil {
+		return res, err
+	}
+
+	if !token.IsSuperAdmin(gc) {
+		return res, fmt.Errorf("unauthorized")
+	}
+	audit.SetTarget(ctx, constants.AuditTargetTypeOIDCProvider, params.ID)
+
+	oidcProvider, err := db.Provider.GetOIDCProviderByID(params.ID)
+	if err != nil {
+		return res, fmt.Errorf("oidc provider not found")
+	}
+
+	err = db.Provider.DeleteOIDCProvider(oidcProvider)
+	if err != nil {
+		log.Println("error deleting oidc provider:", err)
+		return res, err
+	}
+	utils.ResetMetaOIDCProviders()
+
+	res = &model.Response{
+		Message: "oidc provider deleted successfully",
+	}
+
+	return res, nil
+}
@@ -5,11 +5,14 @@ import (
 	"fmt"
 	"log"
 
+	"github.com/authorizerdev/authorizer/server/audit"
+	"github.com/authorizerdev/authorizer/server/constants"
 	"github.com/authorizerdev/authorizer/server/db"
 	"github.com/authorizerdev/authorizer/server/graph/model"
 	"github.com/authorizerdev/authorizer/server/sessionstore"
 	"github.com/authorizerdev/authorizer/server/token"
 	"github.com/authorizerdev/authorizer/server/utils"
+	"github.com/authorizerdev/authorizer/server/webhook"
 )
 
 // DeleteUserResolver is a resolver for delete user mutation
@@ -28,14 +31,28 @@ func DeleteUserResolver(ctx context.Context, params model.DeleteUserInput) (*mod
 	if err != nil {
 		return res, err
 	}
+	audit.SetTarget(ctx, constants.AuditTargetTypeUser, user.ID)
 
 	sessionstore.DeleteAllUserSession(fmt.Sprintf("%x", user.ID))
 
+	credentials, err := db.Provider.ListWebauthnCredentialsByUserID(user.ID)
+	if err != nil {
+		return res, err
+	}
+	for _, credential := range credentials {
+		err = db.Provider.DeleteWebauthnCredential(credential)
+		if err != nil {
+			log.Println("error deleting webauthn credential:", err)
+			return res, err
+		}
+	}
+
 	err = db.Provider.DeleteUser(user)
 	if err != nil {
 		log.Println("error deleting user:", err)
 		return res, err
 	}
+	webhook.Trigger(constants.WebhookEventUserDeleted, user)
 
 	res = &model.Response{
 		Message: `user deleted successfully`,
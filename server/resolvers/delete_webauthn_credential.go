@@ -0,0 +1,46 @@
+package resolvers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/authorizerdev/authorizer/server/db"
+	"github.com/authorizerdev/authorizer/server/graph/model"
+	"github.com/authorizerdev/authorizer/server/token"
+	"github.com/authorizerdev/authorizer/server/utils"
+)
+
+// DeleteWebauthnCredentialResolver is a resolver for delete webauthn credential mutation
+func DeleteWebauthnCredentialResolver(ctx context.Context, params model.DeleteWebauthnCredentialInput) (*model.Response, error) {
+	gc, err := utils.GinContextFromContext(ctx)
+	var res *model.Response
+	if err != nil {
+		return res, err
+	}
+
+	claims, err := token.ValidateAccessToken(gc)
+	if err != nil {
+		return res, err
+	}
+
+	credentials, err := db.Provider.ListWebauthnCredentialsByUserID(fmt.Sprintf("%v", claims["id"]))
+	if err != nil {
+		return res, err
+	}
+
+	for _, credential := range credentials {
+		if credential.ID == params.ID {
+			err = db.Provider.DeleteWebauthnCredential(credential)
+			if err != nil {
+				return res, err
+			}
+
+			res = &model.Response{
+				Message: `Passkey deleted successfully`,
+			}
+			return res, nil
+		}
+	}
+
+	return res, fmt.Errorf(`passkey not found`)
+}
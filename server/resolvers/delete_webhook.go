@@ -0,0 +1,47 @@
+package resolvers
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/authorizerdev/authorizer/server/audit"
+	"github.com/authorizerdev/authorizer/server/constants"
+	"github.com/authorizerdev/authorizer/server/db"
+	"github.com/authorizerdev/authorizer/server/graph/model"
+	"github.com/authorizerdev/authorizer/server/token"
+	"github.com/authorizerdev/authorizer/server/utils"
+)
+
+// DeleteWebhookResolver is a resolver for delete webhook mutation
+// Deliveries of the webhook are kept in the delivery log, pending ones are not retried.
+// This is admin only mutation
+func DeleteWebhookResolver(ctx context.Context, params model.WebhookInput) (*model.Response, error) {
+	gc, err := utils.GinContextFromContext(ctx)
+	var res *model.Response
+	if err != nil {
+		return res, err
+	}
+
+	if !token.IsSuperAdmin(gc) {
+		return res, fmt.Errorf("unauthorized")
+	}
+	audit.SetTarget(ctx, constants.AuditTargetTypeWebhook, params.ID)
+
+	webhook, err := db.Provider.GetWebhookByID(params.ID)
+	if err != nil {
+		return res, fmt.Errorf("webhook not found")
+	}
+
+	err = db.Provider.DeleteWebhook(webhook)
+	if err != nil {
+		log.Println("error deleting webhook:", err)
+		return res, err
+	}
+
+	res = &model.Response{
+		Message: "webhook deleted successfully",
+	}
+
+	return res, nil
+}
@@ -0,0 +1,53 @@
+package resolvers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/authorizerdev/authorizer/server/db"
+	"github.com/authorizerdev/authorizer/server/graph/model"
+	"github.com/authorizerdev/authorizer/server/token"
+	"github.com/authorizerdev/authorizer/server/utils"
+)
+
+// DisableTotpResolver is a resolver for disable totp mutation
+// It requires valid TOTP code or backup code
+func DisableTotpResolver(ctx context.Context, params model.OTPInput) (*model.Response, error) {
+	gc, err := utils.GinContextFromContext(ctx)
+	var res *model.Response
+	if err != nil {
+		return res, err
+	}
+
+	claims, err := token.ValidateAccessToken(gc)
+	if err != nil {
+		return res, err
+	}
+
+	user, err := db.Provider.GetUserByID(fmt.Sprintf("%v", claims["id"]))
+	if err != nil {
+		return res, err
+	}
+
+	if !user.IsTotpEnabled() {
+		return res, fmt.Errorf("totp is not enabled")
+	}
+
+	if !validateUserOTP(&user, params.Otp) {
+		return res, fmt.Errorf("invalid otp")
+	}
+
+	user.TotpSecret = nil
+	user.TotpVerifiedAt = nil
+	user.TotpBackupCodes = nil
+	_, err = db.Provider.UpdateUser(user)
+	if err != nil {
+		return res, err
+	}
+
+	res = &model.Response{
+		Message: `TOTP disabled successfully`,
+	}
+
+	return res, nil
+}
@@ -0,0 +1,82 @@
+package resolvers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/authorizerdev/authorizer/server/constants"
+	"github.com/authorizerdev/authorizer/server/crypto"
+	"github.com/authorizerdev/authorizer/server/db"
+	"github.com/authorizerdev/authorizer/server/envstore"
+	"github.com/authorizerdev/authorizer/server/graph/model"
+	"github.com/authorizerdev/authorizer/server/token"
+	"github.com/authorizerdev/authorizer/server/utils"
+)
+
+// totpBackupCodesCount is the number of backup codes generated on enrollment
+const totpBackupCodesCount = 10
+
+// EnrollTotpResolver is a resolver for enroll totp mutation
+// It generates new TOTP secret & backup codes, which are required for login
+// once the enrollment is confirmed using confirm_totp mutation
+func EnrollTotpResolver(ctx context.Context) (*model.TotpEnrollResponse, error) {
+	gc, err := utils.GinContextFromContext(ctx)
+	var res *model.TotpEnrollResponse
+	if err != nil {
+		return res, err
+	}
+
+	claims, err := token.ValidateAccessToken(gc)
+	if err != nil {
+		return res, err
+	}
+
+	user, err := db.Provider.GetUserByID(fmt.Sprintf("%v", claims["id"]))
+	if err != nil {
+		return res, err
+	}
+
+	if user.IsTotpEnabled() {
+		return res, fmt.Errorf("totp is already enabled, disable it to enroll again")
+	}
+
+	secret, err := crypto.NewTOTPSecret()
+	if err != nil {
+		return res, err
+	}
+
+	encryptedSecret, err := utils.EncryptAES([]byte(secret))
+	if err != nil {
+		return res, err
+	}
+
+	backupCodes, err := crypto.NewBackupCodes(totpBackupCodesCount)
+	if err != nil {
+		return res, err
+	}
+
+	backupCodeHashes := []string{}
+	for _, code := range backupCodes {
+		backupCodeHashes = append(backupCodeHashes, crypto.HashBackupCode(code))
+	}
+
+	totpSecret := utils.EncryptB64(string(encryptedSecret))
+	totpBackupCodes := strings.Join(backupCodeHashes, ",")
+	user.TotpSecret = &totpSecret
+	user.TotpVerifiedAt = nil
+	user.TotpBackupCodes = &totpBackupCodes
+	_, err = db.Provider.UpdateUser(user)
+	if err != nil {
+		return res, err
+	}
+
+	res = &model.TotpEnrollResponse{
+		Message:         `Scan the provisioning uri with authenticator app and confirm the code`,
+		Secret:          secret,
+		ProvisioningURI: crypto.GetTOTPProvisioningURI(secret, envstore.EnvInMemoryStoreObj.GetStringStoreEnvVariable(constants.EnvKeyOrganizationName), user.Email),
+		BackupCodes:     backupCodes,
+	}
+
+	return res, nil
+}
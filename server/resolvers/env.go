@@ -28,6 +28,7 @@ func EnvResolver(ctx context.Context) (*model.Env, error) {
 	// get clone of store
 	store := envstore.EnvInMemoryStoreObj.GetEnvStoreClone()
 	adminSecret := store.StringEnv[constants.EnvKeyAdminSecret]
+	clientID := store.StringEnv[constants.EnvKeyClientID]
 	databaseURL := store.StringEnv[constants.EnvKeyDatabaseURL]
 	databaseName := store.StringEnv[constants.EnvKeyDatabaseName]
 	databaseType := store.StringEnv[constants.EnvKeyDatabaseType]
@@ -37,10 +38,30 @@ func EnvResolver(ctx context.Context) (*model.Env, error) {
 	smtpUsername := store.StringEnv[constants.EnvKeySmtpUsername]
 	smtpPassword := store.StringEnv[constants.EnvKeySmtpPassword]
 	senderEmail := store.StringEnv[constants.EnvKeySenderEmail]
+	smsWebhookURL := store.StringEnv[constants.EnvKeySmsWebhookURL]
+	smsWebhookAuthorization := store.StringEnv[constants.EnvKeySmsWebhookAuthorization]
 	jwtType := store.StringEnv[constants.EnvKeyJwtType]
 	jwtSecret := store.StringEnv[constants.EnvKeyJwtSecret]
+	jwtPrivateKey := store.StringEnv[constants.EnvKeyJwtPrivateKey]
+	jwtPublicKey := store.StringEnv[constants.EnvKeyJwtPublicKey]
 	jwtRoleClaim := store.StringEnv[constants.EnvKeyJwtRoleClaim]
+	accessTokenExpiryTime := store.StringEnv[constants.EnvKeyAccessTokenExpiryTime]
+	refreshTokenExpiryTime := store.StringEnv[constants.EnvKeyRefreshTokenExpiryTime]
+	verificationTokenExpiryTime := store.StringEnv[constants.EnvKeyVerificationTokenExpiryTime]
+	sessionInactivityTimeout := store.StringEnv[constants.EnvKeySessionInactivityTimeout]
+	roleAccessTokenExpiryTimes := store.SliceEnv[constants.EnvKeyRoleAccessTokenExpiryTimes]
+	roleRefreshTokenExpiryTimes := store.SliceEnv[constants.EnvKeyRoleRefreshTokenExpiryTimes]
+	rateLimits := store.SliceEnv[constants.EnvKeyRateLimits]
+	accountLockoutThreshold := store.StringEnv[constants.EnvKeyAccountLockoutThreshold]
+	accountLockoutDuration := store.StringEnv[constants.EnvKeyAccountLockoutDuration]
+	passwordMinLength := store.StringEnv[constants.EnvKeyPasswordMinLength]
+	passwordCharacterClasses := store.SliceEnv[constants.EnvKeyPasswordCharacterClasses]
+	passwordHistorySize := store.StringEnv[constants.EnvKeyPasswordHistorySize]
+	breachedPasswordsFile := store.StringEnv[constants.EnvKeyBreachedPasswordsFile]
+	passwordHashAlgorithm := store.StringEnv[constants.EnvKeyPasswordHashAlgorithm]
+	auditLogRetentionDays := store.StringEnv[constants.EnvKeyAuditLogRetentionDays]
 	allowedOrigins := store.SliceEnv[constants.EnvKeyAllowedOrigins]
+	clientRedirectURIs := store.SliceEnv[constants.EnvKeyClientRedirectURIs]
 	authorizerURL := store.StringEnv[constants.EnvKeyAuthorizerURL]
 	appURL := store.StringEnv[constants.EnvKeyAppURL]
 	redisURL := store.StringEnv[constants.EnvKeyRedisURL]
@@ -50,6 +71,10 @@ func EnvResolver(ctx context.Context) (*model.Env, error) {
 	disableBasicAuthentication := store.BoolEnv[constants.EnvKeyDisableBasicAuthentication]
 	disableMagicLinkLogin := store.BoolEnv[constants.EnvKeyDisableMagicLinkLogin]
 	disableLoginPage := store.BoolEnv[constants.EnvKeyDisableLoginPage]
+	disableSecurityAlertEmail := store.BoolEnv[constants.EnvKeyDisableSecurityAlertEmail]
+	disableWebauthn := store.BoolEnv[constants.EnvKeyDisableWebauthn]
+	disablePasswordEmailCheck := store.BoolEnv[constants.EnvKeyDisablePasswordEmailCheck]
+	disablePhoneLogin := store.BoolEnv[constants.EnvKeyDisablePhoneLogin]
 	roles := store.SliceEnv[constants.EnvKeyRoles]
 	defaultRoles := store.SliceEnv[constants.EnvKeyDefaultRoles]
 	protectedRoles := store.SliceEnv[constants.EnvKeyProtectedRoles]
@@ -59,44 +84,99 @@ func EnvResolver(ctx context.Context) (*model.Env, error) {
 	facebookClientSecret := store.StringEnv[constants.EnvKeyFacebookClientSecret]
 	githubClientID := store.StringEnv[constants.EnvKeyGithubClientID]
 	githubClientSecret := store.StringEnv[constants.EnvKeyGithubClientSecret]
+	appleClientID := store.StringEnv[constants.EnvKeyAppleClientID]
+	appleTeamID := store.StringEnv[constants.EnvKeyAppleTeamID]
+	appleKeyID := store.StringEnv[constants.EnvKeyAppleKeyID]
+	applePrivateKey := store.StringEnv[constants.EnvKeyApplePrivateKey]
+	microsoftClientID := store.StringEnv[constants.EnvKeyMicrosoftClientID]
+	microsoftClientSecret := store.StringEnv[constants.EnvKeyMicrosoftClientSecret]
+	microsoftTenantID := store.StringEnv[constants.EnvKeyMicrosoftTenantID]
+	gitlabClientID := store.StringEnv[constants.EnvKeyGitlabClientID]
+	gitlabClientSecret := store.StringEnv[constants.EnvKeyGitlabClientSecret]
+	linkedinClientID := store.StringEnv[constants.EnvKeyLinkedinClientID]
+	linkedinClientSecret := store.StringEnv[constants.EnvKeyLinkedinClientSecret]
+	discordClientID := store.StringEnv[constants.EnvKeyDiscordClientID]
+	discordClientSecret := store.StringEnv[constants.EnvKeyDiscordClientSecret]
+	twitterClientID := store.StringEnv[constants.EnvKeyTwitterClientID]
+	twitterClientSecret := store.StringEnv[constants.EnvKeyTwitterClientSecret]
 	organizationName := store.StringEnv[constants.EnvKeyOrganizationName]
 	organizationLogo := store.StringEnv[constants.EnvKeyOrganizationLogo]
 
 	res = &model.Env{
-		AdminSecret:                &adminSecret,
-		DatabaseName:               &databaseName,
-		DatabaseURL:                &databaseURL,
-		DatabaseType:               &databaseType,
-		CustomAccessTokenScript:    &customAccessTokenScript,
-		SMTPHost:                   &smtpHost,
-		SMTPPort:                   &smtpPort,
-		SMTPPassword:               &smtpPassword,
-		SMTPUsername:               &smtpUsername,
-		SenderEmail:                &senderEmail,
-		JwtType:                    &jwtType,
-		JwtSecret:                  &jwtSecret,
-		JwtRoleClaim:               &jwtRoleClaim,
-		AllowedOrigins:             allowedOrigins,
-		AuthorizerURL:              &authorizerURL,
-		AppURL:                     &appURL,
-		RedisURL:                   &redisURL,
-		CookieName:                 &cookieName,
-		ResetPasswordURL:           &resetPasswordURL,
-		DisableEmailVerification:   &disableEmailVerification,
-		DisableBasicAuthentication: &disableBasicAuthentication,
-		DisableMagicLinkLogin:      &disableMagicLinkLogin,
-		DisableLoginPage:           &disableLoginPage,
-		Roles:                      roles,
-		ProtectedRoles:             protectedRoles,
-		DefaultRoles:               defaultRoles,
-		GoogleClientID:             &googleClientID,
-		GoogleClientSecret:         &googleClientSecret,
-		GithubClientID:             &githubClientID,
-		GithubClientSecret:         &githubClientSecret,
-		FacebookClientID:           &facebookClientID,
-		FacebookClientSecret:       &facebookClientSecret,
-		OrganizationName:           &organizationName,
-		OrganizationLogo:           &organizationLogo,
+		AdminSecret:                 &adminSecret,
+		ClientID:                    &clientID,
+		DatabaseName:                &databaseName,
+		DatabaseURL:                 &databaseURL,
+		DatabaseType:                &databaseType,
+		CustomAccessTokenScript:     &customAccessTokenScript,
+		SMTPHost:                    &smtpHost,
+		SMTPPort:                    &smtpPort,
+		SMTPPassword:                &smtpPassword,
+		SMTPUsername:                &smtpUsername,
+		SenderEmail:                 &senderEmail,
+		SmsWebhookURL:               &smsWebhookURL,
+		SmsWebhookAuthorization:     &smsWebhookAuthorization,
+		JwtType:                     &jwtType,
+		JwtSecret:                   &jwtSecret,
+		JwtPrivateKey:               &jwtPrivateKey,
+		JwtPublicKey:                &jwtPublicKey,
+		JwtRoleClaim:                &jwtRoleClaim,
+		AccessTokenExpiryTime:       &accessTokenExpiryTime,
+		RefreshTokenExpiryTime:      &refreshTokenExpiryTime,
+		VerificationTokenExpiryTime: &verificationTokenExpiryTime,
+		SessionInactivityTimeout:    &sessionInactivityTimeout,
+		RoleAccessTokenExpiryTimes:  roleAccessTokenExpiryTimes,
+		RoleRefreshTokenExpiryTimes: roleRefreshTokenExpiryTimes,
+		RateLimits:                  rateLimits,
+		AccountLockoutThreshold:     &accountLockoutThreshold,
+		AccountLockoutDuration:      &accountLockoutDuration,
+		PasswordMinLength:           &passwordMinLength,
+		PasswordCharacterClasses:    passwordCharacterClasses,
+		PasswordHistorySize:         &passwordHistorySize,
+		BreachedPasswordsFile:       &breachedPasswordsFile,
+		PasswordHashAlgorithm:       &passwordHashAlgorithm,
+		AuditLogRetentionDays:       &auditLogRetentionDays,
+		AllowedOrigins:              allowedOrigins,
+		ClientRedirectURIS:          clientRedirectURIs,
+		AuthorizerURL:               &authorizerURL,
+		AppURL:                      &appURL,
+		RedisURL:                    &redisURL,
+		CookieName:                  &cookieName,
+		ResetPasswordURL:            &resetPasswordURL,
+		DisableEmailVerification:    &disableEmailVerification,
+		DisableBasicAuthentication:  &disableBasicAuthentication,
+		DisableMagicLinkLogin:       &disableMagicLinkLogin,
+		DisableLoginPage:            &disableLoginPage,
+		DisableSecurityAlertEmail:   &disableSecurityAlertEmail,
+		DisableWebauthn:             &disableWebauthn,
+		DisablePasswordEmailCheck:   &disablePasswordEmailCheck,
+		DisablePhoneLogin:           &disablePhoneLogin,
+		Roles:                       roles,
+		ProtectedRoles:              protectedRoles,
+		DefaultRoles:                defaultRoles,
+		GoogleClientID:              &googleClientID,
+		GoogleClientSecret:          &googleClientSecret,
+		GithubClientID:              &githubClientID,
+		GithubClientSecret:          &githubClientSecret,
+		FacebookClientID:            &facebookClientID,
+		FacebookClientSecret:        &facebookClientSecret,
+		AppleClientID:               &appleClientID,
+		AppleTeamID:                 &appleTeamID,
+		AppleKeyID:                  &appleKeyID,
+		ApplePrivateKey:             &applePrivateKey,
+		MicrosoftClientID:           &microsoftClientID,
+		MicrosoftClientSecret:       &microsoftClientSecret,
+		MicrosoftTenantID:           &microsoftTenantID,
+		GitlabClientID:              &gitlabClientID,
+		GitlabClientSecret:          &gitlabClientSecret,
+		LinkedinClientID:            &linkedinClientID,
+		LinkedinClientSecret:        &linkedinClientSecret,
+		DiscordClientID:             &discordClientID,
+		DiscordClientSecret:         &discordClientSecret,
+		TwitterClientID:             &twitterClientID,
+		TwitterClientSecret:         &twitterClientSecret,
+		OrganizationName:            &organizationName,
+		OrganizationLogo:            &organizationLogo,
 	}
 	return res, nil
 }
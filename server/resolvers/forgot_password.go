@@ -7,12 +7,15 @@ import (
 	"strings"
 	"time"
 
+	"github.com/authorizerdev/authorizer/server/audit"
 	"github.com/authorizerdev/authorizer/server/constants"
 	"github.com/authorizerdev/authorizer/server/db"
 	"github.com/authorizerdev/authorizer/server/db/models"
 	"github.com/authorizerdev/authorizer/server/email"
+	"github.com/authorizerdev/authorizer/server/env"
 	"github.com/authorizerdev/authorizer/server/envstore"
 	"github.com/authorizerdev/authorizer/server/graph/model"
+	"github.com/authorizerdev/authorizer/server/ratelimit"
 	"github.com/authorizerdev/authorizer/server/token"
 	"github.com/authorizerdev/authorizer/server/utils"
 )
@@ -29,11 +32,16 @@ func ForgotPasswordResolver(ctx context.Context, params model.ForgotPasswordInpu
 	}
 	host := gc.Request.Host
 	params.Email = strings.ToLower(params.Email)
+	audit.SetActor(ctx, constants.AuditActorTypeUser, "", params.Email)
 
 	if !utils.IsValidEmail(params.Email) {
 		return res, fmt.Errorf("invalid email")
 	}
 
+	if err := ratelimit.Check(constants.RateLimitActionForgotPassword, params.Email, utils.GetIP(gc.Request)); err != nil {
+		return res, err
+	}
+
 	_, err = db.Provider.GetUserByEmail(params.Email)
 	if err != nil {
 		return res, fmt.Errorf(`user with this email not found`)
@@ -46,7 +54,7 @@ func ForgotPasswordResolver(ctx context.Context, params model.ForgotPasswordInpu
 	db.Provider.AddVerificationRequest(models.VerificationRequest{
 		Token:      verificationToken,
 		Identifier: constants.VerificationTypeForgotPassword,
-		ExpiresAt:  time.Now().Add(time.Minute * 30).Unix(),
+		ExpiresAt:  time.Now().Add(env.GetVerificationTokenExpiryTime()).Unix(),
 		Email:      params.Email,
 	})
 
@@ -0,0 +1,49 @@
+package resolvers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/authorizerdev/authorizer/server/audit"
+	"github.com/authorizerdev/authorizer/server/constants"
+	"github.com/authorizerdev/authorizer/server/env"
+	"github.com/authorizerdev/authorizer/server/envstore"
+	"github.com/authorizerdev/authorizer/server/graph/model"
+	"github.com/authorizerdev/authorizer/server/token"
+	"github.com/authorizerdev/authorizer/server/utils"
+)
+
+// GenerateJwtKeyResolver is a resolver for generate jwt key mutation
+// It adds a new pending key to the key ring, which can be promoted later
+// This is admin only mutation
+func GenerateJwtKeyResolver(ctx context.Context, params *model.GenerateJWTKeyInput) (*model.JWTKey, error) {
+	gc, err := utils.GinContextFromContext(ctx)
+	var res *model.JWTKey
+	if err != nil {
+		return res, err
+	}
+
+	if !token.IsSuperAdmin(gc) {
+		return res, fmt.Errorf("unauthorized")
+	}
+
+	updatedData := envstore.EnvInMemoryStoreObj.GetEnvStoreClone()
+	algo := updatedData.StringEnv[constants.EnvKeyJwtType]
+	if params != nil && params.Type != nil {
+		algo = *params.Type
+	}
+
+	key, err := env.AddJwtKey(updatedData.StringEnv, algo)
+	if err != nil {
+		return res, err
+	}
+	audit.SetTarget(ctx, constants.AuditTargetTypeJWTKey, key.ID)
+
+	envstore.EnvInMemoryStoreObj.UpdateEnvStore(updatedData)
+	err = env.UpdatePersistedEnv(updatedData)
+	if err != nil {
+		return res, err
+	}
+
+	return key.AsAPIJwtKey(), nil
+}
@@ -0,0 +1,49 @@
+package resolvers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/authorizerdev/authorizer/server/graph/model"
+	"github.com/authorizerdev/authorizer/server/token"
+	"github.com/authorizerdev/authorizer/server/userimport"
+	"github.com/authorizerdev/authorizer/server/utils"
+)
+
+// ImportUsersResolver is a resolver for import users mutation
+// It imports the json or csv users export of other identity systems with their password hashes
+// This is admin only mutation
+func ImportUsersResolver(ctx context.Context, params model.ImportUsersInput) (*model.ImportUsersResponse, error) {
+	gc, err := utils.GinContextFromContext(ctx)
+	var res *model.ImportUsersResponse
+	if err != nil {
+		return res, err
+	}
+
+	if !token.IsSuperAdmin(gc) {
+		return res, fmt.Errorf("unauthorized")
+	}
+
+	users, err := userimport.Parse(params.Format, []byte(params.Data))
+	if err != nil {
+		return res, err
+	}
+
+	result := userimport.Import(users)
+	importErrors := []*model.ImportUserError{}
+	for _, importError := range result.Errors {
+		importErrors = append(importErrors, &model.ImportUserError{
+			Row:   importError.Row,
+			Email: importError.Email,
+			Error: importError.Error,
+		})
+	}
+
+	res = &model.ImportUsersResponse{
+		Message:  fmt.Sprintf(`%d of %d users imported`, result.Imported, len(users)),
+		Imported: result.Imported,
+		Errors:   importErrors,
+	}
+
+	return res, nil
+}
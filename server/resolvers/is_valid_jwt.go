@@ -31,7 +31,8 @@ func IsValidJwtResolver(ctx context.Context, params *model.IsValidJWTQueryInput)
 		return nil, err
 	}
 
-	claimRoleInterface := claims[envstore.EnvInMemoryStoreObj.GetStringStoreEnvVariable(constants.EnvKeyJwtRoleClaim)].([]interface{})
+	// tokens issued via client_credentials grant carry client roles & no user claims
+	claimRoleInterface, _ := claims[envstore.EnvInMemoryStoreObj.GetStringStoreEnvVariable(constants.EnvKeyJwtRoleClaim)].([]interface{})
 	claimRoles := []string{}
 	for _, v := range claimRoleInterface {
 		claimRoles = append(claimRoles, v.(string))
@@ -0,0 +1,34 @@
+package resolvers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/authorizerdev/authorizer/server/env"
+	"github.com/authorizerdev/authorizer/server/envstore"
+	"github.com/authorizerdev/authorizer/server/graph/model"
+	"github.com/authorizerdev/authorizer/server/token"
+	"github.com/authorizerdev/authorizer/server/utils"
+)
+
+// JwtKeysResolver is a resolver for jwt keys query
+// It returns the active key followed by pending & retired keys of the key ring
+// This is admin only query
+func JwtKeysResolver(ctx context.Context) ([]*model.JWTKey, error) {
+	gc, err := utils.GinContextFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if !token.IsSuperAdmin(gc) {
+		return nil, fmt.Errorf("unauthorized")
+	}
+
+	stringEnv := envstore.EnvInMemoryStoreObj.GetEnvStoreClone().StringEnv
+	res := []*model.JWTKey{env.GetActiveJwtKey(stringEnv).AsAPIJwtKey()}
+	for _, key := range env.GetJwtKeys(stringEnv) {
+		res = append(res, key.AsAPIJwtKey())
+	}
+
+	return res, nil
+}
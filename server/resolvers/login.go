@@ -6,15 +6,18 @@ import (
 	"log"
 	"strings"
 
+	"github.com/authorizerdev/authorizer/server/audit"
 	"github.com/authorizerdev/authorizer/server/constants"
 	"github.com/authorizerdev/authorizer/server/cookie"
 	"github.com/authorizerdev/authorizer/server/db"
+	"github.com/authorizerdev/authorizer/server/env"
 	"github.com/authorizerdev/authorizer/server/envstore"
 	"github.com/authorizerdev/authorizer/server/graph/model"
+	"github.com/authorizerdev/authorizer/server/ratelimit"
 	"github.com/authorizerdev/authorizer/server/sessionstore"
 	"github.com/authorizerdev/authorizer/server/token"
 	"github.com/authorizerdev/authorizer/server/utils"
-	"golang.org/x/crypto/bcrypt"
+	"github.com/authorizerdev/authorizer/server/webhook"
 )
 
 // LoginResolver is a resolver for login mutation
@@ -30,10 +33,20 @@ func LoginResolver(ctx context.Context, params model.LoginInput) (*model.AuthRes
 	}
 
 	params.Email = strings.ToLower(params.Email)
+	audit.SetActor(ctx, constants.AuditActorTypeUser, "", params.Email)
+	if err := ratelimit.Check(constants.RateLimitActionLogin, params.Email, utils.GetIP(gc.Request)); err != nil {
+		return res, err
+	}
+
 	user, err := db.Provider.GetUserByEmail(params.Email)
 	if err != nil {
 		return res, fmt.Errorf(`user with this email not found`)
 	}
+	audit.SetActor(ctx, constants.AuditActorTypeUser, user.ID, user.Email)
+
+	if err := ratelimit.CheckAccountLock(user.ID); err != nil {
+		return res, err
+	}
 
 	if !strings.Contains(user.SignupMethods, constants.SignupMethodBasicAuth) {
 		return res, fmt.Errorf(`user has not signed up email & password`)
@@ -43,12 +56,24 @@ func LoginResolver(ctx context.Context, params model.LoginInput) (*model.AuthRes
 		return res, fmt.Errorf(`email not verified`)
 	}
 
-	err = bcrypt.CompareHashAndPassword([]byte(*user.Password), []byte(params.Password))
-
-	if err != nil {
-		log.Println("compare password error:", err)
+	if user.Password == nil || !utils.VerifyPassword(*user.Password, params.Password) {
+		if ratelimit.RecordFailedLogin(user.ID) {
+			return res, ratelimit.AccountLockedError(env.GetAccountLockoutDuration())
+		}
 		return res, fmt.Errorf(`invalid password`)
 	}
+	ratelimit.ResetFailedLogins(user.ID)
+
+	// upgrade the password hash to current algorithm & parameters
+	if utils.PasswordNeedsRehash(*user.Password) {
+		if password, err := utils.HashPassword(params.Password); err == nil {
+			user.Password = &password
+			if _, err := db.Provider.UpdateUser(user); err != nil {
+				log.Println("error updating password hash:", err)
+			}
+		}
+	}
+
 	roles := envstore.EnvInMemoryStoreObj.GetSliceStoreEnvVariable(constants.EnvKeyDefaultRoles)
 	currentRoles := strings.Split(user.Roles, ",")
 	if len(params.Roles) > 0 {
@@ -59,17 +84,34 @@ func LoginResolver(ctx context.Context, params model.LoginInput) (*model.AuthRes
 		roles = params.Roles
 	}
 
-	authToken, err := token.CreateAuthToken(user, roles)
+	// second factor is required to complete the login
+	if mfaMethods := utils.GetMfaMethods(user); len(mfaMethods) > 0 {
+		challenge, err := token.CreateMfaChallenge(user.ID, roles)
+		if err != nil {
+			return res, err
+		}
+
+		res = &model.AuthResponse{
+			Message:    `Please verify second factor to complete the login`,
+			MfaToken:   &challenge.Token,
+			MfaMethods: mfaMethods,
+		}
+		return res, nil
+	}
+
+	authToken, err := token.CreateAuthToken(user, roles, token.AuthTokenOptions{})
 	if err != nil {
 		return res, err
 	}
 	sessionstore.SetUserSession(user.ID, authToken.FingerPrint, authToken.RefreshToken.Token)
-	cookie.SetCookie(gc, authToken.AccessToken.Token, authToken.RefreshToken.Token, authToken.FingerPrintHash)
-	utils.SaveSessionInDB(user.ID, gc)
+	cookie.SetCookie(gc, authToken.AccessToken.Token, authToken.RefreshToken.Token, authToken.FingerPrintHash, authToken.AccessToken.ExpiresAt, authToken.RefreshToken.ExpiresAt)
+	utils.SaveSessionInDB(user.ID, authToken.FamilyID, gc)
+	webhook.Trigger(constants.WebhookEventUserLogin, user)
 
 	res = &model.AuthResponse{
 		Message:     `Logged in successfully`,
 		AccessToken: &authToken.AccessToken.Token,
+		IDToken:     &authToken.IDToken.Token,
 		ExpiresAt:   &authToken.AccessToken.ExpiresAt,
 		User:        user.AsAPIUser(),
 	}
@@ -5,7 +5,6 @@ import (
 
 	"github.com/authorizerdev/authorizer/server/cookie"
 	"github.com/authorizerdev/authorizer/server/graph/model"
-	"github.com/authorizerdev/authorizer/server/sessionstore"
 	"github.com/authorizerdev/authorizer/server/token"
 	"github.com/authorizerdev/authorizer/server/utils"
 )
@@ -44,7 +43,7 @@ func LogoutResolver(ctx context.Context) (*model.Response, error) {
 	}
 
 	userID := claims["id"].(string)
-	sessionstore.DeleteUserSession(userID, fingerPrint)
+	token.DeleteUserSession(userID, fingerPrint)
 	cookie.DeleteCookie(gc)
 
 	res = &model.Response{
@@ -0,0 +1,35 @@
+package resolvers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/authorizerdev/authorizer/server/cookie"
+	"github.com/authorizerdev/authorizer/server/graph/model"
+	"github.com/authorizerdev/authorizer/server/token"
+	"github.com/authorizerdev/authorizer/server/utils"
+)
+
+// LogoutAllResolver is a resolver for logout all mutation
+// It signs out the logged in user from all the sessions (devices)
+func LogoutAllResolver(ctx context.Context) (*model.Response, error) {
+	gc, err := utils.GinContextFromContext(ctx)
+	var res *model.Response
+	if err != nil {
+		return res, err
+	}
+
+	claims, err := token.ValidateAccessToken(gc)
+	if err != nil {
+		return res, err
+	}
+
+	revokeUserSessions(fmt.Sprintf("%v", claims["id"]), "")
+	cookie.DeleteCookie(gc)
+
+	res = &model.Response{
+		Message: "Logged out from all sessions successfully",
+	}
+
+	return res, nil
+}
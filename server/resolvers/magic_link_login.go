@@ -7,30 +7,44 @@ import (
 	"strings"
 	"time"
 
+	"github.com/authorizerdev/authorizer/server/audit"
 	"github.com/authorizerdev/authorizer/server/constants"
 	"github.com/authorizerdev/authorizer/server/db"
 	"github.com/authorizerdev/authorizer/server/db/models"
 	"github.com/authorizerdev/authorizer/server/email"
+	"github.com/authorizerdev/authorizer/server/env"
 	"github.com/authorizerdev/authorizer/server/envstore"
 	"github.com/authorizerdev/authorizer/server/graph/model"
+	"github.com/authorizerdev/authorizer/server/ratelimit"
 	"github.com/authorizerdev/authorizer/server/token"
 	"github.com/authorizerdev/authorizer/server/utils"
+	"github.com/authorizerdev/authorizer/server/webhook"
 )
 
 // MagicLinkLoginResolver is a resolver for magic link login mutation
+// It sends one time code instead of link when use_otp is set
 func MagicLinkLoginResolver(ctx context.Context, params model.MagicLinkLoginInput) (*model.Response, error) {
+	gc, err := utils.GinContextFromContext(ctx)
 	var res *model.Response
+	if err != nil {
+		return res, err
+	}
 
 	if envstore.EnvInMemoryStoreObj.GetBoolStoreEnvVariable(constants.EnvKeyDisableMagicLinkLogin) {
 		return res, fmt.Errorf(`magic link login is disabled for this instance`)
 	}
 
 	params.Email = strings.ToLower(params.Email)
+	audit.SetActor(ctx, constants.AuditActorTypeUser, "", params.Email)
 
 	if !utils.IsValidEmail(params.Email) {
 		return res, fmt.Errorf(`invalid email address`)
 	}
 
+	if err := ratelimit.Check(constants.RateLimitActionMagicLinkLogin, params.Email, utils.GetIP(gc.Request)); err != nil {
+		return res, err
+	}
+
 	inputRoles := []string{}
 
 	user := models.User{
@@ -55,7 +69,11 @@ func MagicLinkLoginResolver(ctx context.Context, params model.MagicLinkLoginInpu
 		}
 
 		user.Roles = strings.Join(inputRoles, ",")
-		user, _ = db.Provider.AddUser(user)
+		user, err = db.Provider.AddUser(user)
+		if err != nil {
+			return res, err
+		}
+		webhook.Trigger(constants.WebhookEventUserSignup, user)
 	} else {
 		user = existingUser
 		// There multiple scenarios with roles here in magic link login
@@ -103,6 +121,18 @@ func MagicLinkLoginResolver(ctx context.Context, params model.MagicLinkLoginInpu
 	}
 
 	if !envstore.EnvInMemoryStoreObj.GetBoolStoreEnvVariable(constants.EnvKeyDisableEmailVerification) {
+		if params.UseOtp != nil && *params.UseOtp {
+			err = sendEmailOTP(params.Email)
+			if err != nil {
+				return res, err
+			}
+
+			res = &model.Response{
+				Message: `OTP has been sent to your email. Please check your inbox!`,
+			}
+			return res, nil
+		}
+
 		// insert verification request
 		verificationType := constants.VerificationTypeMagicLinkLogin
 		verificationToken, err := token.CreateVerificationToken(params.Email, verificationType)
@@ -112,7 +142,7 @@ func MagicLinkLoginResolver(ctx context.Context, params model.MagicLinkLoginInpu
 		db.Provider.AddVerificationRequest(models.VerificationRequest{
 			Token:      verificationToken,
 			Identifier: verificationType,
-			ExpiresAt:  time.Now().Add(time.Minute * 30).Unix(),
+			ExpiresAt:  time.Now().Add(env.GetVerificationTokenExpiryTime()).Unix(),
 			Email:      params.Email,
 		})
 
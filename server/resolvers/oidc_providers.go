@@ -0,0 +1,33 @@
+package resolvers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/authorizerdev/authorizer/server/db"
+	"github.com/authorizerdev/authorizer/server/graph/model"
+	"github.com/authorizerdev/authorizer/server/token"
+	"github.com/authorizerdev/authorizer/server/utils"
+)
+
+// OidcProvidersResolver is a resolver for oidc providers query
+// This is admin only query
+func OidcProvidersResolver(ctx context.Context, params *model.PaginatedInput) (*model.OIDCProviders, error) {
+	gc, err := utils.GinContextFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if !token.IsSuperAdmin(gc) {
+		return nil, fmt.Errorf("unauthorized")
+	}
+
+	pagination := utils.GetPagination(params)
+
+	res, err := db.Provider.ListOIDCProviders(pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
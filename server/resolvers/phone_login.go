@@ -0,0 +1,55 @@
+package resolvers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/authorizerdev/authorizer/server/audit"
+	"github.com/authorizerdev/authorizer/server/constants"
+	"github.com/authorizerdev/authorizer/server/db"
+	"github.com/authorizerdev/authorizer/server/envstore"
+	"github.com/authorizerdev/authorizer/server/graph/model"
+	"github.com/authorizerdev/authorizer/server/ratelimit"
+	"github.com/authorizerdev/authorizer/server/utils"
+)
+
+// PhoneLoginResolver is a resolver for phone login mutation
+// It sends one time code to the verified phone number of user, which is used with verify_phone_otp mutation
+func PhoneLoginResolver(ctx context.Context, params model.PhoneLoginInput) (*model.Response, error) {
+	gc, err := utils.GinContextFromContext(ctx)
+	var res *model.Response
+	if err != nil {
+		return res, err
+	}
+
+	if envstore.EnvInMemoryStoreObj.GetBoolStoreEnvVariable(constants.EnvKeyDisablePhoneLogin) {
+		return res, fmt.Errorf(`phone login is disabled for this instance`)
+	}
+
+	params.PhoneNumber = strings.TrimSpace(params.PhoneNumber)
+	if !utils.IsValidPhoneNumber(params.PhoneNumber) {
+		return res, fmt.Errorf(`invalid phone number, phone number should be in E.164 format`)
+	}
+
+	if err := ratelimit.Check(constants.RateLimitActionPhoneLogin, params.PhoneNumber, utils.GetIP(gc.Request)); err != nil {
+		return res, err
+	}
+
+	user, err := db.Provider.GetUserByVerifiedPhoneNumber(params.PhoneNumber)
+	if err != nil {
+		return res, fmt.Errorf(`user with this phone number not found`)
+	}
+	audit.SetActor(ctx, constants.AuditActorTypeUser, user.ID, user.Email)
+
+	err = sendPhoneOTP(user, constants.VerificationTypePhoneOTPLogin)
+	if err != nil {
+		return res, err
+	}
+
+	res = &model.Response{
+		Message: `OTP has been sent to your phone number`,
+	}
+
+	return res, nil
+}
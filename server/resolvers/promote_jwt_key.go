@@ -0,0 +1,48 @@
+package resolvers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/authorizerdev/authorizer/server/audit"
+	"github.com/authorizerdev/authorizer/server/constants"
+	"github.com/authorizerdev/authorizer/server/env"
+	"github.com/authorizerdev/authorizer/server/envstore"
+	"github.com/authorizerdev/authorizer/server/graph/model"
+	"github.com/authorizerdev/authorizer/server/token"
+	"github.com/authorizerdev/authorizer/server/utils"
+)
+
+// PromoteJwtKeyResolver is a resolver for promote jwt key mutation
+// New tokens are signed with the promoted key and previously active key
+// is retired, so that it keeps verifying tokens till the grace period ends
+// This is admin only mutation
+func PromoteJwtKeyResolver(ctx context.Context, params model.JWTKeyInput) (*model.Response, error) {
+	gc, err := utils.GinContextFromContext(ctx)
+	var res *model.Response
+	if err != nil {
+		return res, err
+	}
+
+	if !token.IsSuperAdmin(gc) {
+		return res, fmt.Errorf("unauthorized")
+	}
+	audit.SetTarget(ctx, constants.AuditTargetTypeJWTKey, params.ID)
+
+	updatedData := envstore.EnvInMemoryStoreObj.GetEnvStoreClone()
+	err = env.PromoteJwtKey(updatedData.StringEnv, params.ID)
+	if err != nil {
+		return res, err
+	}
+
+	envstore.EnvInMemoryStoreObj.UpdateEnvStore(updatedData)
+	err = env.UpdatePersistedEnv(updatedData)
+	if err != nil {
+		return res, err
+	}
+
+	res = &model.Response{
+		Message: "jwt key promoted successfully",
+	}
+	return res, nil
+}
@@ -0,0 +1,55 @@
+package resolvers
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/authorizerdev/authorizer/server/audit"
+	"github.com/authorizerdev/authorizer/server/constants"
+	"github.com/authorizerdev/authorizer/server/db"
+	"github.com/authorizerdev/authorizer/server/graph/model"
+	"github.com/authorizerdev/authorizer/server/token"
+	"github.com/authorizerdev/authorizer/server/utils"
+)
+
+// RegenerateClientSecretResolver is a resolver for regenerate client secret mutation
+// Previous secret stops working immediately. Public clients become confidential clients
+// This is admin only mutation
+func RegenerateClientSecretResolver(ctx context.Context, params model.ClientInput) (*model.ClientSecretResponse, error) {
+	gc, err := utils.GinContextFromContext(ctx)
+	var res *model.ClientSecretResponse
+	if err != nil {
+		return res, err
+	}
+
+	if !token.IsSuperAdmin(gc) {
+		return res, fmt.Errorf("unauthorized")
+	}
+	audit.SetTarget(ctx, constants.AuditTargetTypeClient, params.ClientID)
+
+	client, err := db.Provider.GetClientByClientID(params.ClientID)
+	if err != nil {
+		return res, fmt.Errorf("client not found")
+	}
+
+	secret, hash, err := generateClientSecret()
+	if err != nil {
+		return res, err
+	}
+	client.ClientSecret = hash
+
+	client, err = db.Provider.UpdateClient(client)
+	if err != nil {
+		log.Println("error updating client:", err)
+		return res, err
+	}
+
+	res = &model.ClientSecretResponse{
+		Message:      `client secret regenerated successfully`,
+		Client:       client.AsAPIClient(),
+		ClientSecret: &secret,
+	}
+
+	return res, nil
+}
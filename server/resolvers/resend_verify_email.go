@@ -7,18 +7,27 @@ import (
 	"strings"
 	"time"
 
+	"github.com/authorizerdev/authorizer/server/audit"
+	"github.com/authorizerdev/authorizer/server/constants"
 	"github.com/authorizerdev/authorizer/server/db"
 	"github.com/authorizerdev/authorizer/server/db/models"
 	"github.com/authorizerdev/authorizer/server/email"
+	"github.com/authorizerdev/authorizer/server/env"
 	"github.com/authorizerdev/authorizer/server/graph/model"
+	"github.com/authorizerdev/authorizer/server/ratelimit"
 	"github.com/authorizerdev/authorizer/server/token"
 	"github.com/authorizerdev/authorizer/server/utils"
 )
 
 // ResendVerifyEmailResolver is a resolver for resend verify email mutation
 func ResendVerifyEmailResolver(ctx context.Context, params model.ResendVerifyEmailInput) (*model.Response, error) {
+	gc, err := utils.GinContextFromContext(ctx)
 	var res *model.Response
+	if err != nil {
+		return res, err
+	}
 	params.Email = strings.ToLower(params.Email)
+	audit.SetActor(ctx, constants.AuditActorTypeUser, "", params.Email)
 
 	if !utils.IsValidEmail(params.Email) {
 		return res, fmt.Errorf("invalid email")
@@ -28,6 +37,10 @@ func ResendVerifyEmailResolver(ctx context.Context, params model.ResendVerifyEma
 		return res, fmt.Errorf("invalid identifier")
 	}
 
+	if err := ratelimit.Check(constants.RateLimitActionResendVerifyEmail, params.Email, utils.GetIP(gc.Request)); err != nil {
+		return res, err
+	}
+
 	verificationRequest, err := db.Provider.GetVerificationRequestByEmail(params.Email, params.Identifier)
 	if err != nil {
 		return res, fmt.Errorf(`verification request not found`)
@@ -46,7 +59,7 @@ func ResendVerifyEmailResolver(ctx context.Context, params model.ResendVerifyEma
 	db.Provider.AddVerificationRequest(models.VerificationRequest{
 		Token:      verificationToken,
 		Identifier: params.Identifier,
-		ExpiresAt:  time.Now().Add(time.Minute * 30).Unix(),
+		ExpiresAt:  time.Now().Add(env.GetVerificationTokenExpiryTime()).Unix(),
 		Email:      params.Email,
 	})
 
@@ -6,10 +6,12 @@ import (
 	"strings"
 	"time"
 
+	"github.com/authorizerdev/authorizer/server/audit"
 	"github.com/authorizerdev/authorizer/server/constants"
 	"github.com/authorizerdev/authorizer/server/db"
 	"github.com/authorizerdev/authorizer/server/envstore"
 	"github.com/authorizerdev/authorizer/server/graph/model"
+	"github.com/authorizerdev/authorizer/server/passwordpolicy"
 	"github.com/authorizerdev/authorizer/server/token"
 	"github.com/authorizerdev/authorizer/server/utils"
 )
@@ -40,8 +42,14 @@ func ResetPasswordResolver(ctx context.Context, params model.ResetPasswordInput)
 	if err != nil {
 		return res, err
 	}
+	audit.SetActor(ctx, constants.AuditActorTypeUser, user.ID, user.Email)
 
-	password, _ := utils.EncryptPassword(params.Password)
+	if err := passwordpolicy.Validate(params.Password, user); err != nil {
+		return res, err
+	}
+
+	password, _ := utils.HashPassword(params.Password)
+	passwordpolicy.UpdateHistory(&user)
 	user.Password = &password
 
 	signupMethod := user.SignupMethods
@@ -59,6 +67,8 @@ func ResetPasswordResolver(ctx context.Context, params model.ResetPasswordInput)
 	// delete from verification table
 	db.Provider.DeleteVerificationRequest(verificationRequest)
 	db.Provider.UpdateUser(user)
+	// sessions stolen before password reset should not stay valid
+	revokeUserSessions(user.ID, "")
 
 	res = &model.Response{
 		Message: `Password updated successfully.`,
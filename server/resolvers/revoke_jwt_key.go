@@ -0,0 +1,48 @@
+package resolvers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/authorizerdev/authorizer/server/audit"
+	"github.com/authorizerdev/authorizer/server/constants"
+	"github.com/authorizerdev/authorizer/server/env"
+	"github.com/authorizerdev/authorizer/server/envstore"
+	"github.com/authorizerdev/authorizer/server/graph/model"
+	"github.com/authorizerdev/authorizer/server/token"
+	"github.com/authorizerdev/authorizer/server/utils"
+)
+
+// RevokeJwtKeyResolver is a resolver for revoke jwt key mutation
+// It removes the pending or retired key from the key ring right away,
+// so that tokens signed with leaked key are not valid anymore
+// This is admin only mutation
+func RevokeJwtKeyResolver(ctx context.Context, params model.JWTKeyInput) (*model.Response, error) {
+	gc, err := utils.GinContextFromContext(ctx)
+	var res *model.Response
+	if err != nil {
+		return res, err
+	}
+
+	if !token.IsSuperAdmin(gc) {
+		return res, fmt.Errorf("unauthorized")
+	}
+	audit.SetTarget(ctx, constants.AuditTargetTypeJWTKey, params.ID)
+
+	updatedData := envstore.EnvInMemoryStoreObj.GetEnvStoreClone()
+	err = env.RevokeJwtKey(updatedData.StringEnv, params.ID)
+	if err != nil {
+		return res, err
+	}
+
+	envstore.EnvInMemoryStoreObj.UpdateEnvStore(updatedData)
+	err = env.UpdatePersistedEnv(updatedData)
+	if err != nil {
+		return res, err
+	}
+
+	res = &model.Response{
+		Message: "jwt key revoked successfully",
+	}
+	return res, nil
+}
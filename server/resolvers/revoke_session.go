@@ -0,0 +1,108 @@
+package resolvers
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/authorizerdev/authorizer/server/cookie"
+	"github.com/authorizerdev/authorizer/server/db"
+	"github.com/authorizerdev/authorizer/server/db/models"
+	"github.com/authorizerdev/authorizer/server/graph/model"
+	"github.com/authorizerdev/authorizer/server/sessionstore"
+	"github.com/authorizerdev/authorizer/server/token"
+	"github.com/authorizerdev/authorizer/server/utils"
+)
+
+// RevokeSessionResolver is a resolver for revoke session mutation
+// It signs out single session (device) of logged in user
+func RevokeSessionResolver(ctx context.Context, params model.RevokeSessionInput) (*model.Response, error) {
+	gc, err := utils.GinContextFromContext(ctx)
+	var res *model.Response
+	if err != nil {
+		return res, err
+	}
+
+	claims, err := token.ValidateAccessToken(gc)
+	if err != nil {
+		return res, err
+	}
+
+	userID := fmt.Sprintf("%v", claims["id"])
+	session, err := db.Provider.GetSessionByID(params.ID)
+	if err != nil || session.UserID != userID {
+		return res, fmt.Errorf(`session not found`)
+	}
+
+	isCurrentSession := session.FamilyID != "" && session.FamilyID == currentSessionFamilyID(claims)
+	err = revokeSession(session)
+	if err != nil {
+		return res, err
+	}
+	if isCurrentSession {
+		cookie.DeleteCookie(gc)
+	}
+
+	res = &model.Response{
+		Message: `Session revoked successfully`,
+	}
+
+	return res, nil
+}
+
+// revokeSession removes the session from session store, which also makes its tokens inactive,
+// and deletes it from database
+func revokeSession(session models.Session) error {
+	if session.FamilyID != "" {
+		token.RevokeRefreshTokenFamily(session.UserID, session.FamilyID)
+	}
+
+	return db.Provider.DeleteSessionByID(session.ID)
+}
+
+// revokeUserSessions removes the sessions of user from session store & database,
+// except the session of keepFamilyID. Empty keepFamilyID revokes all the sessions
+func revokeUserSessions(userID, keepFamilyID string) {
+	if keepFamilyID == "" {
+		token.DeleteAllUserSessions(userID)
+	} else {
+		for fingerPrint, refreshToken := range sessionstore.GetUserSessions(userID) {
+			claims, err := token.VerifyJWTToken(refreshToken)
+			if err == nil && token.GetRefreshTokenFamilyID(claims) == keepFamilyID {
+				continue
+			}
+			token.DeleteUserSession(userID, fingerPrint)
+		}
+	}
+
+	sessions, err := db.Provider.ListSessionsByUserID(userID)
+	if err != nil {
+		log.Println("error getting sessions:", err)
+		return
+	}
+	for _, session := range sessions {
+		if keepFamilyID != "" && session.FamilyID == keepFamilyID {
+			continue
+		}
+		if err := db.Provider.DeleteSessionByID(session.ID); err != nil {
+			log.Println("error deleting session:", err)
+		}
+	}
+}
+
+// currentSessionFamilyID returns the refresh token family of the session
+// to which the verified access token belongs
+func currentSessionFamilyID(claims map[string]interface{}) string {
+	sessionID, _ := claims["sid"].(string)
+	if sessionID == "" {
+		return ""
+	}
+
+	for familyID, familySessionID := range token.GetActiveRefreshTokenFamilies(fmt.Sprintf("%v", claims["id"])) {
+		if familySessionID == sessionID {
+			return familyID
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,44 @@
+package resolvers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/authorizerdev/authorizer/server/audit"
+	"github.com/authorizerdev/authorizer/server/constants"
+	"github.com/authorizerdev/authorizer/server/db"
+	"github.com/authorizerdev/authorizer/server/graph/model"
+	"github.com/authorizerdev/authorizer/server/token"
+	"github.com/authorizerdev/authorizer/server/utils"
+)
+
+// RevokeUserSessionResolver is a resolver for revoke user session mutation
+// It signs out single session (device) of any user
+func RevokeUserSessionResolver(ctx context.Context, params model.RevokeSessionInput) (*model.Response, error) {
+	gc, err := utils.GinContextFromContext(ctx)
+	var res *model.Response
+	if err != nil {
+		return res, err
+	}
+
+	if !token.IsSuperAdmin(gc) {
+		return res, fmt.Errorf("unauthorized")
+	}
+
+	session, err := db.Provider.GetSessionByID(params.ID)
+	if err != nil {
+		return res, fmt.Errorf(`session not found`)
+	}
+	audit.SetTarget(ctx, constants.AuditTargetTypeUser, session.UserID)
+
+	err = revokeSession(session)
+	if err != nil {
+		return res, err
+	}
+
+	res = &model.Response{
+		Message: `Session revoked successfully`,
+	}
+
+	return res, nil
+}
@@ -0,0 +1,82 @@
+package resolvers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/authorizerdev/authorizer/server/constants"
+	"github.com/authorizerdev/authorizer/server/db"
+	"github.com/authorizerdev/authorizer/server/db/models"
+	"github.com/authorizerdev/authorizer/server/envstore"
+	"github.com/authorizerdev/authorizer/server/graph/model"
+	"github.com/authorizerdev/authorizer/server/ratelimit"
+	"github.com/authorizerdev/authorizer/server/sms"
+	"github.com/authorizerdev/authorizer/server/token"
+	"github.com/authorizerdev/authorizer/server/utils"
+)
+
+// SendPhoneVerificationOtpResolver is a resolver for send phone verification otp mutation
+// It sends one time code to the phone number of logged in user, which is confirmed using verify_phone_number mutation
+func SendPhoneVerificationOtpResolver(ctx context.Context) (*model.Response, error) {
+	gc, err := utils.GinContextFromContext(ctx)
+	var res *model.Response
+	if err != nil {
+		return res, err
+	}
+
+	claims, err := token.ValidateAccessToken(gc)
+	if err != nil {
+		return res, err
+	}
+
+	user, err := db.Provider.GetUserByID(fmt.Sprintf("%v", claims["id"]))
+	if err != nil {
+		return res, err
+	}
+
+	if user.PhoneNumber == nil || *user.PhoneNumber == "" {
+		return res, fmt.Errorf(`phone number is not set, please update the profile with phone number`)
+	}
+
+	if !utils.IsValidPhoneNumber(*user.PhoneNumber) {
+		return res, fmt.Errorf(`invalid phone number, phone number should be in E.164 format`)
+	}
+
+	if user.PhoneNumberVerifiedAt != nil {
+		return res, fmt.Errorf(`phone number is already verified`)
+	}
+
+	if err := ratelimit.Check(constants.RateLimitActionSendPhoneVerificationOtp, *user.PhoneNumber, utils.GetIP(gc.Request)); err != nil {
+		return res, err
+	}
+
+	if isPhoneNumberVerifiedByOtherUser(user.ID, *user.PhoneNumber) {
+		return res, fmt.Errorf(`phone number is already used by another user`)
+	}
+
+	err = sendPhoneOTP(user, constants.VerificationTypeVerifyPhoneNumber)
+	if err != nil {
+		return res, err
+	}
+
+	res = &model.Response{
+		Message: `OTP has been sent to your phone number`,
+	}
+
+	return res, nil
+}
+
+// sendPhoneOTP sends new one time code of given verification type to the phone number of user
+func sendPhoneOTP(user models.User, identifier string) error {
+	if !sms.IsSenderConfigured() {
+		return fmt.Errorf(`sms is not configured for this instance`)
+	}
+
+	otp, err := token.CreateOTPVerificationRequest(user.Email, identifier, *user.PhoneNumber)
+	if err != nil {
+		return err
+	}
+
+	message := fmt.Sprintf("%s is your %s verification code", otp, envstore.EnvInMemoryStoreObj.GetStringStoreEnvVariable(constants.EnvKeyOrganizationName))
+	return sms.SendSMS(*user.PhoneNumber, message)
+}
@@ -46,11 +46,22 @@ func SessionResolver(ctx context.Context, params *model.SessionQueryInput) (*mod
 		return res, err
 	}
 
+	// refresh token that was already rotated might be stolen, hence revoke its family
+	if token.IsRefreshTokenReused(claims) {
+		token.HandleRefreshTokenReuse(gc, claims)
+		return res, fmt.Errorf(`unauthorized`)
+	}
+
 	userID := claims["id"].(string)
 
-	persistedRefresh := sessionstore.GetUserSession(userID, fingerPrint)
-	if refreshToken != persistedRefresh {
-		return res, fmt.Errorf(`unauthorized`)
+	// parallel requests (e.g. multiple tabs) using the refresh token rotated within the grace period
+	// get the tokens of the latest session instead of rotating it again
+	rotated := !token.LockRefreshTokenRotation(claims)
+	if !rotated {
+		persistedRefresh := sessionstore.GetUserSession(userID, fingerPrint)
+		if refreshToken != persistedRefresh {
+			return res, fmt.Errorf(`unauthorized`)
+		}
 	}
 
 	user, err := db.Provider.GetUserByID(userID)
@@ -73,19 +84,40 @@ func SessionResolver(ctx context.Context, params *model.SessionQueryInput) (*mod
 		}
 	}
 
-	// delete older session
-	sessionstore.DeleteUserSession(userID, fingerPrint)
+	// keep the original authentication time for the id token
+	var authTime int64
+	if v, ok := claims["auth_time"].(float64); ok {
+		authTime = int64(v)
+	}
 
-	authToken, err := token.CreateAuthToken(user, claimRoles)
-	if err != nil {
-		return res, err
+	var authToken *token.Token
+	if rotated {
+		authToken, err = token.GetRefreshTokenFamilyAuthToken(user, claimRoles, claims, token.AuthTokenOptions{
+			AuthTime: authTime,
+		})
+		if err != nil {
+			return res, fmt.Errorf(`unauthorized`)
+		}
+	} else {
+		// delete older session
+		sessionstore.DeleteUserSession(userID, fingerPrint)
+
+		authToken, err = token.CreateAuthToken(user, claimRoles, token.AuthTokenOptions{
+			AuthTime: authTime,
+			FamilyID: token.GetRefreshTokenFamilyID(claims),
+		})
+		if err != nil {
+			return res, err
+		}
+		sessionstore.SetUserSession(user.ID, authToken.FingerPrint, authToken.RefreshToken.Token)
 	}
-	sessionstore.SetUserSession(user.ID, authToken.FingerPrint, authToken.RefreshToken.Token)
-	cookie.SetCookie(gc, authToken.AccessToken.Token, authToken.RefreshToken.Token, authToken.FingerPrintHash)
+	utils.UpdateSessionLastUsedInDB(authToken.FamilyID)
+	cookie.SetCookie(gc, authToken.AccessToken.Token, authToken.RefreshToken.Token, authToken.FingerPrintHash, authToken.AccessToken.ExpiresAt, authToken.RefreshToken.ExpiresAt)
 
 	res = &model.AuthResponse{
 		Message:     `Session token refreshed`,
 		AccessToken: &authToken.AccessToken.Token,
+		IDToken:     &authToken.IDToken.Token,
 		ExpiresAt:   &authToken.AccessToken.ExpiresAt,
 		User:        user.AsAPIUser(),
 	}
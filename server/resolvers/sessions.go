@@ -0,0 +1,53 @@
+package resolvers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/authorizerdev/authorizer/server/db"
+	"github.com/authorizerdev/authorizer/server/graph/model"
+	"github.com/authorizerdev/authorizer/server/token"
+	"github.com/authorizerdev/authorizer/server/utils"
+)
+
+// SessionsResolver is a resolver for sessions query
+// It returns the active sessions (devices) of logged in user
+func SessionsResolver(ctx context.Context) ([]*model.Session, error) {
+	gc, err := utils.GinContextFromContext(ctx)
+	var res []*model.Session
+	if err != nil {
+		return res, err
+	}
+
+	claims, err := token.ValidateAccessToken(gc)
+	if err != nil {
+		return res, err
+	}
+
+	sessionID, _ := claims["sid"].(string)
+	return listActiveSessions(fmt.Sprintf("%v", claims["id"]), sessionID)
+}
+
+// listActiveSessions returns the sessions of user which are still present in session store,
+// currentSessionID (sid claim) marks the session making the request
+func listActiveSessions(userID, currentSessionID string) ([]*model.Session, error) {
+	sessions, err := db.Provider.ListSessionsByUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	activeFamilies := token.GetActiveRefreshTokenFamilies(userID)
+	res := []*model.Session{}
+	for _, session := range sessions {
+		sessionID, ok := activeFamilies[session.FamilyID]
+		if !ok {
+			continue
+		}
+
+		apiSession := session.AsAPISession()
+		apiSession.IsCurrent = currentSessionID != "" && sessionID == currentSessionID
+		res = append(res, apiSession)
+	}
+
+	return res, nil
+}
@@ -7,16 +7,21 @@ import (
 	"strings"
 	"time"
 
+	"github.com/authorizerdev/authorizer/server/audit"
 	"github.com/authorizerdev/authorizer/server/constants"
 	"github.com/authorizerdev/authorizer/server/cookie"
 	"github.com/authorizerdev/authorizer/server/db"
 	"github.com/authorizerdev/authorizer/server/db/models"
 	"github.com/authorizerdev/authorizer/server/email"
+	"github.com/authorizerdev/authorizer/server/env"
 	"github.com/authorizerdev/authorizer/server/envstore"
 	"github.com/authorizerdev/authorizer/server/graph/model"
+	"github.com/authorizerdev/authorizer/server/passwordpolicy"
+	"github.com/authorizerdev/authorizer/server/ratelimit"
 	"github.com/authorizerdev/authorizer/server/sessionstore"
 	"github.com/authorizerdev/authorizer/server/token"
 	"github.com/authorizerdev/authorizer/server/utils"
+	"github.com/authorizerdev/authorizer/server/webhook"
 )
 
 // SignupResolver is a resolver for signup mutation
@@ -35,11 +40,16 @@ func SignupResolver(ctx context.Context, params model.SignUpInput) (*model.AuthR
 	}
 
 	params.Email = strings.ToLower(params.Email)
+	audit.SetActor(ctx, constants.AuditActorTypeUser, "", params.Email)
 
 	if !utils.IsValidEmail(params.Email) {
 		return res, fmt.Errorf(`invalid email address`)
 	}
 
+	if err := ratelimit.Check(constants.RateLimitActionSignup, params.Email, utils.GetIP(gc.Request)); err != nil {
+		return res, err
+	}
+
 	// find user with email
 	existingUser, err := db.Provider.GetUserByEmail(params.Email)
 	if err != nil {
@@ -70,9 +80,13 @@ func SignupResolver(ctx context.Context, params model.SignUpInput) (*model.AuthR
 		Email: params.Email,
 	}
 
+	if err := passwordpolicy.Validate(params.Password, user); err != nil {
+		return res, err
+	}
+
 	user.Roles = strings.Join(inputRoles, ",")
 
-	password, _ := utils.EncryptPassword(params.Password)
+	password, _ := utils.HashPassword(params.Password)
 	user.Password = &password
 
 	if params.GivenName != nil {
@@ -116,6 +130,8 @@ func SignupResolver(ctx context.Context, params model.SignUpInput) (*model.AuthR
 	if err != nil {
 		return res, err
 	}
+	audit.SetActor(ctx, constants.AuditActorTypeUser, user.ID, user.Email)
+	webhook.Trigger(constants.WebhookEventUserSignup, user)
 	roles := strings.Split(user.Roles, ",")
 	userToReturn := user.AsAPIUser()
 
@@ -129,7 +145,7 @@ func SignupResolver(ctx context.Context, params model.SignUpInput) (*model.AuthR
 		db.Provider.AddVerificationRequest(models.VerificationRequest{
 			Token:      verificationToken,
 			Identifier: verificationType,
-			ExpiresAt:  time.Now().Add(time.Minute * 30).Unix(),
+			ExpiresAt:  time.Now().Add(env.GetVerificationTokenExpiryTime()).Unix(),
 			Email:      params.Email,
 		})
 
@@ -144,17 +160,19 @@ func SignupResolver(ctx context.Context, params model.SignUpInput) (*model.AuthR
 		}
 	} else {
 
-		authToken, err := token.CreateAuthToken(user, roles)
+		authToken, err := token.CreateAuthToken(user, roles, token.AuthTokenOptions{})
 		if err != nil {
 			return res, err
 		}
 		sessionstore.SetUserSession(user.ID, authToken.FingerPrint, authToken.RefreshToken.Token)
-		cookie.SetCookie(gc, authToken.AccessToken.Token, authToken.RefreshToken.Token, authToken.FingerPrintHash)
-		utils.SaveSessionInDB(user.ID, gc)
+		cookie.SetCookie(gc, authToken.AccessToken.Token, authToken.RefreshToken.Token, authToken.FingerPrintHash, authToken.AccessToken.ExpiresAt, authToken.RefreshToken.ExpiresAt)
+		utils.SaveSessionInDB(user.ID, authToken.FamilyID, gc)
+		webhook.Trigger(constants.WebhookEventUserLogin, user)
 
 		res = &model.AuthResponse{
 			Message:     `Signed up successfully.`,
 			AccessToken: &authToken.AccessToken.Token,
+			IDToken:     &authToken.IDToken.Token,
 			ExpiresAt:   &authToken.AccessToken.ExpiresAt,
 			User:        userToReturn,
 		}
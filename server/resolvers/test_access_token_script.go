@@ -0,0 +1,63 @@
+package resolvers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/authorizerdev/authorizer/server/constants"
+	"github.com/authorizerdev/authorizer/server/db"
+	"github.com/authorizerdev/authorizer/server/db/models"
+	"github.com/authorizerdev/authorizer/server/envstore"
+	"github.com/authorizerdev/authorizer/server/graph/model"
+	"github.com/authorizerdev/authorizer/server/token"
+	"github.com/authorizerdev/authorizer/server/utils"
+)
+
+// TestAccessTokenScriptResolver is a resolver for test access token script query
+// It previews the access token claims of given user. This is admin only query
+func TestAccessTokenScriptResolver(ctx context.Context, params model.TestAccessTokenScriptInput) (*model.TestAccessTokenScriptResponse, error) {
+	gc, err := utils.GinContextFromContext(ctx)
+	var res *model.TestAccessTokenScriptResponse
+
+	if err != nil {
+		return res, err
+	}
+
+	if !token.IsSuperAdmin(gc) {
+		return res, fmt.Errorf("unauthorized")
+	}
+
+	var user models.User
+	if params.UserID != nil && *params.UserID != "" {
+		user, err = db.Provider.GetUserByID(*params.UserID)
+	} else if params.Email != nil && *params.Email != "" {
+		user, err = db.Provider.GetUserByEmail(strings.ToLower(*params.Email))
+	} else {
+		return res, fmt.Errorf("user_id or email is required")
+	}
+	if err != nil {
+		return res, fmt.Errorf(`user not found`)
+	}
+
+	script := envstore.EnvInMemoryStoreObj.GetStringStoreEnvVariable(constants.EnvKeyCustomAccessTokenScript)
+	if params.Script != nil {
+		script = *params.Script
+	}
+
+	if script != "" {
+		if err := token.ValidateAccessTokenScript(script); err != nil {
+			return res, err
+		}
+	}
+
+	claims, err := token.GetAccessTokenClaims(user, strings.Split(user.Roles, ","), token.AuthTokenOptions{}, script)
+	if err != nil {
+		return res, err
+	}
+
+	res = &model.TestAccessTokenScriptResponse{
+		Claims: claims,
+	}
+	return res, nil
+}
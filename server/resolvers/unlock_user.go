@@ -0,0 +1,44 @@
+package resolvers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/authorizerdev/authorizer/server/audit"
+	"github.com/authorizerdev/authorizer/server/constants"
+	"github.com/authorizerdev/authorizer/server/db"
+	"github.com/authorizerdev/authorizer/server/graph/model"
+	"github.com/authorizerdev/authorizer/server/ratelimit"
+	"github.com/authorizerdev/authorizer/server/token"
+	"github.com/authorizerdev/authorizer/server/utils"
+)
+
+// UnlockUserResolver is a resolver for unlock user mutation
+// It removes the account lock caused by failed login attempts
+// This is admin only mutation
+func UnlockUserResolver(ctx context.Context, params model.UnlockUserInput) (*model.Response, error) {
+	gc, err := utils.GinContextFromContext(ctx)
+	var res *model.Response
+	if err != nil {
+		return res, err
+	}
+
+	if !token.IsSuperAdmin(gc) {
+		return res, fmt.Errorf("unauthorized")
+	}
+
+	user, err := db.Provider.GetUserByEmail(strings.ToLower(params.Email))
+	if err != nil {
+		return res, err
+	}
+	audit.SetTarget(ctx, constants.AuditTargetTypeUser, user.ID)
+
+	ratelimit.UnlockAccount(user.ID)
+
+	res = &model.Response{
+		Message: `user unlocked successfully`,
+	}
+
+	return res, nil
+}
@@ -0,0 +1,55 @@
+package resolvers
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/authorizerdev/authorizer/server/audit"
+	"github.com/authorizerdev/authorizer/server/constants"
+	"github.com/authorizerdev/authorizer/server/db"
+	"github.com/authorizerdev/authorizer/server/graph/model"
+	"github.com/authorizerdev/authorizer/server/token"
+	"github.com/authorizerdev/authorizer/server/utils"
+)
+
+// UpdateClientResolver is a resolver for update client mutation
+// This is admin only mutation
+func UpdateClientResolver(ctx context.Context, params model.UpdateClientInput) (*model.Client, error) {
+	gc, err := utils.GinContextFromContext(ctx)
+	var res *model.Client
+	if err != nil {
+		return res, err
+	}
+
+	if !token.IsSuperAdmin(gc) {
+		return res, fmt.Errorf("unauthorized")
+	}
+	audit.SetTarget(ctx, constants.AuditTargetTypeClient, params.ClientID)
+
+	client, err := db.Provider.GetClientByClientID(params.ClientID)
+	if err != nil {
+		return res, fmt.Errorf("client not found")
+	}
+
+	if params.Name != nil {
+		if strings.TrimSpace(*params.Name) == "" {
+			return res, fmt.Errorf("name can not be empty")
+		}
+		client.Name = strings.TrimSpace(*params.Name)
+	}
+
+	err = setClientInfo(&client, params.RedirectUris, params.GrantTypes, params.Scopes, params.Roles, params.AllowPlainPkce, params.Trusted, params.AccessTokenExpiresIn, params.RefreshTokenExpiresIn)
+	if err != nil {
+		return res, err
+	}
+
+	client, err = db.Provider.UpdateClient(client)
+	if err != nil {
+		log.Println("error updating client:", err)
+		return res, err
+	}
+
+	return client.AsAPIClient(), nil
+}
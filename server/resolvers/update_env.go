@@ -5,12 +5,15 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
-	"log"
 	"reflect"
+	"sort"
+	"strings"
 
+	"github.com/authorizerdev/authorizer/server/audit"
 	"github.com/authorizerdev/authorizer/server/constants"
 	"github.com/authorizerdev/authorizer/server/cookie"
-	"github.com/authorizerdev/authorizer/server/db"
+	"github.com/authorizerdev/authorizer/server/crypto"
+	"github.com/authorizerdev/authorizer/server/env"
 	"github.com/authorizerdev/authorizer/server/envstore"
 	"github.com/authorizerdev/authorizer/server/graph/model"
 	"github.com/authorizerdev/authorizer/server/token"
@@ -30,6 +33,7 @@ func UpdateEnvResolver(ctx context.Context, params model.UpdateEnvInput) (*model
 	if !token.IsSuperAdmin(gc) {
 		return res, fmt.Errorf("unauthorized")
 	}
+	audit.SetTarget(ctx, constants.AuditTargetTypeEnv, "")
 
 	var data map[string]interface{}
 	byteData, err := json.Marshal(params)
@@ -59,6 +63,10 @@ func UpdateEnvResolver(ctx context.Context, params model.UpdateEnvInput) (*model
 
 	}
 
+	// keep the current signing key, so that it can be retired with grace period
+	// instead of invalidating all the tokens in case of secret / key change
+	previousJwtKey := env.GetActiveJwtKey(envstore.EnvInMemoryStoreObj.GetEnvStoreClone().StringEnv)
+
 	updatedData := envstore.EnvInMemoryStoreObj.GetEnvStoreClone()
 	for key, value := range data {
 		if value != nil {
@@ -81,6 +89,10 @@ func UpdateEnvResolver(ctx context.Context, params model.UpdateEnvInput) (*model
 		}
 	}
 
+	// keys requested to change are recorded even if update fails
+	currentData := envstore.EnvInMemoryStoreObj.GetEnvStoreClone()
+	audit.SetMessage(ctx, "changed keys: "+strings.Join(getChangedEnvKeys(currentData, updatedData), ","))
+
 	// handle derivative cases like disabling email verification & magic login
 	// in case SMTP is off but env is set to true
 	if updatedData.StringEnv[constants.EnvKeySmtpHost] == "" || updatedData.StringEnv[constants.EnvKeySmtpUsername] == "" || updatedData.StringEnv[constants.EnvKeySmtpPassword] == "" || updatedData.StringEnv[constants.EnvKeySenderEmail] == "" && updatedData.StringEnv[constants.EnvKeySmtpPort] == "" {
@@ -91,6 +103,10 @@ func UpdateEnvResolver(ctx context.Context, params model.UpdateEnvInput) (*model
 		if !updatedData.BoolEnv[constants.EnvKeyDisableMagicLinkLogin] {
 			updatedData.BoolEnv[constants.EnvKeyDisableMagicLinkLogin] = true
 		}
+
+		if !updatedData.BoolEnv[constants.EnvKeyDisableSecurityAlertEmail] {
+			updatedData.BoolEnv[constants.EnvKeyDisableSecurityAlertEmail] = true
+		}
 	}
 
 	// check the roles change
@@ -113,15 +129,62 @@ func UpdateEnvResolver(ctx context.Context, params model.UpdateEnvInput) (*model
 		}
 	}
 
-	// Update local store
-	envstore.EnvInMemoryStoreObj.UpdateEnvStore(updatedData)
+	// validate jwt type & keys. In case of algo change without new private key
+	// the existing key might not be valid for new algo, so generate a new pair
+	if params.JwtType != nil {
+		if !crypto.IsValidJwtAlgo(*params.JwtType) {
+			return res, fmt.Errorf("invalid jwt type %s", *params.JwtType)
+		}
 
-	// Fetch the current db store and update it
-	env, err := db.Provider.GetEnv()
-	if err != nil {
+		if params.JwtPrivateKey == nil && crypto.IsAsymmetricAlgo(*params.JwtType) {
+			if _, err := crypto.ParsePrivateKey(*params.JwtType, updatedData.StringEnv[constants.EnvKeyJwtPrivateKey]); err != nil {
+				updatedData.StringEnv[constants.EnvKeyJwtPrivateKey] = ""
+			}
+		}
+	}
+
+	if params.CustomAccessTokenScript != nil && *params.CustomAccessTokenScript != "" {
+		if err := token.ValidateAccessTokenScript(*params.CustomAccessTokenScript); err != nil {
+			return res, err
+		}
+	}
+
+	if err := env.ValidateTokenExpiryTimes(updatedData); err != nil {
 		return res, err
 	}
 
+	if err := env.ValidateRateLimits(updatedData); err != nil {
+		return res, err
+	}
+
+	if err := env.ValidatePasswordPolicy(updatedData); err != nil {
+		return res, err
+	}
+
+	if err := env.ValidateAuditLogRetention(updatedData); err != nil {
+		return res, err
+	}
+
+	for _, uri := range updatedData.SliceEnv[constants.EnvKeyClientRedirectURIs] {
+		if !utils.IsValidClientRedirectURI(uri) {
+			return res, fmt.Errorf("invalid %s %s", constants.EnvKeyClientRedirectURIs, uri)
+		}
+	}
+
+	if _, err := env.SetJwtKeys(updatedData.StringEnv); err != nil {
+		return res, err
+	}
+
+	if _, err := env.RetireChangedJwtKey(previousJwtKey, updatedData.StringEnv); err != nil {
+		return res, err
+	}
+
+	// including the derivative changes like generated jwt keys
+	audit.SetMessage(ctx, "changed keys: "+strings.Join(getChangedEnvKeys(currentData, updatedData), ","))
+
+	// Update local store
+	envstore.EnvInMemoryStoreObj.UpdateEnvStore(updatedData)
+
 	if params.AdminSecret != nil {
 		hashedKey, err := utils.EncryptPassword(envstore.EnvInMemoryStoreObj.GetStringStoreEnvVariable(constants.EnvKeyAdminSecret))
 		if err != nil {
@@ -130,20 +193,37 @@ func UpdateEnvResolver(ctx context.Context, params model.UpdateEnvInput) (*model
 		cookie.SetAdminCookie(gc, hashedKey)
 	}
 
-	encryptedConfig, err := utils.EncryptEnvData(updatedData)
+	err = env.UpdatePersistedEnv(updatedData)
 	if err != nil {
 		return res, err
 	}
 
-	env.EnvData = encryptedConfig
-	_, err = db.Provider.UpdateEnv(env)
-	if err != nil {
-		log.Println("error updating config:", err)
-		return res, err
-	}
-
 	res = &model.Response{
 		Message: "configurations updated successfully",
 	}
 	return res, nil
 }
+
+// getChangedEnvKeys returns the sorted keys of env that are changed in updated data.
+// Values are not returned, as they can be secrets
+func getChangedEnvKeys(current, updated envstore.Store) []string {
+	keys := []string{}
+	for key, value := range updated.StringEnv {
+		if current.StringEnv[key] != value {
+			keys = append(keys, key)
+		}
+	}
+	for key, value := range updated.BoolEnv {
+		if current.BoolEnv[key] != value {
+			keys = append(keys, key)
+		}
+	}
+	for key, value := range updated.SliceEnv {
+		if strings.Join(current.SliceEnv[key], ",") != strings.Join(value, ",") {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	return keys
+}
@@ -0,0 +1,99 @@
+package resolvers
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/authorizerdev/authorizer/server/audit"
+	"github.com/authorizerdev/authorizer/server/constants"
+	"github.com/authorizerdev/authorizer/server/db"
+	"github.com/authorizerdev/authorizer/server/graph/model"
+	"github.com/authorizerdev/authorizer/server/token"
+	"github.com/authorizerdev/authorizer/server/utils"
+)
+
+// UpdateOidcProviderResolver is a resolver for update oidc provider mutation
+// Name can not be updated as it is used as signup method of users.
+// This is admin only mutation
+func UpdateOidcProviderResolver(ctx context.Context, params model.UpdateOIDCProviderInput) (*model.OIDCProvider, error) {
+	gc, err := utils.GinContextFromContext(ctx)
+	var res *model.OIDCProvider
+	if err != nil {
+		return res, err
+	}
+
+	if !token.IsSuperAdmin(gc) {
+		return res, fmt.Errorf("unauthorized")
+	}
+	audit.SetTarget(ctx, constants.AuditTargetTypeOIDCProvider, params.ID)
+
+	oidcProvider, err := db.Provider.GetOIDCProviderByID(params.ID)
+	if err != nil {
+		return res, fmt.Errorf("oidc provider not found")
+	}
+
+	if params.DisplayName != nil {
+		oidcProvider.DisplayName = strings.TrimSpace(*params.DisplayName)
+	}
+
+	if params.IssuerURL != nil {
+		issuerURL, err := validateOIDCProviderIssuerURL(*params.IssuerURL)
+		if err != nil {
+			return res, err
+		}
+		oidcProvider.IssuerURL = issuerURL
+	}
+
+	if params.ClientID != nil {
+		if strings.TrimSpace(*params.ClientID) == "" {
+			return res, fmt.Errorf("client id is required")
+		}
+		oidcProvider.ClientID = strings.TrimSpace(*params.ClientID)
+	}
+
+	if params.ClientSecret != nil {
+		if strings.TrimSpace(*params.ClientSecret) == "" {
+			return res, fmt.Errorf("client secret is required")
+		}
+		clientSecret, err := utils.EncryptAES([]byte(strings.TrimSpace(*params.ClientSecret)))
+		if err != nil {
+			return res, err
+		}
+		oidcProvider.ClientSecret = string(clientSecret)
+	}
+
+	if params.Scopes != nil {
+		scopes, err := validateOIDCProviderScopes(params.Scopes)
+		if err != nil {
+			return res, err
+		}
+		oidcProvider.Scopes = scopes
+	}
+
+	if params.ClaimMapping != nil {
+		claimMapping, err := validateOIDCProviderClaimMapping(params.ClaimMapping)
+		if err != nil {
+			return res, err
+		}
+		oidcProvider.ClaimMapping = claimMapping
+	}
+
+	if params.TrustEmail != nil {
+		oidcProvider.TrustEmail = *params.TrustEmail
+	}
+
+	if params.Enabled != nil {
+		oidcProvider.Enabled = *params.Enabled
+	}
+
+	oidcProvider, err = db.Provider.UpdateOIDCProvider(oidcProvider)
+	if err != nil {
+		log.Println("error updating oidc provider:", err)
+		return res, err
+	}
+	utils.ResetMetaOIDCProviders()
+
+	return oidcProvider.AsAPIOIDCProvider(), nil
+}
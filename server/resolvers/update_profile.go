@@ -12,11 +12,12 @@ import (
 	"github.com/authorizerdev/authorizer/server/db"
 	"github.com/authorizerdev/authorizer/server/db/models"
 	"github.com/authorizerdev/authorizer/server/email"
+	"github.com/authorizerdev/authorizer/server/env"
 	"github.com/authorizerdev/authorizer/server/graph/model"
-	"github.com/authorizerdev/authorizer/server/sessionstore"
+	"github.com/authorizerdev/authorizer/server/passwordpolicy"
 	"github.com/authorizerdev/authorizer/server/token"
 	"github.com/authorizerdev/authorizer/server/utils"
-	"golang.org/x/crypto/bcrypt"
+	"github.com/authorizerdev/authorizer/server/webhook"
 )
 
 // UpdateProfileResolver is resolver for update profile mutation
@@ -68,6 +69,13 @@ func UpdateProfileResolver(ctx context.Context, params model.UpdateProfileInput)
 	}
 
 	if params.PhoneNumber != nil && user.PhoneNumber != params.PhoneNumber {
+		// changed phone number needs to be verified again
+		if user.PhoneNumber == nil || *user.PhoneNumber != *params.PhoneNumber {
+			if isPhoneNumberVerifiedByOtherUser(user.ID, *params.PhoneNumber) {
+				return res, fmt.Errorf(`phone number is already used by another user`)
+			}
+			user.PhoneNumberVerifiedAt = nil
+		}
 		user.PhoneNumber = params.PhoneNumber
 	}
 
@@ -75,8 +83,9 @@ func UpdateProfileResolver(ctx context.Context, params model.UpdateProfileInput)
 		user.Picture = params.Picture
 	}
 
+	hasPasswordChanged := false
 	if params.OldPassword != nil {
-		if err = bcrypt.CompareHashAndPassword([]byte(*user.Password), []byte(*params.OldPassword)); err != nil {
+		if user.Password == nil || !utils.VerifyPassword(*user.Password, *params.OldPassword) {
 			return res, fmt.Errorf("incorrect old password")
 		}
 
@@ -92,9 +101,14 @@ func UpdateProfileResolver(ctx context.Context, params model.UpdateProfileInput)
 			return res, fmt.Errorf(`password and confirm password does not match`)
 		}
 
-		password, _ := utils.EncryptPassword(*params.NewPassword)
+		if err := passwordpolicy.Validate(*params.NewPassword, user); err != nil {
+			return res, err
+		}
 
+		password, _ := utils.HashPassword(*params.NewPassword)
+		passwordpolicy.UpdateHistory(&user)
 		user.Password = &password
+		hasPasswordChanged = true
 	}
 
 	hasEmailChanged := false
@@ -113,7 +127,8 @@ func UpdateProfileResolver(ctx context.Context, params model.UpdateProfileInput)
 			return res, fmt.Errorf("user with this email address already exists")
 		}
 
-		sessionstore.DeleteAllUserSession(fmt.Sprintf("%v", user.ID))
+		// email needs to be verified again, hence current session is also revoked
+		revokeUserSessions(user.ID, "")
 		cookie.DeleteCookie(gc)
 
 		user.Email = newEmail
@@ -128,7 +143,7 @@ func UpdateProfileResolver(ctx context.Context, params model.UpdateProfileInput)
 		db.Provider.AddVerificationRequest(models.VerificationRequest{
 			Token:      verificationToken,
 			Identifier: verificationType,
-			ExpiresAt:  time.Now().Add(time.Minute * 30).Unix(),
+			ExpiresAt:  time.Now().Add(env.GetVerificationTokenExpiryTime()).Unix(),
 			Email:      newEmail,
 		})
 
@@ -138,11 +153,16 @@ func UpdateProfileResolver(ctx context.Context, params model.UpdateProfileInput)
 		}()
 	}
 
-	_, err = db.Provider.UpdateUser(user)
+	user, err = db.Provider.UpdateUser(user)
 	if err != nil {
 		log.Println("error updating user:", err)
 		return res, err
 	}
+	// sessions stolen before password change should not stay valid, hence only current session is kept
+	if hasPasswordChanged && !hasEmailChanged {
+		revokeUserSessions(user.ID, currentSessionFamilyID(claims))
+	}
+	webhook.Trigger(constants.WebhookEventUserProfileUpdated, user)
 	message := `Profile details updated successfully.`
 	if hasEmailChanged {
 		message += `For the email change we have sent new verification email, please verify and continue`
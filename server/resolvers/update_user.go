@@ -7,16 +7,18 @@ import (
 	"strings"
 	"time"
 
+	"github.com/authorizerdev/authorizer/server/audit"
 	"github.com/authorizerdev/authorizer/server/constants"
 	"github.com/authorizerdev/authorizer/server/cookie"
 	"github.com/authorizerdev/authorizer/server/db"
 	"github.com/authorizerdev/authorizer/server/db/models"
 	"github.com/authorizerdev/authorizer/server/email"
+	"github.com/authorizerdev/authorizer/server/env"
 	"github.com/authorizerdev/authorizer/server/envstore"
 	"github.com/authorizerdev/authorizer/server/graph/model"
-	"github.com/authorizerdev/authorizer/server/sessionstore"
 	"github.com/authorizerdev/authorizer/server/token"
 	"github.com/authorizerdev/authorizer/server/utils"
+	"github.com/authorizerdev/authorizer/server/webhook"
 )
 
 // UpdateUserResolver is a resolver for update user mutation
@@ -31,6 +33,7 @@ func UpdateUserResolver(ctx context.Context, params model.UpdateUserInput) (*mod
 	if !token.IsSuperAdmin(gc) {
 		return res, fmt.Errorf("unauthorized")
 	}
+	audit.SetTarget(ctx, constants.AuditTargetTypeUser, params.ID)
 
 	if params.GivenName == nil && params.FamilyName == nil && params.Picture == nil && params.MiddleName == nil && params.Nickname == nil && params.Email == nil && params.Birthdate == nil && params.Gender == nil && params.PhoneNumber == nil && params.Roles == nil {
 		return res, fmt.Errorf("please enter atleast one param to update")
@@ -66,6 +69,13 @@ func UpdateUserResolver(ctx context.Context, params model.UpdateUserInput) (*mod
 	}
 
 	if params.PhoneNumber != nil && user.PhoneNumber != params.PhoneNumber {
+		// changed phone number needs to be verified again
+		if user.PhoneNumber == nil || *user.PhoneNumber != *params.PhoneNumber {
+			if isPhoneNumberVerifiedByOtherUser(user.ID, *params.PhoneNumber) {
+				return res, fmt.Errorf(`phone number is already used by another user`)
+			}
+			user.PhoneNumberVerifiedAt = nil
+		}
 		user.PhoneNumber = params.PhoneNumber
 	}
 
@@ -95,7 +105,7 @@ func UpdateUserResolver(ctx context.Context, params model.UpdateUserInput) (*mod
 			return res, fmt.Errorf("user with this email address already exists")
 		}
 
-		sessionstore.DeleteAllUserSession(fmt.Sprintf("%v", user.ID))
+		revokeUserSessions(user.ID, "")
 		cookie.DeleteCookie(gc)
 
 		user.Email = newEmail
@@ -109,7 +119,7 @@ func UpdateUserResolver(ctx context.Context, params model.UpdateUserInput) (*mod
 		db.Provider.AddVerificationRequest(models.VerificationRequest{
 			Token:      verificationToken,
 			Identifier: verificationType,
-			ExpiresAt:  time.Now().Add(time.Minute * 30).Unix(),
+			ExpiresAt:  time.Now().Add(env.GetVerificationTokenExpiryTime()).Unix(),
 			Email:      newEmail,
 		})
 
@@ -131,12 +141,12 @@ func UpdateUserResolver(ctx context.Context, params model.UpdateUserInput) (*mod
 			return res, fmt.Errorf("invalid list of roles")
 		}
 
+		// tokens hold the roles, hence sessions are revoked when roles change
 		if !utils.IsStringArrayEqual(inputRoles, currentRoles) {
 			rolesToSave = strings.Join(inputRoles, ",")
+			revokeUserSessions(user.ID, "")
+			cookie.DeleteCookie(gc)
 		}
-
-		sessionstore.DeleteAllUserSession(fmt.Sprintf("%v", user.ID))
-		cookie.DeleteCookie(gc)
 	}
 
 	if rolesToSave != "" {
@@ -148,6 +158,7 @@ func UpdateUserResolver(ctx context.Context, params model.UpdateUserInput) (*mod
 		log.Println("error updating user:", err)
 		return res, err
 	}
+	webhook.Trigger(constants.WebhookEventUserProfileUpdated, user)
 
 	res = &model.User{
 		ID:         params.ID,
@@ -0,0 +1,63 @@
+package resolvers
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/authorizerdev/authorizer/server/audit"
+	"github.com/authorizerdev/authorizer/server/constants"
+	"github.com/authorizerdev/authorizer/server/db"
+	"github.com/authorizerdev/authorizer/server/graph/model"
+	"github.com/authorizerdev/authorizer/server/token"
+	"github.com/authorizerdev/authorizer/server/utils"
+	"github.com/authorizerdev/authorizer/server/webhook"
+)
+
+// UpdateWebhookResolver is a resolver for update webhook mutation
+// This is admin only mutation
+func UpdateWebhookResolver(ctx context.Context, params model.UpdateWebhookInput) (*model.Webhook, error) {
+	gc, err := utils.GinContextFromContext(ctx)
+	var res *model.Webhook
+	if err != nil {
+		return res, err
+	}
+
+	if !token.IsSuperAdmin(gc) {
+		return res, fmt.Errorf("unauthorized")
+	}
+	audit.SetTarget(ctx, constants.AuditTargetTypeWebhook, params.ID)
+
+	existingWebhook, err := db.Provider.GetWebhookByID(params.ID)
+	if err != nil {
+		return res, fmt.Errorf("webhook not found")
+	}
+
+	if params.EventName != nil {
+		if !webhook.IsValidEvent(*params.EventName) {
+			return res, fmt.Errorf("invalid event name %s, it should be one of %s", *params.EventName, strings.Join(constants.WebhookEvents, ", "))
+		}
+		existingWebhook.EventName = *params.EventName
+	}
+
+	if params.Endpoint != nil {
+		endpoint := strings.TrimSpace(*params.Endpoint)
+		if !utils.IsValidWebhookEndpoint(endpoint) {
+			return res, fmt.Errorf("invalid endpoint, it should be an absolute http or https url")
+		}
+		existingWebhook.Endpoint = endpoint
+	}
+
+	if params.Enabled != nil {
+		existingWebhook.Enabled = *params.Enabled
+	}
+
+	existingWebhook, err = db.Provider.UpdateWebhook(existingWebhook)
+	if err != nil {
+		log.Println("error updating webhook:", err)
+		return res, err
+	}
+
+	return existingWebhook.AsAPIWebhook(), nil
+}
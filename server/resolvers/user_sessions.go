@@ -0,0 +1,32 @@
+package resolvers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/authorizerdev/authorizer/server/db"
+	"github.com/authorizerdev/authorizer/server/graph/model"
+	"github.com/authorizerdev/authorizer/server/token"
+	"github.com/authorizerdev/authorizer/server/utils"
+)
+
+// UserSessionsResolver is a resolver for user sessions query
+// It returns the active sessions (devices) of given user
+func UserSessionsResolver(ctx context.Context, params model.ListSessionsInput) ([]*model.Session, error) {
+	gc, err := utils.GinContextFromContext(ctx)
+	var res []*model.Session
+	if err != nil {
+		return res, err
+	}
+
+	if !token.IsSuperAdmin(gc) {
+		return res, fmt.Errorf("unauthorized")
+	}
+
+	user, err := db.Provider.GetUserByID(params.UserID)
+	if err != nil {
+		return res, fmt.Errorf(`user not found`)
+	}
+
+	return listActiveSessions(user.ID, "")
+}
@@ -0,0 +1,70 @@
+package resolvers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/authorizerdev/authorizer/server/constants"
+	"github.com/authorizerdev/authorizer/server/envstore"
+	"github.com/authorizerdev/authorizer/server/graph/model"
+	"github.com/authorizerdev/authorizer/server/token"
+	"github.com/authorizerdev/authorizer/server/utils"
+)
+
+// VerifyDeviceCodeResolver is a resolver for verify device code mutation
+// Logged in user approves or denies the device request using user code shown on the device
+func VerifyDeviceCodeResolver(ctx context.Context, params model.VerifyDeviceCodeInput) (*model.Response, error) {
+	gc, err := utils.GinContextFromContext(ctx)
+	var res *model.Response
+	if err != nil {
+		return res, err
+	}
+
+	claims, err := token.ValidateAccessToken(gc)
+	if err != nil {
+		return res, err
+	}
+
+	deviceCode, err := token.GetDeviceCodeByUserCode(params.UserCode)
+	if err != nil {
+		return res, err
+	}
+
+	if deviceCode.Status != constants.DeviceCodeStatusPending {
+		return res, fmt.Errorf("user code is already used")
+	}
+
+	if params.Deny != nil && *params.Deny {
+		deviceCode.Status = constants.DeviceCodeStatusDenied
+		err = token.SaveDeviceCode(deviceCode)
+		if err != nil {
+			return res, err
+		}
+
+		res = &model.Response{
+			Message: `device request denied`,
+		}
+		return res, nil
+	}
+
+	roles := []string{}
+	if claimRoles, ok := claims[envstore.EnvInMemoryStoreObj.GetStringStoreEnvVariable(constants.EnvKeyJwtRoleClaim)].([]interface{}); ok {
+		for _, role := range claimRoles {
+			roles = append(roles, role.(string))
+		}
+	}
+
+	deviceCode.Status = constants.DeviceCodeStatusApproved
+	deviceCode.UserID = fmt.Sprintf("%v", claims["id"])
+	deviceCode.Roles = roles
+	err = token.SaveDeviceCode(deviceCode)
+	if err != nil {
+		return res, err
+	}
+
+	res = &model.Response{
+		Message: `device approved successfully`,
+	}
+
+	return res, nil
+}
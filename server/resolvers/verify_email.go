@@ -6,12 +6,15 @@ import (
 	"strings"
 	"time"
 
+	"github.com/authorizerdev/authorizer/server/audit"
+	"github.com/authorizerdev/authorizer/server/constants"
 	"github.com/authorizerdev/authorizer/server/cookie"
 	"github.com/authorizerdev/authorizer/server/db"
 	"github.com/authorizerdev/authorizer/server/graph/model"
 	"github.com/authorizerdev/authorizer/server/sessionstore"
 	"github.com/authorizerdev/authorizer/server/token"
 	"github.com/authorizerdev/authorizer/server/utils"
+	"github.com/authorizerdev/authorizer/server/webhook"
 )
 
 // VerifyEmailResolver is a resolver for verify email mutation
@@ -37,26 +40,47 @@ func VerifyEmailResolver(ctx context.Context, params model.VerifyEmailInput) (*m
 	if err != nil {
 		return res, err
 	}
+	audit.SetActor(ctx, constants.AuditActorTypeUser, user.ID, user.Email)
 
 	// update email_verified_at in users table
+	isNewlyVerified := user.EmailVerifiedAt == nil
 	now := time.Now().Unix()
 	user.EmailVerifiedAt = &now
 	db.Provider.UpdateUser(user)
+	if isNewlyVerified {
+		webhook.Trigger(constants.WebhookEventUserEmailVerified, user)
+	}
 	// delete from verification table
 	db.Provider.DeleteVerificationRequest(verificationRequest)
 
 	roles := strings.Split(user.Roles, ",")
-	authToken, err := token.CreateAuthToken(user, roles)
+	if mfaMethods := utils.GetMfaMethods(user); len(mfaMethods) > 0 {
+		challenge, err := token.CreateMfaChallenge(user.ID, roles)
+		if err != nil {
+			return res, err
+		}
+
+		res = &model.AuthResponse{
+			Message:    `Email verified successfully. Please verify second factor to complete the login`,
+			MfaToken:   &challenge.Token,
+			MfaMethods: mfaMethods,
+		}
+		return res, nil
+	}
+
+	authToken, err := token.CreateAuthToken(user, roles, token.AuthTokenOptions{})
 	if err != nil {
 		return res, err
 	}
 	sessionstore.SetUserSession(user.ID, authToken.FingerPrint, authToken.RefreshToken.Token)
-	cookie.SetCookie(gc, authToken.AccessToken.Token, authToken.RefreshToken.Token, authToken.FingerPrintHash)
-	utils.SaveSessionInDB(user.ID, gc)
+	cookie.SetCookie(gc, authToken.AccessToken.Token, authToken.RefreshToken.Token, authToken.FingerPrintHash, authToken.AccessToken.ExpiresAt, authToken.RefreshToken.ExpiresAt)
+	utils.SaveSessionInDB(user.ID, authToken.FamilyID, gc)
+	webhook.Trigger(constants.WebhookEventUserLogin, user)
 
 	res = &model.AuthResponse{
 		Message:     `Email verified successfully.`,
 		AccessToken: &authToken.AccessToken.Token,
+		IDToken:     &authToken.IDToken.Token,
 		ExpiresAt:   &authToken.AccessToken.ExpiresAt,
 		User:        user.AsAPIUser(),
 	}
@@ -0,0 +1,109 @@
+package resolvers
+
+import (
+	"context"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/authorizerdev/authorizer/server/audit"
+	"github.com/authorizerdev/authorizer/server/constants"
+	"github.com/authorizerdev/authorizer/server/cookie"
+	"github.com/authorizerdev/authorizer/server/db"
+	"github.com/authorizerdev/authorizer/server/email"
+	"github.com/authorizerdev/authorizer/server/graph/model"
+	"github.com/authorizerdev/authorizer/server/ratelimit"
+	"github.com/authorizerdev/authorizer/server/sessionstore"
+	"github.com/authorizerdev/authorizer/server/token"
+	"github.com/authorizerdev/authorizer/server/utils"
+	"github.com/authorizerdev/authorizer/server/webhook"
+)
+
+// VerifyEmailOtpResolver is a resolver for verify email otp mutation
+// It logs in the user with the one time code sent by magic link login with use_otp
+func VerifyEmailOtpResolver(ctx context.Context, params model.VerifyEmailOTPInput) (*model.AuthResponse, error) {
+	gc, err := utils.GinContextFromContext(ctx)
+	var res *model.AuthResponse
+	if err != nil {
+		return res, err
+	}
+
+	params.Email = strings.ToLower(params.Email)
+	audit.SetActor(ctx, constants.AuditActorTypeUser, "", params.Email)
+	if err := ratelimit.Check(constants.RateLimitActionVerifyEmailOtp, params.Email, utils.GetIP(gc.Request)); err != nil {
+		return res, err
+	}
+
+	err = token.VerifyOTPVerificationRequest(params.Email, constants.VerificationTypeEmailOTPLogin, params.Email, params.Otp)
+	if err != nil {
+		return res, err
+	}
+
+	user, err := db.Provider.GetUserByEmail(params.Email)
+	if err != nil {
+		return res, err
+	}
+	audit.SetActor(ctx, constants.AuditActorTypeUser, user.ID, user.Email)
+
+	// code is received via email, hence email is verified
+	if user.EmailVerifiedAt == nil {
+		now := time.Now().Unix()
+		user.EmailVerifiedAt = &now
+		user, err = db.Provider.UpdateUser(user)
+		if err != nil {
+			return res, err
+		}
+		webhook.Trigger(constants.WebhookEventUserEmailVerified, user)
+	}
+
+	roles := strings.Split(user.Roles, ",")
+	if mfaMethods := utils.GetMfaMethods(user); len(mfaMethods) > 0 {
+		challenge, err := token.CreateMfaChallenge(user.ID, roles)
+		if err != nil {
+			return res, err
+		}
+
+		res = &model.AuthResponse{
+			Message:    `Please verify second factor to complete the login`,
+			MfaToken:   &challenge.Token,
+			MfaMethods: mfaMethods,
+		}
+		return res, nil
+	}
+
+	authToken, err := token.CreateAuthToken(user, roles, token.AuthTokenOptions{})
+	if err != nil {
+		return res, err
+	}
+	sessionstore.SetUserSession(user.ID, authToken.FingerPrint, authToken.RefreshToken.Token)
+	cookie.SetCookie(gc, authToken.AccessToken.Token, authToken.RefreshToken.Token, authToken.FingerPrintHash, authToken.AccessToken.ExpiresAt, authToken.RefreshToken.ExpiresAt)
+	utils.SaveSessionInDB(user.ID, authToken.FamilyID, gc)
+	webhook.Trigger(constants.WebhookEventUserLogin, user)
+
+	res = &model.AuthResponse{
+		Message:     `Logged in successfully`,
+		AccessToken: &authToken.AccessToken.Token,
+		IDToken:     &authToken.IDToken.Token,
+		ExpiresAt:   &authToken.AccessToken.ExpiresAt,
+		User:        user.AsAPIUser(),
+	}
+
+	return res, nil
+}
+
+// sendEmailOTP sends new one time code for login to the email
+func sendEmailOTP(emailAddress string) error {
+	otp, err := token.CreateOTPVerificationRequest(emailAddress, constants.VerificationTypeEmailOTPLogin, emailAddress)
+	if err != nil {
+		return err
+	}
+
+	// exec it as go routin so that we can reduce the api latency
+	go func() {
+		if err := email.SendOTPMail(emailAddress, otp); err != nil {
+			log.Println("error sending otp email:", err)
+		}
+	}()
+
+	return nil
+}
@@ -0,0 +1,155 @@
+package resolvers
+
+import (
+	"context"
+	"crypto/subtle"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/authorizerdev/authorizer/server/audit"
+	"github.com/authorizerdev/authorizer/server/constants"
+	"github.com/authorizerdev/authorizer/server/cookie"
+	"github.com/authorizerdev/authorizer/server/crypto"
+	"github.com/authorizerdev/authorizer/server/db"
+	"github.com/authorizerdev/authorizer/server/db/models"
+	"github.com/authorizerdev/authorizer/server/graph/model"
+	"github.com/authorizerdev/authorizer/server/ratelimit"
+	"github.com/authorizerdev/authorizer/server/sessionstore"
+	"github.com/authorizerdev/authorizer/server/token"
+	"github.com/authorizerdev/authorizer/server/utils"
+	"github.com/authorizerdev/authorizer/server/webhook"
+)
+
+const (
+	// totpLastStepStatePrefix is the prefix of session store key for the last used TOTP time step of user
+	totpLastStepStatePrefix = "totp_last_step_"
+	// totpUsedStepStatePrefix is the prefix of session store key for the TOTP time step used by user
+	totpUsedStepStatePrefix = "totp_used_step_"
+)
+
+// VerifyOtpResolver is a resolver for verify otp mutation
+// It completes the login of user with the mfa token returned by login
+func VerifyOtpResolver(ctx context.Context, params model.VerifyOTPInput) (*model.AuthResponse, error) {
+	gc, err := utils.GinContextFromContext(ctx)
+	var res *model.AuthResponse
+	if err != nil {
+		return res, err
+	}
+
+	challenge, err := token.GetMfaChallenge(params.MfaToken)
+	if err != nil {
+		return res, err
+	}
+
+	user, err := db.Provider.GetUserByID(challenge.UserID)
+	if err != nil {
+		return res, err
+	}
+	audit.SetActor(ctx, constants.AuditActorTypeUser, user.ID, user.Email)
+
+	if err := ratelimit.Check(constants.RateLimitActionVerifyOtp, user.Email, utils.GetIP(gc.Request)); err != nil {
+		return res, err
+	}
+
+	if !validateUserOTP(&user, params.Otp) {
+		if token.RecordMfaChallengeFailedAttempt(challenge) {
+			return res, fmt.Errorf(`too many invalid attempts, please login again`)
+		}
+
+		return res, fmt.Errorf(`invalid otp`)
+	}
+	token.RemoveMfaChallenge(challenge)
+
+	authToken, err := token.CreateAuthToken(user, challenge.Roles, token.AuthTokenOptions{
+		AuthTime: challenge.AuthTime,
+	})
+	if err != nil {
+		return res, err
+	}
+	sessionstore.SetUserSession(user.ID, authToken.FingerPrint, authToken.RefreshToken.Token)
+	cookie.SetCookie(gc, authToken.AccessToken.Token, authToken.RefreshToken.Token, authToken.FingerPrintHash, authToken.AccessToken.ExpiresAt, authToken.RefreshToken.ExpiresAt)
+	utils.SaveSessionInDB(user.ID, authToken.FamilyID, gc)
+	webhook.Trigger(constants.WebhookEventUserLogin, user)
+
+	res = &model.AuthResponse{
+		Message:     `Logged in successfully`,
+		AccessToken: &authToken.AccessToken.Token,
+		IDToken:     &authToken.IDToken.Token,
+		ExpiresAt:   &authToken.AccessToken.ExpiresAt,
+		User:        user.AsAPIUser(),
+	}
+
+	return res, nil
+}
+
+// validateUserOTP validates the TOTP code or backup code of user.
+// Used backup code is removed from the user, only if it was not used by parallel request
+func validateUserOTP(user *models.User, otp string) bool {
+	if validateTotpCode(*user, otp) {
+		return true
+	}
+
+	if user.TotpBackupCodes == nil || *user.TotpBackupCodes == "" {
+		return false
+	}
+
+	hash := crypto.HashBackupCode(otp)
+	backupCodes := strings.Split(*user.TotpBackupCodes, ",")
+	for i, backupCode := range backupCodes {
+		if subtle.ConstantTimeCompare([]byte(backupCode), []byte(hash)) == 1 {
+			remainingBackupCodes := strings.Join(append(backupCodes[:i:i], backupCodes[i+1:]...), ",")
+			updated, err := db.Provider.UpdateUserTotpBackupCodes(*user, remainingBackupCodes)
+			if err != nil {
+				log.Println("error removing used backup code:", err)
+				return false
+			}
+			if !updated {
+				return false
+			}
+			user.TotpBackupCodes = &remainingBackupCodes
+			return true
+		}
+	}
+
+	return false
+}
+
+// validateTotpCode validates the TOTP code of user.
+// Code of the same or previous time step can not be used again,
+// time step is claimed atomically so that parallel requests can not use the same code
+func validateTotpCode(user models.User, code string) bool {
+	if user.TotpSecret == nil {
+		return false
+	}
+
+	encryptedSecret, err := utils.DecryptB64(*user.TotpSecret)
+	if err != nil {
+		return false
+	}
+
+	secret, err := utils.DecryptAES([]byte(encryptedSecret))
+	if err != nil {
+		log.Println("error decrypting totp secret:", err)
+		return false
+	}
+
+	step, ok := crypto.ValidateTOTPCode(string(secret), code)
+	if !ok {
+		return false
+	}
+
+	lastStep, _ := strconv.ParseInt(sessionstore.GetState(totpLastStepStatePrefix+user.ID), 10, 64)
+	if step <= lastStep {
+		return false
+	}
+
+	count, err := sessionstore.IncrementState(fmt.Sprintf("%s%s_%d", totpUsedStepStatePrefix, user.ID, step), 3*crypto.TOTPPeriod)
+	if err != nil || count != 1 {
+		return false
+	}
+	sessionstore.SetState(totpLastStepStatePrefix+user.ID, strconv.FormatInt(step, 10), 3*crypto.TOTPPeriod)
+
+	return true
+}
@@ -0,0 +1,67 @@
+package resolvers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/authorizerdev/authorizer/server/constants"
+	"github.com/authorizerdev/authorizer/server/db"
+	"github.com/authorizerdev/authorizer/server/graph/model"
+	"github.com/authorizerdev/authorizer/server/token"
+	"github.com/authorizerdev/authorizer/server/utils"
+)
+
+// VerifyPhoneNumberResolver is a resolver for verify phone number mutation
+// It confirms the phone number of logged in user with the code sent by send_phone_verification_otp
+func VerifyPhoneNumberResolver(ctx context.Context, params model.OTPInput) (*model.Response, error) {
+	gc, err := utils.GinContextFromContext(ctx)
+	var res *model.Response
+	if err != nil {
+		return res, err
+	}
+
+	claims, err := token.ValidateAccessToken(gc)
+	if err != nil {
+		return res, err
+	}
+
+	user, err := db.Provider.GetUserByID(fmt.Sprintf("%v", claims["id"]))
+	if err != nil {
+		return res, err
+	}
+
+	if user.PhoneNumber == nil || *user.PhoneNumber == "" {
+		return res, fmt.Errorf(`phone number is not set`)
+	}
+
+	if isPhoneNumberVerifiedByOtherUser(user.ID, *user.PhoneNumber) {
+		return res, fmt.Errorf(`phone number is already used by another user`)
+	}
+
+	// code is bound to the phone number, so it is not valid once the phone number is changed
+	err = token.VerifyOTPVerificationRequest(user.Email, constants.VerificationTypeVerifyPhoneNumber, *user.PhoneNumber, params.Otp)
+	if err != nil {
+		return res, err
+	}
+
+	now := time.Now().Unix()
+	user.PhoneNumberVerifiedAt = &now
+	_, err = db.Provider.UpdateUser(user)
+	if err != nil {
+		return res, err
+	}
+
+	res = &model.Response{
+		Message: `Phone number verified successfully`,
+	}
+
+	return res, nil
+}
+
+// isPhoneNumberVerifiedByOtherUser checks if the phone number is already verified by another user,
+// as verified phone number identifies the user in phone login
+func isPhoneNumberVerifiedByOtherUser(userID, phoneNumber string) bool {
+	user, err := db.Provider.GetUserByVerifiedPhoneNumber(phoneNumber)
+	return err == nil && user.ID != userID
+}
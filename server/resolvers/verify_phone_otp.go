@@ -0,0 +1,83 @@
+package resolvers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/authorizerdev/authorizer/server/audit"
+	"github.com/authorizerdev/authorizer/server/constants"
+	"github.com/authorizerdev/authorizer/server/cookie"
+	"github.com/authorizerdev/authorizer/server/db"
+	"github.com/authorizerdev/authorizer/server/envstore"
+	"github.com/authorizerdev/authorizer/server/graph/model"
+	"github.com/authorizerdev/authorizer/server/ratelimit"
+	"github.com/authorizerdev/authorizer/server/sessionstore"
+	"github.com/authorizerdev/authorizer/server/token"
+	"github.com/authorizerdev/authorizer/server/utils"
+	"github.com/authorizerdev/authorizer/server/webhook"
+)
+
+// VerifyPhoneOtpResolver is a resolver for verify phone otp mutation
+// It logs in the user with the one time code sent by phone login
+func VerifyPhoneOtpResolver(ctx context.Context, params model.VerifyPhoneOTPInput) (*model.AuthResponse, error) {
+	gc, err := utils.GinContextFromContext(ctx)
+	var res *model.AuthResponse
+	if err != nil {
+		return res, err
+	}
+
+	if envstore.EnvInMemoryStoreObj.GetBoolStoreEnvVariable(constants.EnvKeyDisablePhoneLogin) {
+		return res, fmt.Errorf(`phone login is disabled for this instance`)
+	}
+
+	params.PhoneNumber = strings.TrimSpace(params.PhoneNumber)
+	if err := ratelimit.Check(constants.RateLimitActionVerifyPhoneOtp, params.PhoneNumber, utils.GetIP(gc.Request)); err != nil {
+		return res, err
+	}
+
+	user, err := db.Provider.GetUserByVerifiedPhoneNumber(params.PhoneNumber)
+	if err != nil {
+		return res, fmt.Errorf(`invalid otp`)
+	}
+	audit.SetActor(ctx, constants.AuditActorTypeUser, user.ID, user.Email)
+
+	err = token.VerifyOTPVerificationRequest(user.Email, constants.VerificationTypePhoneOTPLogin, params.PhoneNumber, params.Otp)
+	if err != nil {
+		return res, err
+	}
+
+	roles := strings.Split(user.Roles, ",")
+	if mfaMethods := utils.GetMfaMethods(user); len(mfaMethods) > 0 {
+		challenge, err := token.CreateMfaChallenge(user.ID, roles)
+		if err != nil {
+			return res, err
+		}
+
+		res = &model.AuthResponse{
+			Message:    `Please verify second factor to complete the login`,
+			MfaToken:   &challenge.Token,
+			MfaMethods: mfaMethods,
+		}
+		return res, nil
+	}
+
+	authToken, err := token.CreateAuthToken(user, roles, token.AuthTokenOptions{})
+	if err != nil {
+		return res, err
+	}
+	sessionstore.SetUserSession(user.ID, authToken.FingerPrint, authToken.RefreshToken.Token)
+	cookie.SetCookie(gc, authToken.AccessToken.Token, authToken.RefreshToken.Token, authToken.FingerPrintHash, authToken.AccessToken.ExpiresAt, authToken.RefreshToken.ExpiresAt)
+	utils.SaveSessionInDB(user.ID, authToken.FamilyID, gc)
+	webhook.Trigger(constants.WebhookEventUserLogin, user)
+
+	res = &model.AuthResponse{
+		Message:     `Logged in successfully`,
+		AccessToken: &authToken.AccessToken.Token,
+		IDToken:     &authToken.IDToken.Token,
+		ExpiresAt:   &authToken.AccessToken.ExpiresAt,
+		User:        user.AsAPIUser(),
+	}
+
+	return res, nil
+}
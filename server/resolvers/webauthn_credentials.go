@@ -0,0 +1,38 @@
+package resolvers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/authorizerdev/authorizer/server/db"
+	"github.com/authorizerdev/authorizer/server/graph/model"
+	"github.com/authorizerdev/authorizer/server/token"
+	"github.com/authorizerdev/authorizer/server/utils"
+)
+
+// WebauthnCredentialsResolver is a resolver for webauthn credentials query
+// It returns the passkeys of logged in user
+func WebauthnCredentialsResolver(ctx context.Context) ([]*model.WebauthnCredential, error) {
+	gc, err := utils.GinContextFromContext(ctx)
+	var res []*model.WebauthnCredential
+	if err != nil {
+		return res, err
+	}
+
+	claims, err := token.ValidateAccessToken(gc)
+	if err != nil {
+		return res, err
+	}
+
+	credentials, err := db.Provider.ListWebauthnCredentialsByUserID(fmt.Sprintf("%v", claims["id"]))
+	if err != nil {
+		return res, err
+	}
+
+	res = []*model.WebauthnCredential{}
+	for _, credential := range credentials {
+		res = append(res, credential.AsAPIWebauthnCredential())
+	}
+
+	return res, nil
+}
@@ -0,0 +1,151 @@
+package resolvers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/authorizerdev/authorizer/server/audit"
+	"github.com/authorizerdev/authorizer/server/constants"
+	"github.com/authorizerdev/authorizer/server/cookie"
+	"github.com/authorizerdev/authorizer/server/db"
+	"github.com/authorizerdev/authorizer/server/envstore"
+	"github.com/authorizerdev/authorizer/server/graph/model"
+	"github.com/authorizerdev/authorizer/server/ratelimit"
+	"github.com/authorizerdev/authorizer/server/sessionstore"
+	"github.com/authorizerdev/authorizer/server/token"
+	"github.com/authorizerdev/authorizer/server/utils"
+	"github.com/authorizerdev/authorizer/server/webauthn"
+	"github.com/authorizerdev/authorizer/server/webhook"
+)
+
+// WebauthnLoginResolver is a resolver for webauthn login mutation
+// It verifies the response of navigator.credentials.get and logs in the user,
+// either as passwordless login or as second factor of login with mfa token
+func WebauthnLoginResolver(ctx context.Context, params model.WebauthnLoginInput) (*model.AuthResponse, error) {
+	gc, err := utils.GinContextFromContext(ctx)
+	var res *model.AuthResponse
+	if err != nil {
+		return res, err
+	}
+
+	if envstore.EnvInMemoryStoreObj.GetBoolStoreEnvVariable(constants.EnvKeyDisableWebauthn) {
+		return res, fmt.Errorf(`webauthn is disabled for this instance`)
+	}
+
+	if err := ratelimit.Check(constants.RateLimitActionWebauthnLogin, "", utils.GetIP(gc.Request)); err != nil {
+		return res, err
+	}
+
+	clientDataJSON, err := webauthn.DecodeString(params.ClientDataJSON)
+	if err != nil {
+		return res, fmt.Errorf(`invalid client data`)
+	}
+
+	authenticatorData, err := webauthn.DecodeString(params.AuthenticatorData)
+	if err != nil {
+		return res, fmt.Errorf(`invalid authenticator data`)
+	}
+
+	signature, err := webauthn.DecodeString(params.Signature)
+	if err != nil {
+		return res, fmt.Errorf(`invalid signature`)
+	}
+
+	clientData, err := webauthn.ParseClientData(clientDataJSON)
+	if err != nil {
+		return res, err
+	}
+
+	challenge, err := token.ConsumeWebauthnChallenge(clientData.Challenge, token.WebauthnChallengeTypeLogin)
+	if err != nil {
+		return res, err
+	}
+
+	var mfaChallenge *token.MfaChallenge
+	if challenge.MfaToken != "" {
+		mfaChallenge, err = token.GetMfaChallenge(challenge.MfaToken)
+		if err != nil {
+			return res, err
+		}
+	}
+
+	credential, err := db.Provider.GetWebauthnCredentialByCredentialID(strings.TrimRight(params.ID, "="))
+	if err != nil {
+		return res, fmt.Errorf(`passkey not found`)
+	}
+
+	if challenge.UserID != "" && challenge.UserID != credential.UserID {
+		return res, fmt.Errorf(`passkey not found`)
+	}
+
+	// only the passkeys enabled as second factor can complete the password login
+	if mfaChallenge != nil && !credential.MfaEnabled {
+		return res, fmt.Errorf(`passkey not found`)
+	}
+
+	if params.UserHandle != nil && *params.UserHandle != "" && *params.UserHandle != webauthn.EncodeToString([]byte(credential.UserID)) {
+		return res, fmt.Errorf(`invalid user handle`)
+	}
+
+	publicKey, err := webauthn.DecodeString(credential.PublicKey)
+	if err != nil {
+		return res, err
+	}
+
+	signCount, err := webauthn.VerifyAssertion(webauthnRelyingPartyID(), webauthnOrigin(), challenge.Challenge, publicKey, uint32(credential.SignCount), mfaChallenge == nil, clientDataJSON, authenticatorData, signature)
+	if err != nil {
+		if mfaChallenge != nil && token.RecordMfaChallengeFailedAttempt(mfaChallenge) {
+			return res, fmt.Errorf(`too many invalid attempts, please login again`)
+		}
+		return res, err
+	}
+
+	lastUsedAt := time.Now().Unix()
+	credential.SignCount = int64(signCount)
+	credential.LastUsedAt = &lastUsedAt
+	_, err = db.Provider.UpdateWebauthnCredential(credential)
+	if err != nil {
+		return res, err
+	}
+
+	user, err := db.Provider.GetUserByID(credential.UserID)
+	if err != nil {
+		return res, err
+	}
+	audit.SetActor(ctx, constants.AuditActorTypeUser, user.ID, user.Email)
+
+	roles := envstore.EnvInMemoryStoreObj.GetSliceStoreEnvVariable(constants.EnvKeyDefaultRoles)
+	options := token.AuthTokenOptions{}
+	if mfaChallenge != nil {
+		token.RemoveMfaChallenge(mfaChallenge)
+		roles = mfaChallenge.Roles
+		options.AuthTime = mfaChallenge.AuthTime
+	} else if len(params.Roles) > 0 {
+		if !utils.IsValidRoles(strings.Split(user.Roles, ","), params.Roles) {
+			return res, fmt.Errorf(`invalid roles`)
+		}
+
+		roles = params.Roles
+	}
+
+	authToken, err := token.CreateAuthToken(user, roles, options)
+	if err != nil {
+		return res, err
+	}
+	sessionstore.SetUserSession(user.ID, authToken.FingerPrint, authToken.RefreshToken.Token)
+	cookie.SetCookie(gc, authToken.AccessToken.Token, authToken.RefreshToken.Token, authToken.FingerPrintHash, authToken.AccessToken.ExpiresAt, authToken.RefreshToken.ExpiresAt)
+	utils.SaveSessionInDB(user.ID, authToken.FamilyID, gc)
+	webhook.Trigger(constants.WebhookEventUserLogin, user)
+
+	res = &model.AuthResponse{
+		Message:     `Logged in successfully`,
+		AccessToken: &authToken.AccessToken.Token,
+		IDToken:     &authToken.IDToken.Token,
+		ExpiresAt:   &authToken.AccessToken.ExpiresAt,
+		User:        user.AsAPIUser(),
+	}
+
+	return res, nil
+}
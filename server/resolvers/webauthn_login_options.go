@@ -0,0 +1,80 @@
+package resolvers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/authorizerdev/authorizer/server/constants"
+	"github.com/authorizerdev/authorizer/server/db"
+	"github.com/authorizerdev/authorizer/server/db/models"
+	"github.com/authorizerdev/authorizer/server/envstore"
+	"github.com/authorizerdev/authorizer/server/graph/model"
+	"github.com/authorizerdev/authorizer/server/token"
+	"github.com/authorizerdev/authorizer/server/utils"
+)
+
+// WebauthnLoginOptionsResolver is a resolver for webauthn login options mutation
+// It returns the options for navigator.credentials.get, either for passwordless login
+// or for second factor when mfa token is present
+func WebauthnLoginOptionsResolver(ctx context.Context, params *model.WebauthnLoginOptionsInput) (*model.WebauthnOptionsResponse, error) {
+	var res *model.WebauthnOptionsResponse
+
+	if envstore.EnvInMemoryStoreObj.GetBoolStoreEnvVariable(constants.EnvKeyDisableWebauthn) {
+		return res, fmt.Errorf(`webauthn is disabled for this instance`)
+	}
+
+	userID := ""
+	mfaToken := ""
+	// user verification (pin / biometric) is the second factor in passwordless login
+	userVerification := "required"
+	if params != nil && params.MfaToken != nil && *params.MfaToken != "" {
+		mfaChallenge, err := token.GetMfaChallenge(*params.MfaToken)
+		if err != nil {
+			return res, err
+		}
+		userID = mfaChallenge.UserID
+		mfaToken = mfaChallenge.Token
+		userVerification = "preferred"
+	} else if params != nil && params.Email != nil && *params.Email != "" {
+		user, err := db.Provider.GetUserByEmail(strings.ToLower(*params.Email))
+		if err != nil {
+			return res, fmt.Errorf(`user with this email not found`)
+		}
+		userID = user.ID
+	}
+
+	credentials := []models.WebauthnCredential{}
+	if userID != "" {
+		var err error
+		credentials, err = db.Provider.ListWebauthnCredentialsByUserID(userID)
+		if err != nil {
+			return res, err
+		}
+
+		if mfaToken != "" {
+			credentials = utils.GetMfaWebauthnCredentials(credentials)
+		}
+
+		if len(credentials) == 0 {
+			return res, fmt.Errorf(`user has no passkeys`)
+		}
+	}
+
+	challenge, err := token.CreateWebauthnChallenge(token.WebauthnChallengeTypeLogin, userID, mfaToken)
+	if err != nil {
+		return res, err
+	}
+
+	res = &model.WebauthnOptionsResponse{
+		Options: map[string]interface{}{
+			"challenge":        challenge.Challenge,
+			"rpId":             webauthnRelyingPartyID(),
+			"timeout":          token.WebauthnChallengeExpiry.Milliseconds(),
+			"userVerification": userVerification,
+			"allowCredentials": webauthnCredentialDescriptors(credentials),
+		},
+	}
+
+	return res, nil
+}
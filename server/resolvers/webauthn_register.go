@@ -0,0 +1,97 @@
+package resolvers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/authorizerdev/authorizer/server/constants"
+	"github.com/authorizerdev/authorizer/server/db"
+	"github.com/authorizerdev/authorizer/server/db/models"
+	"github.com/authorizerdev/authorizer/server/envstore"
+	"github.com/authorizerdev/authorizer/server/graph/model"
+	"github.com/authorizerdev/authorizer/server/token"
+	"github.com/authorizerdev/authorizer/server/utils"
+	"github.com/authorizerdev/authorizer/server/webauthn"
+)
+
+// WebauthnRegisterResolver is a resolver for webauthn register mutation
+// It verifies the response of navigator.credentials.create and saves the passkey of logged in user
+func WebauthnRegisterResolver(ctx context.Context, params model.WebauthnRegisterInput) (*model.WebauthnCredential, error) {
+	gc, err := utils.GinContextFromContext(ctx)
+	var res *model.WebauthnCredential
+	if err != nil {
+		return res, err
+	}
+
+	if envstore.EnvInMemoryStoreObj.GetBoolStoreEnvVariable(constants.EnvKeyDisableWebauthn) {
+		return res, fmt.Errorf(`webauthn is disabled for this instance`)
+	}
+
+	claims, err := token.ValidateAccessToken(gc)
+	if err != nil {
+		return res, err
+	}
+
+	user, err := db.Provider.GetUserByID(fmt.Sprintf("%v", claims["id"]))
+	if err != nil {
+		return res, err
+	}
+
+	clientDataJSON, err := webauthn.DecodeString(params.ClientDataJSON)
+	if err != nil {
+		return res, fmt.Errorf(`invalid client data`)
+	}
+
+	attestationObject, err := webauthn.DecodeString(params.AttestationObject)
+	if err != nil {
+		return res, fmt.Errorf(`invalid attestation object`)
+	}
+
+	clientData, err := webauthn.ParseClientData(clientDataJSON)
+	if err != nil {
+		return res, err
+	}
+
+	challenge, err := token.ConsumeWebauthnChallenge(clientData.Challenge, token.WebauthnChallengeTypeRegistration)
+	if err != nil {
+		return res, err
+	}
+
+	if challenge.UserID != user.ID {
+		return res, fmt.Errorf(`invalid challenge`)
+	}
+
+	credential, err := webauthn.VerifyRegistration(webauthnRelyingPartyID(), webauthnOrigin(), challenge.Challenge, clientDataJSON, attestationObject)
+	if err != nil {
+		return res, err
+	}
+
+	credentialID := webauthn.EncodeToString(credential.ID)
+	if credentialID != strings.TrimRight(params.ID, "=") {
+		return res, fmt.Errorf(`credential id mismatch`)
+	}
+
+	if _, err := db.Provider.GetWebauthnCredentialByCredentialID(credentialID); err == nil {
+		return res, fmt.Errorf(`credential is already registered`)
+	}
+
+	name := "Passkey"
+	if params.Name != nil && strings.TrimSpace(*params.Name) != "" {
+		name = strings.TrimSpace(*params.Name)
+	}
+
+	webauthnCredential, err := db.Provider.AddWebauthnCredential(models.WebauthnCredential{
+		UserID:       user.ID,
+		CredentialID: credentialID,
+		PublicKey:    webauthn.EncodeToString(credential.PublicKey),
+		SignCount:    int64(credential.SignCount),
+		Name:         name,
+		MfaEnabled:   params.MfaEnabled != nil && *params.MfaEnabled,
+	})
+	if err != nil {
+		return res, err
+	}
+
+	return webauthnCredential.AsAPIWebauthnCredential(), nil
+}
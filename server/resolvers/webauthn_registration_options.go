@@ -0,0 +1,117 @@
+package resolvers
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/authorizerdev/authorizer/server/constants"
+	"github.com/authorizerdev/authorizer/server/db"
+	"github.com/authorizerdev/authorizer/server/db/models"
+	"github.com/authorizerdev/authorizer/server/envstore"
+	"github.com/authorizerdev/authorizer/server/graph/model"
+	"github.com/authorizerdev/authorizer/server/token"
+	"github.com/authorizerdev/authorizer/server/utils"
+	"github.com/authorizerdev/authorizer/server/webauthn"
+)
+
+// WebauthnRegistrationOptionsResolver is a resolver for webauthn registration options mutation
+// It returns the options for navigator.credentials.create to register new passkey for logged in user
+func WebauthnRegistrationOptionsResolver(ctx context.Context) (*model.WebauthnOptionsResponse, error) {
+	gc, err := utils.GinContextFromContext(ctx)
+	var res *model.WebauthnOptionsResponse
+	if err != nil {
+		return res, err
+	}
+
+	if envstore.EnvInMemoryStoreObj.GetBoolStoreEnvVariable(constants.EnvKeyDisableWebauthn) {
+		return res, fmt.Errorf(`webauthn is disabled for this instance`)
+	}
+
+	claims, err := token.ValidateAccessToken(gc)
+	if err != nil {
+		return res, err
+	}
+
+	user, err := db.Provider.GetUserByID(fmt.Sprintf("%v", claims["id"]))
+	if err != nil {
+		return res, err
+	}
+
+	credentials, err := db.Provider.ListWebauthnCredentialsByUserID(user.ID)
+	if err != nil {
+		return res, err
+	}
+
+	challenge, err := token.CreateWebauthnChallenge(token.WebauthnChallengeTypeRegistration, user.ID, "")
+	if err != nil {
+		return res, err
+	}
+
+	pubKeyCredParams := []map[string]interface{}{}
+	for _, alg := range webauthn.SupportedAlgorithms {
+		pubKeyCredParams = append(pubKeyCredParams, map[string]interface{}{
+			"type": "public-key",
+			"alg":  alg,
+		})
+	}
+
+	displayName := user.Email
+	if user.GivenName != nil && *user.GivenName != "" {
+		displayName = *user.GivenName
+	}
+
+	res = &model.WebauthnOptionsResponse{
+		Options: map[string]interface{}{
+			"challenge": challenge.Challenge,
+			"rp": map[string]interface{}{
+				"id":   webauthnRelyingPartyID(),
+				"name": envstore.EnvInMemoryStoreObj.GetStringStoreEnvVariable(constants.EnvKeyOrganizationName),
+			},
+			"user": map[string]interface{}{
+				"id":          webauthn.EncodeToString([]byte(user.ID)),
+				"name":        user.Email,
+				"displayName": displayName,
+			},
+			"pubKeyCredParams":   pubKeyCredParams,
+			"timeout":            token.WebauthnChallengeExpiry.Milliseconds(),
+			"attestation":        "none",
+			"excludeCredentials": webauthnCredentialDescriptors(credentials),
+			"authenticatorSelection": map[string]interface{}{
+				"residentKey":      "preferred",
+				"userVerification": "preferred",
+			},
+		},
+	}
+
+	return res, nil
+}
+
+// webauthnRelyingPartyID returns the relying party id of passkeys, i.e. the host of AUTHORIZER_URL
+func webauthnRelyingPartyID() string {
+	host, _ := utils.GetHostParts(envstore.EnvInMemoryStoreObj.GetStringStoreEnvVariable(constants.EnvKeyAuthorizerURL))
+	return host
+}
+
+// webauthnOrigin returns the only origin allowed in passkey ceremonies, i.e. the origin of AUTHORIZER_URL
+func webauthnOrigin() string {
+	u, err := url.Parse(envstore.EnvInMemoryStoreObj.GetStringStoreEnvVariable(constants.EnvKeyAuthorizerURL))
+	if err != nil || u.Scheme == "" || u.Host == "" {
+		return ""
+	}
+
+	return u.Scheme + "://" + u.Host
+}
+
+// webauthnCredentialDescriptors returns the PublicKeyCredentialDescriptor list of given credentials
+func webauthnCredentialDescriptors(credentials []models.WebauthnCredential) []map[string]interface{} {
+	descriptors := []map[string]interface{}{}
+	for _, credential := range credentials {
+		descriptors = append(descriptors, map[string]interface{}{
+			"type": "public-key",
+			"id":   credential.CredentialID,
+		})
+	}
+
+	return descriptors
+}
@@ -0,0 +1,42 @@
+package resolvers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/authorizerdev/authorizer/server/db"
+	"github.com/authorizerdev/authorizer/server/graph/model"
+	"github.com/authorizerdev/authorizer/server/token"
+	"github.com/authorizerdev/authorizer/server/utils"
+)
+
+// WebhookDeliveriesResolver is a resolver for webhook deliveries query
+// This is admin only query
+func WebhookDeliveriesResolver(ctx context.Context, params *model.WebhookDeliveriesInput) (*model.WebhookDeliveries, error) {
+	gc, err := utils.GinContextFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if !token.IsSuperAdmin(gc) {
+		return nil, fmt.Errorf("unauthorized")
+	}
+
+	webhookID := ""
+	paginatedInput := &model.PaginatedInput{}
+	if params != nil {
+		paginatedInput.Pagination = params.Pagination
+		if params.WebhookID != nil {
+			webhookID = *params.WebhookID
+		}
+	}
+
+	pagination := utils.GetPagination(paginatedInput)
+
+	res, err := db.Provider.ListWebhookDeliveries(pagination, webhookID)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
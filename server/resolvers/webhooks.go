@@ -0,0 +1,33 @@
+package resolvers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/authorizerdev/authorizer/server/db"
+	"github.com/authorizerdev/authorizer/server/graph/model"
+	"github.com/authorizerdev/authorizer/server/token"
+	"github.com/authorizerdev/authorizer/server/utils"
+)
+
+// WebhooksResolver is a resolver for webhooks query
+// This is admin only query
+func WebhooksResolver(ctx context.Context, params *model.PaginatedInput) (*model.Webhooks, error) {
+	gc, err := utils.GinContextFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if !token.IsSuperAdmin(gc) {
+		return nil, fmt.Errorf("unauthorized")
+	}
+
+	pagination := utils.GetPagination(params)
+
+	res, err := db.Provider.ListWebhooks(pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
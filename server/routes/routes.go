@@ -1,6 +1,7 @@
 package routes
 
 import (
+	"github.com/authorizerdev/authorizer/server/constants"
 	"github.com/authorizerdev/authorizer/server/handlers"
 	"github.com/authorizerdev/authorizer/server/middlewares"
 	"github.com/gin-contrib/location"
@@ -17,9 +18,19 @@ func InitRouter() *gin.Engine {
 	router.GET("/", handlers.RootHandler())
 	router.POST("/graphql", handlers.GraphqlHandler())
 	router.GET("/playground", handlers.PlaygroundHandler())
-	router.GET("/oauth_login/:oauth_provider", handlers.OAuthLoginHandler())
-	router.GET("/oauth_callback/:oauth_provider", handlers.OAuthCallbackHandler())
-	router.GET("/verify_email", handlers.VerifyEmailHandler())
+	router.GET("/oauth_login/:oauth_provider", middlewares.AuditMiddleware(constants.AuditActionOAuthLogin), handlers.OAuthLoginHandler())
+	router.GET("/oauth_callback/:oauth_provider", middlewares.AuditMiddleware(constants.AuditActionOAuthCallback), handlers.OAuthCallbackHandler())
+	// apple posts the callback with form_post response mode
+	router.POST("/oauth_callback/:oauth_provider", middlewares.AuditMiddleware(constants.AuditActionOAuthCallback), handlers.OAuthCallbackHandler())
+	router.GET("/verify_email", middlewares.AuditMiddleware(constants.AuditActionVerifyEmailLink), handlers.VerifyEmailHandler())
+	router.GET("/authorize", middlewares.AuditMiddleware(constants.AuditActionAuthorize), handlers.AuthorizeHandler())
+	router.POST("/oauth/token", middlewares.AuditMiddleware(constants.AuditActionOAuthToken), handlers.OAuthTokenHandler())
+	router.POST("/oauth/device_authorization", middlewares.AuditMiddleware(constants.AuditActionDeviceAuthorization), handlers.DeviceAuthorizationHandler())
+	router.POST("/oauth/introspect", middlewares.AuditMiddleware(constants.AuditActionOAuthIntrospect), handlers.IntrospectHandler())
+	router.POST("/oauth/revoke", middlewares.AuditMiddleware(constants.AuditActionOAuthRevoke), handlers.RevokeHandler())
+	router.GET("/userinfo", middlewares.AuditMiddleware(constants.AuditActionUserInfo), handlers.UserInfoHandler())
+	router.GET("/.well-known/openid-configuration", handlers.OpenIDConfigurationHandler())
+	router.GET("/.well-known/jwks.json", handlers.JWKsHandler())
 
 	router.LoadHTMLGlob("templates/*")
 	// login page app related routes.
@@ -28,6 +39,7 @@ func InitRouter() *gin.Engine {
 		app.Static("/build", "app/build")
 		app.GET("/", handlers.AppHandler())
 		app.GET("/reset-password", handlers.AppHandler())
+		app.GET("/device", handlers.AppHandler())
 	}
 
 	// dashboard related routes
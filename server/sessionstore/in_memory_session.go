@@ -1,14 +1,23 @@
 package sessionstore
 
 import (
+	"strconv"
 	"sync"
+	"time"
 )
 
+// stateValue is the value of state with its expiry time
+type stateValue struct {
+	value     string
+	expiresAt time.Time
+}
+
 // InMemoryStore is a simple in-memory store for sessions.
 type InMemoryStore struct {
 	mutex            sync.Mutex
 	store            map[string]map[string]string
 	socialLoginState map[string]string
+	state            map[string]stateValue
 }
 
 // AddUserSession adds a user session to the in-memory store.
@@ -110,3 +119,84 @@ func (c *InMemoryStore) RemoveSocialLoginState(key string) {
 
 	delete(c.socialLoginState, key)
 }
+
+// SetState sets the state in the in-memory store, which expires after given duration.
+func (c *InMemoryStore) SetState(key, value string, expiresIn time.Duration) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	// remove expired states
+	now := time.Now()
+	for k, v := range c.state {
+		if v.expiresAt.Before(now) {
+			delete(c.state, k)
+		}
+	}
+
+	c.state[key] = stateValue{
+		value:     value,
+		expiresAt: now.Add(expiresIn),
+	}
+}
+
+// GetState gets the state from the in-memory store.
+func (c *InMemoryStore) GetState(key string) string {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	state, ok := c.state[key]
+	if !ok || state.expiresAt.Before(time.Now()) {
+		return ""
+	}
+
+	return state.value
+}
+
+// IncrementState increments the counter state in the in-memory store and returns the new value.
+// Expiry is set only when the counter is created, i.e. the counter is reset after the window.
+func (c *InMemoryStore) IncrementState(key string, expiresIn time.Duration) (int64, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	now := time.Now()
+	state, ok := c.state[key]
+	if !ok || state.expiresAt.Before(now) {
+		state = stateValue{
+			value:     "0",
+			expiresAt: now.Add(expiresIn),
+		}
+	}
+
+	count, _ := strconv.ParseInt(state.value, 10, 64)
+	count++
+	state.value = strconv.FormatInt(count, 10)
+	c.state[key] = state
+
+	return count, nil
+}
+
+// PopState gets & removes the state from the in-memory store in single step.
+func (c *InMemoryStore) PopState(key string) string {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	state, ok := c.state[key]
+	if !ok {
+		return ""
+	}
+
+	delete(c.state, key)
+	if state.expiresAt.Before(time.Now()) {
+		return ""
+	}
+
+	return state.value
+}
+
+// RemoveState removes the state from the in-memory store.
+func (c *InMemoryStore) RemoveState(key string) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	delete(c.state, key)
+}
@@ -4,10 +4,21 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/go-redis/redis/v8"
 )
 
+// incrementStateScript increments the counter and sets its expiry in single step,
+// expiry is also set in case the counter was left without it
+var incrementStateScript = redis.NewScript(`
+local count = redis.call("INCR", KEYS[1])
+if count == 1 or redis.call("PTTL", KEYS[1]) < 0 then
+	redis.call("PEXPIRE", KEYS[1], ARGV[1])
+end
+return count
+`)
+
 type RedisStore struct {
 	ctx   context.Context
 	store *redis.Client
@@ -96,3 +107,57 @@ func (c *RedisStore) RemoveSocialLoginState(key string) {
 		log.Fatalln("Error deleting redis token:", err)
 	}
 }
+
+// SetState sets the state in redis store, which expires after given duration.
+func (c *RedisStore) SetState(key, value string, expiresIn time.Duration) {
+	err := c.store.Set(c.ctx, "authorizer_state_"+key, value, expiresIn).Err()
+	if err != nil {
+		log.Fatalln("Error saving redis state:", err)
+	}
+}
+
+// GetState gets the state from redis store.
+func (c *RedisStore) GetState(key string) string {
+	state, err := c.store.Get(c.ctx, "authorizer_state_"+key).Result()
+	if err != nil && err != redis.Nil {
+		log.Println("error getting state from redis store:", err)
+	}
+
+	return state
+}
+
+// IncrementState increments the counter state in redis store and returns the new value.
+// Expiry is set only when the counter is created, i.e. the counter is reset after the window.
+func (c *RedisStore) IncrementState(key string, expiresIn time.Duration) (int64, error) {
+	count, err := incrementStateScript.Run(c.ctx, c.store, []string{"authorizer_state_" + key}, expiresIn.Milliseconds()).Int64()
+	if err != nil {
+		log.Println("error incrementing redis state:", err)
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// PopState gets & removes the state from redis store in single transaction.
+func (c *RedisStore) PopState(key string) string {
+	var state *redis.StringCmd
+	_, err := c.store.TxPipelined(c.ctx, func(pipe redis.Pipeliner) error {
+		state = pipe.Get(c.ctx, "authorizer_state_"+key)
+		pipe.Del(c.ctx, "authorizer_state_"+key)
+		return nil
+	})
+	if err != nil && err != redis.Nil {
+		log.Println("error popping state from redis store:", err)
+		return ""
+	}
+
+	return state.Val()
+}
+
+// RemoveState removes the state from redis store.
+func (c *RedisStore) RemoveState(key string) {
+	err := c.store.Del(c.ctx, "authorizer_state_"+key).Err()
+	if err != nil {
+		log.Fatalln("Error deleting redis state:", err)
+	}
+}
@@ -2,7 +2,9 @@ package sessionstore
 
 import (
 	"context"
+	"fmt"
 	"log"
+	"time"
 
 	"github.com/authorizerdev/authorizer/server/constants"
 	"github.com/authorizerdev/authorizer/server/envstore"
@@ -117,6 +119,66 @@ func RemoveSocialLoginState(key string) {
 	}
 }
 
+// SetState sets the state in the session store, which expires after given duration.
+// It is used for short lived data like authorization codes
+func SetState(key, value string, expiresIn time.Duration) {
+	if SessionStoreObj.RedisMemoryStoreObj != nil {
+		SessionStoreObj.RedisMemoryStoreObj.SetState(key, value, expiresIn)
+	}
+	if SessionStoreObj.InMemoryStoreObj != nil {
+		SessionStoreObj.InMemoryStoreObj.SetState(key, value, expiresIn)
+	}
+}
+
+// GetState returns the state from the session store
+func GetState(key string) string {
+	if SessionStoreObj.RedisMemoryStoreObj != nil {
+		return SessionStoreObj.RedisMemoryStoreObj.GetState(key)
+	}
+	if SessionStoreObj.InMemoryStoreObj != nil {
+		return SessionStoreObj.InMemoryStoreObj.GetState(key)
+	}
+
+	return ""
+}
+
+// IncrementState increments the counter state in the session store and returns the new value.
+// It is used for rate limiting, counter expires after given duration from its creation.
+// Callers should treat the error as limit reached, so that they fail closed
+func IncrementState(key string, expiresIn time.Duration) (int64, error) {
+	if SessionStoreObj.RedisMemoryStoreObj != nil {
+		return SessionStoreObj.RedisMemoryStoreObj.IncrementState(key, expiresIn)
+	}
+	if SessionStoreObj.InMemoryStoreObj != nil {
+		return SessionStoreObj.InMemoryStoreObj.IncrementState(key, expiresIn)
+	}
+
+	return 0, fmt.Errorf("session store is not initialized")
+}
+
+// PopState returns & removes the state from the session store atomically.
+// It is used for single use data like authorization codes
+func PopState(key string) string {
+	if SessionStoreObj.RedisMemoryStoreObj != nil {
+		return SessionStoreObj.RedisMemoryStoreObj.PopState(key)
+	}
+	if SessionStoreObj.InMemoryStoreObj != nil {
+		return SessionStoreObj.InMemoryStoreObj.PopState(key)
+	}
+
+	return ""
+}
+
+// RemoveState removes the state from the session store
+func RemoveState(key string) {
+	if SessionStoreObj.RedisMemoryStoreObj != nil {
+		SessionStoreObj.RedisMemoryStoreObj.RemoveState(key)
+	}
+	if SessionStoreObj.InMemoryStoreObj != nil {
+		SessionStoreObj.InMemoryStoreObj.RemoveState(key)
+	}
+}
+
 // InitializeSessionStore initializes the SessionStoreObj based on environment variables
 func InitSession() {
 	if envstore.EnvInMemoryStoreObj.GetStringStoreEnvVariable(constants.EnvKeyRedisURL) != "" {
@@ -142,6 +204,7 @@ func InitSession() {
 		SessionStoreObj.InMemoryStoreObj = &InMemoryStore{
 			store:            map[string]map[string]string{},
 			socialLoginState: map[string]string{},
+			state:            map[string]stateValue{},
 		}
 	}
 }
@@ -0,0 +1,41 @@
+package sms
+
+import (
+	"log"
+	"sync"
+)
+
+// defaultLocalSender is used in development when no sms provider is configured
+var defaultLocalSender = NewLocalSender()
+
+// LocalSender is a stand-in sender for development & tests.
+// It logs the messages and keeps the last message of each phone number in memory
+type LocalSender struct {
+	mutex    sync.Mutex
+	messages map[string]string
+}
+
+// NewLocalSender returns new local sender
+func NewLocalSender() *LocalSender {
+	return &LocalSender{
+		messages: map[string]string{},
+	}
+}
+
+// SendSMS logs the message and saves it in memory
+func (sender *LocalSender) SendSMS(to, message string) error {
+	sender.mutex.Lock()
+	defer sender.mutex.Unlock()
+
+	log.Printf("sms to %s: %s", to, message)
+	sender.messages[to] = message
+	return nil
+}
+
+// LastMessage returns the last message sent to the phone number
+func (sender *LocalSender) LastMessage(to string) string {
+	sender.mutex.Lock()
+	defer sender.mutex.Unlock()
+
+	return sender.messages[to]
+}
@@ -0,0 +1,52 @@
+package sms
+
+import (
+	"errors"
+
+	"github.com/authorizerdev/authorizer/server/constants"
+	"github.com/authorizerdev/authorizer/server/envstore"
+)
+
+// Sender is the interface to be implemented by sms providers
+type Sender interface {
+	// SendSMS sends the message to the phone number
+	SendSMS(to, message string) error
+}
+
+// Provider overrides the sender configured via env, e.g. LocalSender in tests
+var Provider Sender
+
+// GetSender returns the sender used for sending sms.
+// Webhook sender is used when SMS_WEBHOOK_URL is set, local sender is used in development
+func GetSender() (Sender, error) {
+	if Provider != nil {
+		return Provider, nil
+	}
+
+	webhookURL := envstore.EnvInMemoryStoreObj.GetStringStoreEnvVariable(constants.EnvKeySmsWebhookURL)
+	if webhookURL != "" {
+		return NewWebhookSender(webhookURL, envstore.EnvInMemoryStoreObj.GetStringStoreEnvVariable(constants.EnvKeySmsWebhookAuthorization)), nil
+	}
+
+	if envstore.EnvInMemoryStoreObj.GetStringStoreEnvVariable(constants.EnvKeyEnv) == "development" {
+		return defaultLocalSender, nil
+	}
+
+	return nil, errors.New("sms sender is not configured")
+}
+
+// IsSenderConfigured returns true if sms can be sent
+func IsSenderConfigured() bool {
+	_, err := GetSender()
+	return err == nil
+}
+
+// SendSMS sends the message to the phone number using configured sender
+func SendSMS(to, message string) error {
+	sender, err := GetSender()
+	if err != nil {
+		return err
+	}
+
+	return sender.SendSMS(to, message)
+}
@@ -0,0 +1,62 @@
+package sms
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// webhookTimeout is the timeout of sms webhook request
+const webhookTimeout = 10 * time.Second
+
+// WebhookSender sends sms by posting json {"to": "...", "message": "..."} to the webhook url,
+// which can forward it to any sms gateway
+type WebhookSender struct {
+	URL string
+	// Authorization is sent as Authorization header, if present
+	Authorization string
+	client        *http.Client
+}
+
+// NewWebhookSender returns new webhook sender
+func NewWebhookSender(url, authorization string) *WebhookSender {
+	return &WebhookSender{
+		URL:           url,
+		Authorization: authorization,
+		client:        &http.Client{Timeout: webhookTimeout},
+	}
+}
+
+// SendSMS posts the message to webhook url
+func (sender *WebhookSender) SendSMS(to, message string) error {
+	body, err := json.Marshal(map[string]string{
+		"to":      to,
+		"message": message,
+	})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, sender.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if sender.Authorization != "" {
+		req.Header.Set("Authorization", sender.Authorization)
+	}
+
+	res, err := sender.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		return fmt.Errorf("sms webhook responded with status %d", res.StatusCode)
+	}
+
+	return nil
+}
@@ -0,0 +1,92 @@
+package test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/authorizerdev/authorizer/server/constants"
+	"github.com/authorizerdev/authorizer/server/db"
+	"github.com/authorizerdev/authorizer/server/envstore"
+	"github.com/authorizerdev/authorizer/server/graph/model"
+	"github.com/authorizerdev/authorizer/server/resolvers"
+	"github.com/authorizerdev/authorizer/server/token"
+	"github.com/authorizerdev/authorizer/server/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func accessTokenScriptTests(t *testing.T, s TestSetup) {
+	t.Helper()
+	t.Run(`should run custom access token script in sandbox`, func(t *testing.T) {
+		req, ctx := createContext(s)
+		email := "script." + s.TestInfo.Email
+		_, err := resolvers.SignupResolver(ctx, model.SignUpInput{
+			Email:           email,
+			Password:        s.TestInfo.Password,
+			ConfirmPassword: s.TestInfo.Password,
+		})
+		assert.Nil(t, err)
+		user, err := db.Provider.GetUserByEmail(email)
+		assert.Nil(t, err)
+
+		_, err = resolvers.TestAccessTokenScriptResolver(ctx, model.TestAccessTokenScriptInput{
+			Email: &email,
+		})
+		assert.NotNil(t, err, "unauthorized")
+
+		h, err := utils.EncryptPassword(envstore.EnvInMemoryStoreObj.GetStringStoreEnvVariable(constants.EnvKeyAdminSecret))
+		assert.Nil(t, err)
+		req.Header.Set("Cookie", fmt.Sprintf("%s=%s", envstore.EnvInMemoryStoreObj.GetStringStoreEnvVariable(constants.EnvKeyAdminCookieName), h))
+
+		for _, invalidScript := range []string{
+			`function(user, tokenPayload) {`,
+			`42`,
+			`(function() { while (true) {} })()`,
+		} {
+			_, err = resolvers.UpdateEnvResolver(ctx, model.UpdateEnvInput{
+				CustomAccessTokenScript: &invalidScript,
+			})
+			assert.NotNil(t, err, invalidScript)
+		}
+
+		script := `function(user, tokenPayload) {
+			return { exp: 1, iat: 1, token_type: 'id_token', id: 'other', extra: user.email + tokenPayload.token_type };
+		}`
+		_, err = resolvers.UpdateEnvResolver(ctx, model.UpdateEnvInput{
+			CustomAccessTokenScript: &script,
+		})
+		assert.Nil(t, err)
+
+		res, err := resolvers.TestAccessTokenScriptResolver(ctx, model.TestAccessTokenScriptInput{
+			Email: &email,
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, email+constants.TokenTypeAccessToken, res.Claims["extra"])
+		assert.Equal(t, constants.TokenTypeAccessToken, res.Claims["token_type"])
+		assert.Equal(t, user.ID, res.Claims["id"])
+		assert.Greater(t, res.Claims["exp"], int64(1))
+
+		// saved script is used for the access tokens
+		accessToken, _, err := token.CreateAccessToken(user, []string{"user"}, token.AuthTokenOptions{})
+		assert.Nil(t, err)
+		claims, err := token.VerifyJWTToken(accessToken)
+		assert.Nil(t, err)
+		assert.Equal(t, email+constants.TokenTypeAccessToken, claims["extra"])
+		assert.Equal(t, constants.TokenTypeAccessToken, claims["token_type"])
+
+		// endless script is interrupted
+		endlessScript := `function(user, tokenPayload) { while (true) {} }`
+		_, err = resolvers.TestAccessTokenScriptResolver(ctx, model.TestAccessTokenScriptInput{
+			UserID: &user.ID,
+			Script: &endlessScript,
+		})
+		assert.NotNil(t, err)
+
+		emptyScript := ""
+		_, err = resolvers.UpdateEnvResolver(ctx, model.UpdateEnvInput{
+			CustomAccessTokenScript: &emptyScript,
+		})
+		assert.Nil(t, err)
+
+		cleanData(email)
+	})
+}
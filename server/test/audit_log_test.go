@@ -0,0 +1,201 @@
+package test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/authorizerdev/authorizer/server/audit"
+	"github.com/authorizerdev/authorizer/server/constants"
+	"github.com/authorizerdev/authorizer/server/db"
+	"github.com/authorizerdev/authorizer/server/db/models"
+	"github.com/authorizerdev/authorizer/server/envstore"
+	"github.com/authorizerdev/authorizer/server/graph/model"
+	"github.com/authorizerdev/authorizer/server/resolvers"
+	"github.com/stretchr/testify/assert"
+)
+
+// graphqlRequest sends the graphql query to test server, so that it goes through the graphql middlewares
+func graphqlRequest(s TestSetup, query string, headers map[string]string) error {
+	body, _ := json.Marshal(map[string]string{"query": query})
+	req, err := http.NewRequest(http.MethodPost, "http://"+s.Server.Listener.Addr().String()+"/graphql", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for key, value := range headers {
+		req.Header.Set(key, value)
+	}
+
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	var response struct {
+		Errors []struct {
+			Message string `json:"message"`
+		} `json:"errors"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&response); err != nil {
+		return err
+	}
+	if len(response.Errors) > 0 {
+		return fmt.Errorf(response.Errors[0].Message)
+	}
+
+	return nil
+}
+
+func auditLogTests(t *testing.T, s TestSetup) {
+	t.Helper()
+	t.Run(`should record audit events of login and env update`, func(t *testing.T) {
+		req, ctx := createContext(s)
+		email := "audit_log." + s.TestInfo.Email
+		resolvers.SignupResolver(ctx, model.SignUpInput{
+			Email:           email,
+			Password:        s.TestInfo.Password,
+			ConfirmPassword: s.TestInfo.Password,
+		})
+		verificationRequest, err := db.Provider.GetVerificationRequestByEmail(email, constants.VerificationTypeBasicAuthSignup)
+		assert.Nil(t, err)
+		_, err = resolvers.VerifyEmailResolver(ctx, model.VerifyEmailInput{
+			Token: verificationRequest.Token,
+		})
+		assert.Nil(t, err)
+		user, err := db.Provider.GetUserByEmail(email)
+		assert.Nil(t, err)
+
+		loginQuery := `mutation { login(params: {email: %q, password: %q}) { message } }`
+		err = graphqlRequest(s, fmt.Sprintf(loginQuery, email, "Wrong@123"), nil)
+		assert.NotNil(t, err)
+		err = graphqlRequest(s, fmt.Sprintf(loginQuery, email, s.TestInfo.Password), map[string]string{"User-Agent": "audit-log-test"})
+		assert.Nil(t, err)
+
+		// queries are not recorded
+		err = graphqlRequest(s, `query { session { message } }`, nil)
+		assert.NotNil(t, err)
+
+		loginRes, err := resolvers.LoginResolver(ctx, model.LoginInput{
+			Email:    email,
+			Password: s.TestInfo.Password,
+		})
+		assert.Nil(t, err)
+		userInfoReq, _ := http.NewRequest(http.MethodGet, "http://"+s.Server.Listener.Addr().String()+"/userinfo", nil)
+		userInfoReq.Header.Set("Authorization", "Bearer "+*loginRes.AccessToken)
+		userInfoRes, err := http.DefaultClient.Do(userInfoReq)
+		assert.Nil(t, err)
+		userInfoRes.Body.Close()
+		assert.Equal(t, http.StatusOK, userInfoRes.StatusCode)
+
+		adminSecret := envstore.EnvInMemoryStoreObj.GetStringStoreEnvVariable(constants.EnvKeyAdminSecret)
+		organizationName := envstore.EnvInMemoryStoreObj.GetStringStoreEnvVariable(constants.EnvKeyOrganizationName)
+		updateEnvQuery := `mutation { _update_env(params: {ORGANIZATION_NAME: %q}) { message } }`
+		err = graphqlRequest(s, fmt.Sprintf(updateEnvQuery, organizationName+" audit"), map[string]string{"x-authorizer-admin-secret": adminSecret})
+		assert.Nil(t, err)
+		err = graphqlRequest(s, fmt.Sprintf(updateEnvQuery, organizationName), map[string]string{"x-authorizer-admin-secret": adminSecret})
+		assert.Nil(t, err)
+
+		login := "login"
+		actorID := user.ID
+		_, err = resolvers.AuditLogsResolver(ctx, &model.AuditLogsInput{
+			Action:  &login,
+			ActorID: &actorID,
+		})
+		assert.NotNil(t, err, "unauthorized")
+
+		req.Header.Set("x-authorizer-admin-secret", adminSecret)
+		res, err := resolvers.AuditLogsResolver(ctx, &model.AuditLogsInput{
+			Action:  &login,
+			ActorID: &actorID,
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, int64(2), res.Pagination.Total)
+		for _, event := range res.AuditEvents {
+			assert.Equal(t, constants.AuditActorTypeUser, event.ActorType)
+			assert.Equal(t, email, *event.ActorEmail)
+			if event.Result == constants.AuditResultSuccess {
+				assert.Equal(t, "audit-log-test", *event.UserAgent)
+			} else {
+				assert.NotEmpty(t, *event.Message)
+			}
+		}
+
+		failure := constants.AuditResultFailure
+		res, err = resolvers.AuditLogsResolver(ctx, &model.AuditLogsInput{
+			ActorID: &actorID,
+			Result:  &failure,
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, int64(1), res.Pagination.Total)
+
+		session := "session"
+		res, err = resolvers.AuditLogsResolver(ctx, &model.AuditLogsInput{
+			Action: &session,
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, int64(0), res.Pagination.Total)
+
+		userInfo := constants.AuditActionUserInfo
+		res, err = resolvers.AuditLogsResolver(ctx, &model.AuditLogsInput{
+			Action:  &userInfo,
+			ActorID: &actorID,
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, int64(1), res.Pagination.Total)
+
+		updateEnv := "_update_env"
+		res, err = resolvers.AuditLogsResolver(ctx, &model.AuditLogsInput{
+			Action: &updateEnv,
+		})
+		assert.Nil(t, err)
+		assert.GreaterOrEqual(t, res.Pagination.Total, int64(2))
+		if len(res.AuditEvents) > 0 {
+			assert.Equal(t, constants.AuditActorTypeAdmin, res.AuditEvents[0].ActorType)
+			assert.Equal(t, constants.AuditTargetTypeEnv, *res.AuditEvents[0].TargetType)
+			assert.Equal(t, constants.AuditResultSuccess, res.AuditEvents[0].Result)
+			assert.Equal(t, "changed keys: "+constants.EnvKeyOrganizationName, *res.AuditEvents[0].Message)
+		}
+
+		from := time.Now().Add(time.Hour).Unix()
+		res, err = resolvers.AuditLogsResolver(ctx, &model.AuditLogsInput{
+			ActorID: &actorID,
+			From:    &from,
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, int64(0), res.Pagination.Total)
+
+		invalidResult := "unknown"
+		_, err = resolvers.AuditLogsResolver(ctx, &model.AuditLogsInput{
+			Result: &invalidResult,
+		})
+		assert.NotNil(t, err)
+
+		// events older than retention are deleted
+		oldEvent, err := db.Provider.AddAuditEvent(models.AuditEvent{
+			Action:    "audit_log_retention_test",
+			ActorType: constants.AuditActorTypeAnonymous,
+			Result:    constants.AuditResultSuccess,
+			CreatedAt: time.Now().AddDate(0, 0, -100).Unix(),
+		})
+		assert.Nil(t, err)
+		res, err = resolvers.AuditLogsResolver(ctx, &model.AuditLogsInput{
+			Action: &oldEvent.Action,
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, int64(1), res.Pagination.Total)
+		audit.DeleteExpiredEvents()
+		res, err = resolvers.AuditLogsResolver(ctx, &model.AuditLogsInput{
+			Action: &oldEvent.Action,
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, int64(0), res.Pagination.Total)
+
+		req.Header.Del("x-authorizer-admin-secret")
+		cleanData(email)
+	})
+}
@@ -0,0 +1,175 @@
+package test
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/authorizerdev/authorizer/server/constants"
+	"github.com/authorizerdev/authorizer/server/db"
+	"github.com/authorizerdev/authorizer/server/envstore"
+	"github.com/authorizerdev/authorizer/server/graph/model"
+	"github.com/authorizerdev/authorizer/server/resolvers"
+	"github.com/authorizerdev/authorizer/server/token"
+	"github.com/stretchr/testify/assert"
+)
+
+func authorizeTests(t *testing.T, s TestSetup) {
+	t.Helper()
+	t.Run(`should issue tokens with authorization code flow`, func(t *testing.T) {
+		_, ctx := createContext(s)
+		email := "authorize." + s.TestInfo.Email
+		resolvers.SignupResolver(ctx, model.SignUpInput{
+			Email:           email,
+			Password:        s.TestInfo.Password,
+			ConfirmPassword: s.TestInfo.Password,
+		})
+		verificationRequest, err := db.Provider.GetVerificationRequestByEmail(email, constants.VerificationTypeBasicAuthSignup)
+		assert.Nil(t, err)
+		verifyRes, err := resolvers.VerifyEmailResolver(ctx, model.VerifyEmailInput{
+			Token: verificationRequest.Token,
+		})
+		assert.Nil(t, err)
+
+		serverURL := "http://" + s.Server.Listener.Addr().String()
+		clientID := envstore.EnvInMemoryStoreObj.GetStringStoreEnvVariable(constants.EnvKeyClientID)
+		redirectURI := "http://localhost:3000/callback"
+		envstore.EnvInMemoryStoreObj.UpdateEnvVariable(constants.SliceStoreIdentifier, constants.EnvKeyClientRedirectURIs, []string{redirectURI})
+		defer envstore.EnvInMemoryStoreObj.UpdateEnvVariable(constants.SliceStoreIdentifier, constants.EnvKeyClientRedirectURIs, []string{})
+		codeVerifier := "test-code-verifier-with-enough-entropy-1234567890"
+		hash := sha256.Sum256([]byte(codeVerifier))
+		codeChallenge := base64.RawURLEncoding.EncodeToString(hash[:])
+
+		client := &http.Client{
+			CheckRedirect: func(req *http.Request, via []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		}
+
+		authorize := func(params url.Values) *http.Response {
+			req, _ := http.NewRequest(http.MethodGet, serverURL+"/authorize?"+params.Encode(), nil)
+			req.Header.Set("Authorization", "Bearer "+*verifyRes.AccessToken)
+			res, err := client.Do(req)
+			assert.Nil(t, err)
+			return res
+		}
+
+		exchange := func(params url.Values) (int, map[string]interface{}) {
+			res, err := http.Post(serverURL+"/oauth/token", "application/x-www-form-urlencoded", strings.NewReader(params.Encode()))
+			assert.Nil(t, err)
+			defer res.Body.Close()
+			var data map[string]interface{}
+			json.NewDecoder(res.Body).Decode(&data)
+			return res.StatusCode, data
+		}
+
+		authorizeParams := url.Values{
+			"response_type":         {"code"},
+			"client_id":             {clientID},
+			"redirect_uri":          {redirectURI},
+			"state":                 {"xyz"},
+			"nonce":                 {"test-nonce"},
+			"code_challenge":        {codeChallenge},
+			"code_challenge_method": {"S256"},
+		}
+
+		res := authorize(url.Values{
+			"response_type": {"code"},
+			"client_id":     {"invalid"},
+			"redirect_uri":  {redirectURI},
+		})
+		assert.Equal(t, http.StatusBadRequest, res.StatusCode)
+
+		// first party client needs exact match with CLIENT_REDIRECT_URIS, allowed origins are not enough
+		authorizeParams.Set("redirect_uri", "http://localhost:3000/other")
+		res = authorize(authorizeParams)
+		assert.Equal(t, http.StatusBadRequest, res.StatusCode)
+		authorizeParams.Set("redirect_uri", redirectURI)
+
+		// plain PKCE method is only allowed for clients that opted in
+		authorizeParams.Set("code_challenge_method", "plain")
+		res = authorize(authorizeParams)
+		assert.Equal(t, http.StatusFound, res.StatusCode)
+		location, err := url.Parse(res.Header.Get("Location"))
+		assert.Nil(t, err)
+		assert.Equal(t, "invalid_request", location.Query().Get("error"))
+		assert.Empty(t, location.Query().Get("code"))
+
+		// S256 is the default method
+		authorizeParams.Del("code_challenge_method")
+		res = authorize(authorizeParams)
+		assert.Equal(t, http.StatusFound, res.StatusCode)
+		location, err = url.Parse(res.Header.Get("Location"))
+		assert.Nil(t, err)
+		assert.Equal(t, "xyz", location.Query().Get("state"))
+		code := location.Query().Get("code")
+		assert.NotEmpty(t, code)
+
+		tokenParams := url.Values{
+			"grant_type":    {constants.GrantTypeAuthorizationCode},
+			"client_id":     {clientID},
+			"code":          {code},
+			"redirect_uri":  {redirectURI},
+			"code_verifier": {"invalid-verifier"},
+		}
+		status, _ := exchange(tokenParams)
+		assert.Equal(t, http.StatusBadRequest, status, "invalid code verifier")
+
+		// code is single use, so get a new one
+		res = authorize(authorizeParams)
+		location, _ = url.Parse(res.Header.Get("Location"))
+		tokenParams.Set("code", location.Query().Get("code"))
+		tokenParams.Set("code_verifier", codeVerifier)
+		status, data := exchange(tokenParams)
+		assert.Equal(t, http.StatusOK, status)
+		assert.NotEmpty(t, data["access_token"])
+		assert.NotEmpty(t, data["refresh_token"])
+
+		claims, err := token.VerifyJWTToken(data["id_token"].(string))
+		assert.Nil(t, err)
+		assert.Equal(t, "test-nonce", claims["nonce"])
+		assert.Equal(t, verifyRes.User.ID, claims["sub"])
+
+		status, _ = exchange(tokenParams)
+		assert.Equal(t, http.StatusBadRequest, status, "code should not be reused")
+
+		// parallel redemptions of the same code, only one of them gets the tokens
+		res = authorize(authorizeParams)
+		location, _ = url.Parse(res.Header.Get("Location"))
+		tokenParams.Set("code", location.Query().Get("code"))
+		statuses := make(chan int, 5)
+		for i := 0; i < 5; i++ {
+			go func() {
+				status, _ := exchange(tokenParams)
+				statuses <- status
+			}()
+		}
+		successCount := 0
+		for i := 0; i < 5; i++ {
+			if <-statuses == http.StatusOK {
+				successCount++
+			}
+		}
+		assert.Equal(t, 1, successCount)
+
+		refreshParams := url.Values{
+			"grant_type":    {constants.GrantTypeRefreshToken},
+			"client_id":     {clientID},
+			"refresh_token": {data["refresh_token"].(string)},
+		}
+		status, refreshData := exchange(refreshParams)
+		assert.Equal(t, http.StatusOK, status)
+		assert.NotEmpty(t, refreshData["access_token"])
+
+		// rotated refresh token within grace period gets the latest refresh token instead of a new one
+		status, parallelRefreshData := exchange(refreshParams)
+		assert.Equal(t, http.StatusOK, status)
+		assert.Equal(t, refreshData["refresh_token"], parallelRefreshData["refresh_token"])
+
+		cleanData(email)
+	})
+}
@@ -0,0 +1,318 @@
+package test
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/authorizerdev/authorizer/server/constants"
+	"github.com/authorizerdev/authorizer/server/db"
+	"github.com/authorizerdev/authorizer/server/envstore"
+	"github.com/authorizerdev/authorizer/server/graph/model"
+	"github.com/authorizerdev/authorizer/server/resolvers"
+	"github.com/authorizerdev/authorizer/server/token"
+	"github.com/authorizerdev/authorizer/server/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func clientTests(t *testing.T, s TestSetup) {
+	t.Helper()
+	t.Run(`should manage oauth clients`, func(t *testing.T) {
+		req, ctx := createContext(s)
+		redirectURI := "https://client.example.com/callback"
+		_, err := resolvers.AddClientResolver(ctx, model.AddClientInput{
+			Name:         "test client",
+			RedirectUris: []string{redirectURI},
+		})
+		assert.NotNil(t, err, "unauthorized")
+
+		h, err := utils.EncryptPassword(envstore.EnvInMemoryStoreObj.GetStringStoreEnvVariable(constants.EnvKeyAdminSecret))
+		assert.Nil(t, err)
+		req.Header.Set("Cookie", fmt.Sprintf("%s=%s", envstore.EnvInMemoryStoreObj.GetStringStoreEnvVariable(constants.EnvKeyAdminCookieName), h))
+
+		_, err = resolvers.AddClientResolver(ctx, model.AddClientInput{
+			Name:         "test client",
+			RedirectUris: []string{"https://client.example.com/callback#fragment"},
+		})
+		assert.NotNil(t, err, "invalid redirect uri")
+
+		_, err = resolvers.AddClientResolver(ctx, model.AddClientInput{
+			Name:         "test client",
+			RedirectUris: []string{"https://*.example.com/callback"},
+		})
+		assert.NotNil(t, err, "wildcard redirect uri")
+
+		_, err = resolvers.AddClientResolver(ctx, model.AddClientInput{
+			Name:         "test client",
+			RedirectUris: []string{},
+		})
+		assert.NotNil(t, err, "redirect uri is required")
+
+		_, err = resolvers.AddClientResolver(ctx, model.AddClientInput{
+			Name:         "test client",
+			RedirectUris: []string{redirectURI},
+			GrantTypes:   []string{"password"},
+		})
+		assert.NotNil(t, err, "invalid grant type")
+
+		accessTokenExpiresIn := int64(120)
+		addRes, err := resolvers.AddClientResolver(ctx, model.AddClientInput{
+			Name:                 "test client",
+			RedirectUris:         []string{redirectURI},
+			Scopes:               []string{"openid", "profile"},
+			AccessTokenExpiresIn: &accessTokenExpiresIn,
+		})
+		assert.Nil(t, err)
+		assert.NotNil(t, addRes.ClientSecret)
+		assert.False(t, addRes.Client.IsPublic)
+		assert.False(t, addRes.Client.Trusted)
+		assert.Equal(t, constants.DefaultClientGrantTypes, addRes.Client.GrantTypes)
+		clientID := addRes.Client.ClientID
+		clientSecret := *addRes.ClientSecret
+
+		dbClient, err := db.Provider.GetClientByClientID(clientID)
+		assert.Nil(t, err)
+		assert.NotEqual(t, clientSecret, dbClient.ClientSecret, "secret should be stored as hash")
+
+		clients, err := resolvers.ClientsResolver(ctx, nil)
+		assert.Nil(t, err)
+		assert.GreaterOrEqual(t, len(clients.Clients), 1)
+
+		newName := "updated client"
+		updatedClient, err := resolvers.UpdateClientResolver(ctx, model.UpdateClientInput{
+			ClientID: clientID,
+			Name:     &newName,
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, newName, updatedClient.Name)
+		assert.Equal(t, []string{redirectURI}, updatedClient.RedirectUris)
+
+		// authorization code flow with registered client
+		email := "client." + s.TestInfo.Email
+		resolvers.SignupResolver(ctx, model.SignUpInput{
+			Email:           email,
+			Password:        s.TestInfo.Password,
+			ConfirmPassword: s.TestInfo.Password,
+		})
+		verificationRequest, err := db.Provider.GetVerificationRequestByEmail(email, constants.VerificationTypeBasicAuthSignup)
+		assert.Nil(t, err)
+		verifyRes, err := resolvers.VerifyEmailResolver(ctx, model.VerifyEmailInput{
+			Token: verificationRequest.Token,
+		})
+		assert.Nil(t, err)
+
+		serverURL := "http://" + s.Server.Listener.Addr().String()
+		codeVerifier := "client-code-verifier-with-enough-entropy-1234567890"
+		hash := sha256.Sum256([]byte(codeVerifier))
+		codeChallenge := base64.RawURLEncoding.EncodeToString(hash[:])
+		httpClient := &http.Client{
+			CheckRedirect: func(req *http.Request, via []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		}
+
+		authorize := func(params url.Values) *http.Response {
+			req, _ := http.NewRequest(http.MethodGet, serverURL+"/authorize?"+params.Encode(), nil)
+			req.Header.Set("Authorization", "Bearer "+*verifyRes.AccessToken)
+			res, err := httpClient.Do(req)
+			assert.Nil(t, err)
+			return res
+		}
+
+		exchange := func(params url.Values, secret string) (int, map[string]interface{}) {
+			req, _ := http.NewRequest(http.MethodPost, serverURL+"/oauth/token", strings.NewReader(params.Encode()))
+			req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+			if secret != "" {
+				req.SetBasicAuth(clientID, secret)
+			}
+			res, err := http.DefaultClient.Do(req)
+			assert.Nil(t, err)
+			defer res.Body.Close()
+			var data map[string]interface{}
+			json.NewDecoder(res.Body).Decode(&data)
+			return res.StatusCode, data
+		}
+
+		authorizeParams := url.Values{
+			"response_type":         {"code"},
+			"client_id":             {clientID},
+			"redirect_uri":          {redirectURI + "/other"},
+			"code_challenge":        {codeChallenge},
+			"code_challenge_method": {"S256"},
+		}
+		res := authorize(authorizeParams)
+		assert.Equal(t, http.StatusBadRequest, res.StatusCode, "redirect uri should match exactly")
+
+		authorizeParams.Set("redirect_uri", redirectURI)
+		res = authorize(authorizeParams)
+		location, _ := url.Parse(res.Header.Get("Location"))
+		assert.Equal(t, "unauthorized_client", location.Query().Get("error"), "codes should be issued only to trusted clients")
+
+		trusted := true
+		updatedClient, err = resolvers.UpdateClientResolver(ctx, model.UpdateClientInput{
+			ClientID: clientID,
+			Trusted:  &trusted,
+		})
+		assert.Nil(t, err)
+		assert.True(t, updatedClient.Trusted)
+
+		authorizeParams.Set("scope", "openid email")
+		res = authorize(authorizeParams)
+		location, _ = url.Parse(res.Header.Get("Location"))
+		assert.Equal(t, "invalid_scope", location.Query().Get("error"))
+
+		authorizeParams.Set("scope", "openid profile")
+		res = authorize(authorizeParams)
+		assert.Equal(t, http.StatusFound, res.StatusCode)
+		location, _ = url.Parse(res.Header.Get("Location"))
+		code := location.Query().Get("code")
+		assert.NotEmpty(t, code)
+
+		tokenParams := url.Values{
+			"grant_type":    {constants.GrantTypeAuthorizationCode},
+			"code":          {code},
+			"redirect_uri":  {redirectURI},
+			"code_verifier": {codeVerifier},
+		}
+		status, _ := exchange(tokenParams, "invalid-secret")
+		assert.Equal(t, http.StatusUnauthorized, status, "client secret should be verified")
+
+		status, data := exchange(tokenParams, clientSecret)
+		assert.Equal(t, http.StatusOK, status)
+		assert.Equal(t, float64(accessTokenExpiresIn), data["expires_in"])
+
+		claims, err := token.VerifyJWTToken(data["access_token"].(string))
+		assert.Nil(t, err)
+		assert.Equal(t, clientID, claims["aud"])
+		claims, err = token.VerifyJWTToken(data["id_token"].(string))
+		assert.Nil(t, err)
+		assert.Equal(t, clientID, claims["aud"])
+
+		// refresh token can not be used by another client
+		status, _ = exchange(url.Values{
+			"grant_type":    {constants.GrantTypeRefreshToken},
+			"client_id":     {envstore.EnvInMemoryStoreObj.GetStringStoreEnvVariable(constants.EnvKeyClientID)},
+			"refresh_token": {data["refresh_token"].(string)},
+		}, "")
+		assert.Equal(t, http.StatusBadRequest, status)
+
+		// old secret stops working after regeneration
+		secretRes, err := resolvers.RegenerateClientSecretResolver(ctx, model.ClientInput{
+			ClientID: clientID,
+		})
+		assert.Nil(t, err)
+		status, _ = exchange(url.Values{
+			"grant_type":    {constants.GrantTypeRefreshToken},
+			"refresh_token": {data["refresh_token"].(string)},
+		}, clientSecret)
+		assert.Equal(t, http.StatusUnauthorized, status)
+		status, refreshData := exchange(url.Values{
+			"grant_type":    {constants.GrantTypeRefreshToken},
+			"refresh_token": {data["refresh_token"].(string)},
+		}, *secretRes.ClientSecret)
+		assert.Equal(t, http.StatusOK, status)
+		assert.NotEmpty(t, refreshData["access_token"])
+
+		_, err = resolvers.DeleteClientResolver(ctx, model.ClientInput{
+			ClientID: clientID,
+		})
+		assert.Nil(t, err)
+		_, err = db.Provider.GetClientByClientID(clientID)
+		assert.NotNil(t, err)
+
+		cleanData(email)
+	})
+	t.Run(`should issue client credentials tokens`, func(t *testing.T) {
+		req, ctx := createContext(s)
+		h, err := utils.EncryptPassword(envstore.EnvInMemoryStoreObj.GetStringStoreEnvVariable(constants.EnvKeyAdminSecret))
+		assert.Nil(t, err)
+		req.Header.Set("Cookie", fmt.Sprintf("%s=%s", envstore.EnvInMemoryStoreObj.GetStringStoreEnvVariable(constants.EnvKeyAdminCookieName), h))
+
+		_, err = resolvers.AddClientResolver(ctx, model.AddClientInput{
+			Name:         "machine client",
+			RedirectUris: []string{"https://machine.example.com/callback"},
+			GrantTypes:   []string{constants.GrantTypeClientCredentials},
+			Roles:        []string{"invalid_role"},
+		})
+		assert.NotNil(t, err, "invalid roles")
+
+		addRes, err := resolvers.AddClientResolver(ctx, model.AddClientInput{
+			Name:         "machine client",
+			RedirectUris: []string{"https://machine.example.com/callback"},
+			GrantTypes:   []string{constants.GrantTypeClientCredentials},
+			Scopes:       []string{"read", "write"},
+			Roles:        []string{"user"},
+		})
+		assert.Nil(t, err)
+		clientID := addRes.Client.ClientID
+
+		exchange := func(params url.Values) (int, map[string]interface{}) {
+			res, err := http.PostForm("http://"+s.Server.Listener.Addr().String()+"/oauth/token", params)
+			assert.Nil(t, err)
+			defer res.Body.Close()
+			var data map[string]interface{}
+			json.NewDecoder(res.Body).Decode(&data)
+			return res.StatusCode, data
+		}
+
+		status, _ := exchange(url.Values{
+			"grant_type": {constants.GrantTypeClientCredentials},
+			"client_id":  {clientID},
+		})
+		assert.Equal(t, http.StatusUnauthorized, status, "client secret is required")
+
+		status, _ = exchange(url.Values{
+			"grant_type":    {constants.GrantTypeClientCredentials},
+			"client_id":     {clientID},
+			"client_secret": {*addRes.ClientSecret},
+			"scope":         {"admin"},
+		})
+		assert.Equal(t, http.StatusBadRequest, status, "invalid scope")
+
+		status, data := exchange(url.Values{
+			"grant_type":    {constants.GrantTypeClientCredentials},
+			"client_id":     {clientID},
+			"client_secret": {*addRes.ClientSecret},
+			"scope":         {"read"},
+		})
+		assert.Equal(t, http.StatusOK, status)
+		assert.Equal(t, "read", data["scope"])
+		assert.Nil(t, data["refresh_token"])
+
+		accessToken := data["access_token"].(string)
+		claims, err := token.VerifyJWTToken(accessToken)
+		assert.Nil(t, err)
+		assert.Equal(t, clientID, claims["sub"])
+		assert.Equal(t, clientID, claims["aud"])
+		assert.Nil(t, claims["id"])
+		assert.Nil(t, claims["email"])
+
+		validRes, err := resolvers.IsValidJwtResolver(ctx, &model.IsValidJWTQueryInput{
+			Jwt:   &accessToken,
+			Roles: []string{"user"},
+		})
+		assert.Nil(t, err)
+		assert.True(t, validRes.Valid)
+
+		_, err = resolvers.IsValidJwtResolver(ctx, &model.IsValidJWTQueryInput{
+			Jwt:   &accessToken,
+			Roles: []string{"admin"},
+		})
+		assert.NotNil(t, err)
+
+		// client tokens can not be used for user apis
+		req.Header.Set("Authorization", "Bearer "+accessToken)
+		_, err = resolvers.ProfileResolver(ctx)
+		assert.NotNil(t, err)
+
+		_, err = resolvers.DeleteClientResolver(ctx, model.ClientInput{
+			ClientID: clientID,
+		})
+		assert.Nil(t, err)
+	})
+}
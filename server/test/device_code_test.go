@@ -0,0 +1,122 @@
+package test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/authorizerdev/authorizer/server/constants"
+	"github.com/authorizerdev/authorizer/server/db"
+	"github.com/authorizerdev/authorizer/server/envstore"
+	"github.com/authorizerdev/authorizer/server/graph/model"
+	"github.com/authorizerdev/authorizer/server/resolvers"
+	"github.com/authorizerdev/authorizer/server/token"
+	"github.com/stretchr/testify/assert"
+)
+
+func deviceCodeTests(t *testing.T, s TestSetup) {
+	t.Helper()
+	t.Run(`should issue tokens with device code flow`, func(t *testing.T) {
+		req, ctx := createContext(s)
+		email := "device." + s.TestInfo.Email
+		resolvers.SignupResolver(ctx, model.SignUpInput{
+			Email:           email,
+			Password:        s.TestInfo.Password,
+			ConfirmPassword: s.TestInfo.Password,
+		})
+		verificationRequest, err := db.Provider.GetVerificationRequestByEmail(email, constants.VerificationTypeBasicAuthSignup)
+		assert.Nil(t, err)
+		verifyRes, err := resolvers.VerifyEmailResolver(ctx, model.VerifyEmailInput{
+			Token: verificationRequest.Token,
+		})
+		assert.Nil(t, err)
+
+		serverURL := "http://" + s.Server.Listener.Addr().String()
+		clientID := envstore.EnvInMemoryStoreObj.GetStringStoreEnvVariable(constants.EnvKeyClientID)
+		post := func(path string, params url.Values) (int, map[string]interface{}) {
+			res, err := http.PostForm(serverURL+path, params)
+			assert.Nil(t, err)
+			defer res.Body.Close()
+			var data map[string]interface{}
+			json.NewDecoder(res.Body).Decode(&data)
+			return res.StatusCode, data
+		}
+
+		status, _ := post("/oauth/device_authorization", url.Values{
+			"client_id": {"invalid"},
+		})
+		assert.Equal(t, http.StatusUnauthorized, status)
+
+		status, data := post("/oauth/device_authorization", url.Values{
+			"client_id": {clientID},
+		})
+		assert.Equal(t, http.StatusOK, status)
+		deviceCode := data["device_code"].(string)
+		userCode := data["user_code"].(string)
+		assert.NotEmpty(t, deviceCode)
+		assert.Len(t, userCode, 9)
+		assert.Contains(t, data["verification_uri"], "/app/device")
+
+		tokenParams := url.Values{
+			"grant_type":  {constants.GrantTypeDeviceCode},
+			"client_id":   {clientID},
+			"device_code": {deviceCode},
+		}
+		status, data = post("/oauth/token", tokenParams)
+		assert.Equal(t, http.StatusBadRequest, status)
+		assert.Equal(t, "authorization_pending", data["error"])
+
+		status, data = post("/oauth/token", tokenParams)
+		assert.Equal(t, "slow_down", data["error"])
+
+		// user code needs logged in user
+		_, err = resolvers.VerifyDeviceCodeResolver(ctx, model.VerifyDeviceCodeInput{
+			UserCode: userCode,
+		})
+		assert.NotNil(t, err)
+
+		req.Header.Set("Authorization", "Bearer "+*verifyRes.AccessToken)
+		_, err = resolvers.VerifyDeviceCodeResolver(ctx, model.VerifyDeviceCodeInput{
+			UserCode: "BCDF-GHJK",
+		})
+		assert.NotNil(t, err, "invalid user code")
+
+		// user code is case insensitive & hyphen is optional
+		_, err = resolvers.VerifyDeviceCodeResolver(ctx, model.VerifyDeviceCodeInput{
+			UserCode: strings.ToLower(userCode[:4] + userCode[5:]),
+		})
+		assert.Nil(t, err)
+
+		_, err = resolvers.VerifyDeviceCodeResolver(ctx, model.VerifyDeviceCodeInput{
+			UserCode: userCode,
+		})
+		assert.NotNil(t, err, "user code should not be reused")
+
+		status, data = post("/oauth/token", tokenParams)
+		assert.Equal(t, http.StatusOK, status)
+		claims, err := token.VerifyJWTToken(data["access_token"].(string))
+		assert.Nil(t, err)
+		assert.Equal(t, verifyRes.User.ID, claims["id"])
+
+		status, data = post("/oauth/token", tokenParams)
+		assert.Equal(t, "expired_token", data["error"], "device code should not be reused")
+
+		// denied device request
+		_, data = post("/oauth/device_authorization", url.Values{
+			"client_id": {clientID},
+		})
+		deny := true
+		_, err = resolvers.VerifyDeviceCodeResolver(ctx, model.VerifyDeviceCodeInput{
+			UserCode: data["user_code"].(string),
+			Deny:     &deny,
+		})
+		assert.Nil(t, err)
+		tokenParams.Set("device_code", data["device_code"].(string))
+		_, data = post("/oauth/token", tokenParams)
+		assert.Equal(t, "access_denied", data["error"])
+
+		cleanData(email)
+	})
+}
@@ -0,0 +1,148 @@
+package test
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/authorizerdev/authorizer/server/constants"
+	"github.com/authorizerdev/authorizer/server/crypto"
+	"github.com/authorizerdev/authorizer/server/db"
+	"github.com/authorizerdev/authorizer/server/envstore"
+	"github.com/authorizerdev/authorizer/server/graph/model"
+	"github.com/authorizerdev/authorizer/server/resolvers"
+	"github.com/authorizerdev/authorizer/server/utils"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/crypto/argon2"
+)
+
+// importedPasswordHashes are the hashes of Test@123 in the formats of other identity systems
+var importedPasswordHashes = map[string]string{
+	"django pbkdf2":       "pbkdf2_sha256$1000$seasalt$45oW7Xq1YYm3GymNHGwbEl4yooxzTJw+7cRxltqt2eU=",
+	"passlib pbkdf2":      "$pbkdf2-sha512$2000$MDEyMzQ1Njc4OWFiY2RlZg$zT77P1.ByBRNOmB9XCGzt5AyDZI0G6c7n6pdZx8EE.0xkq2DR6MOsp/dyQF4hgSjodqpxhUIEbMgM1CAB6ApEQ",
+	"passlib pbkdf2 sha1": "$pbkdf2$1500$MDEyMzQ1Njc4OWFiY2RlZg$Y1ovAtpU.4ujUPckZJFWPRwgNtE",
+	"ldap ssha":           "{SSHA}iGC4lkcPIV09J76YrQVYtqyugRpzYWx0MTIzNA==",
+	"ldap ssha256":        "{SSHA256}OB9THrKB3ylmzGh71q2qhjTrNUiLanplFmC1A6j3xA5zYWx0MTIzNA==",
+	"ldap ssha512":        "{SSHA512}hy1EE0EjmeOlvWyqh9jefxOaDA2aeDdwBrHJUGrEIwH3DJENUAiMTPprZU7EJUPDTQp6CVAgQhPko4Dwg6LDKXNhbHQxMjM0",
+	"scrypt":              "$scrypt$ln=10,r=8,p=1$MDEyMzQ1Njc4OWFiY2RlZg$E/AouBhedB/BJXYeaBQdztG8buR4LwBTOMcemZXJlQM",
+}
+
+func TestImportedPasswordHash(t *testing.T) {
+	hashes := map[string]string{}
+	for name, hash := range importedPasswordHashes {
+		hashes[name] = hash
+	}
+	salt := []byte("0123456789abcdef")
+	hashes["argon2i"] = fmt.Sprintf("$argon2i$v=19$m=4096,t=3,p=1$%s$%s", base64.RawStdEncoding.EncodeToString(salt), base64.RawStdEncoding.EncodeToString(argon2.Key([]byte("Test@123"), salt, 3, 4096, 1, 32)))
+
+	for name, hash := range hashes {
+		assert.True(t, crypto.IsSupportedPasswordHash(hash), name)
+		assert.True(t, crypto.VerifyPassword(hash, "Test@123"), name)
+		assert.False(t, crypto.VerifyPassword(hash, "Test@1234"), name)
+		assert.True(t, crypto.PasswordNeedsRehash(crypto.PasswordHashAlgorithmArgon2id, hash), name)
+	}
+
+	// hashes with parameters above the limits are rejected, so that they can not exhaust memory or cpu on login
+	expensiveHashes := []string{
+		"pbkdf2_sha256$20000000$salt$aGFzaA==",
+		"$argon2id$v=19$m=4194304,t=3,p=1$c2FsdA$aGFzaA",
+		"$argon2id$v=19$m=4096,t=1000,p=1$c2FsdA$aGFzaA",
+		"$argon2id$v=19$m=4096,t=3,p=255$c2FsdA$aGFzaA",
+		"$scrypt$ln=25,r=8,p=1$c2FsdA$aGFzaA",
+		"$scrypt$ln=10,r=8,p=1000$c2FsdA$aGFzaA",
+		"$scrypt$ln=10,r=100000000,p=1$c2FsdA$aGFzaA",
+	}
+	for _, hash := range append([]string{"", "plain", "md5$salt$hash", "pbkdf2_md5$1000$salt$aGFzaA==", "pbkdf2_sha256$0$salt$aGFzaA==", "{SSHA}c2hvcnQ=", "$argon2d$v=19$m=4096,t=3,p=1$c2FsdA$aGFzaA"}, expensiveHashes...) {
+		assert.False(t, crypto.IsSupportedPasswordHash(hash), hash)
+		assert.False(t, crypto.VerifyPassword(hash, "Test@123"), hash)
+	}
+}
+
+func importUsersTests(t *testing.T, s TestSetup) {
+	t.Helper()
+	t.Run(`should import users with foreign password hashes`, func(t *testing.T) {
+		req, ctx := createContext(s)
+		pbkdf2Email := "import_pbkdf2." + s.TestInfo.Email
+		csvEmail := "import_csv." + s.TestInfo.Email
+		unverifiedEmail := "import_unverified." + s.TestInfo.Email
+
+		data := fmt.Sprintf(`[
+			{"email": %q, "email_verified": true, "password_hash": %q, "given_name": "Imported", "roles": ["user"]},
+			{"email": %q, "password_hash": %q},
+			{"email": "invalid email"},
+			{"email": %q, "password_hash": "md5$salt$hash"},
+			{"email": %q, "roles": ["unknown_role"]}
+		]`, pbkdf2Email, importedPasswordHashes["django pbkdf2"], unverifiedEmail, importedPasswordHashes["ldap ssha256"], "import_md5."+s.TestInfo.Email, "import_role."+s.TestInfo.Email)
+
+		_, err := resolvers.ImportUsersResolver(ctx, model.ImportUsersInput{
+			Format: "json",
+			Data:   data,
+		})
+		assert.NotNil(t, err, "unauthorized")
+
+		h, err := utils.EncryptPassword(envstore.EnvInMemoryStoreObj.GetStringStoreEnvVariable(constants.EnvKeyAdminSecret))
+		assert.Nil(t, err)
+		req.Header.Set("Cookie", fmt.Sprintf("%s=%s", envstore.EnvInMemoryStoreObj.GetStringStoreEnvVariable(constants.EnvKeyAdminCookieName), h))
+
+		res, err := resolvers.ImportUsersResolver(ctx, model.ImportUsersInput{
+			Format: "json",
+			Data:   data,
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, 2, res.Imported)
+		assert.Len(t, res.Errors, 3)
+		assert.Equal(t, 3, res.Errors[0].Row)
+
+		phoneNumber := fmt.Sprintf("+1415%07d", time.Now().UnixNano()%10000000)
+		csvData := "email,email_verified,password_hash,roles,phone_number,phone_number_verified\n" +
+			fmt.Sprintf("%s,true,%s,\"user\",%s,true\n", csvEmail, importedPasswordHashes["passlib pbkdf2"], phoneNumber) +
+			fmt.Sprintf("%s,true,,,,\n", pbkdf2Email) +
+			fmt.Sprintf("%s,true,,,%s,true\n", "import_phone."+s.TestInfo.Email, phoneNumber)
+		res, err = resolvers.ImportUsersResolver(ctx, model.ImportUsersInput{
+			Format: "csv",
+			Data:   csvData,
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, 1, res.Imported)
+		assert.Len(t, res.Errors, 2, "existing user and verified phone number are not imported again")
+		req.Header.Del("Cookie")
+
+		user, err := db.Provider.GetUserByEmail(pbkdf2Email)
+		assert.Nil(t, err)
+		assert.Equal(t, "Imported", *user.GivenName)
+		assert.Equal(t, "user", user.Roles)
+
+		_, err = resolvers.LoginResolver(ctx, model.LoginInput{
+			Email:    unverifiedEmail,
+			Password: s.TestInfo.Password,
+		})
+		assert.NotNil(t, err, "email not verified")
+
+		for _, email := range []string{pbkdf2Email, csvEmail} {
+			_, err = resolvers.LoginResolver(ctx, model.LoginInput{
+				Email:    email,
+				Password: "wrong_password",
+			})
+			assert.NotNil(t, err)
+
+			loginRes, err := resolvers.LoginResolver(ctx, model.LoginInput{
+				Email:    email,
+				Password: s.TestInfo.Password,
+			})
+			assert.Nil(t, err)
+			assert.NotNil(t, loginRes.AccessToken)
+
+			// foreign hash is upgraded to the native hash
+			user, err = db.Provider.GetUserByEmail(email)
+			assert.Nil(t, err)
+			assert.True(t, strings.HasPrefix(*user.Password, "$argon2id$"))
+		}
+
+		cleanData(pbkdf2Email)
+		cleanData(csvEmail)
+		cleanData(unverifiedEmail)
+		cleanData("import_phone." + s.TestInfo.Email)
+	})
+}
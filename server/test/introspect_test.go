@@ -0,0 +1,137 @@
+package test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/authorizerdev/authorizer/server/constants"
+	"github.com/authorizerdev/authorizer/server/db"
+	"github.com/authorizerdev/authorizer/server/db/models"
+	"github.com/authorizerdev/authorizer/server/envstore"
+	"github.com/authorizerdev/authorizer/server/graph/model"
+	"github.com/authorizerdev/authorizer/server/resolvers"
+	"github.com/authorizerdev/authorizer/server/sessionstore"
+	"github.com/authorizerdev/authorizer/server/token"
+	"github.com/authorizerdev/authorizer/server/utils"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func introspectTests(t *testing.T, s TestSetup) {
+	t.Helper()
+	t.Run(`should introspect and revoke exact tokens`, func(t *testing.T) {
+		_, ctx := createContext(s)
+		email := "introspect." + s.TestInfo.Email
+		resolvers.SignupResolver(ctx, model.SignUpInput{
+			Email:           email,
+			Password:        s.TestInfo.Password,
+			ConfirmPassword: s.TestInfo.Password,
+		})
+		verificationRequest, err := db.Provider.GetVerificationRequestByEmail(email, constants.VerificationTypeBasicAuthSignup)
+		assert.Nil(t, err)
+		_, err = resolvers.VerifyEmailResolver(ctx, model.VerifyEmailInput{
+			Token: verificationRequest.Token,
+		})
+		assert.Nil(t, err)
+		user, err := db.Provider.GetUserByEmail(email)
+		assert.Nil(t, err)
+
+		createSession := func() *token.Token {
+			authToken, err := token.CreateAuthToken(user, []string{"user"}, token.AuthTokenOptions{})
+			assert.Nil(t, err)
+			sessionstore.SetUserSession(user.ID, authToken.FingerPrint, authToken.RefreshToken.Token)
+			return authToken
+		}
+		firstSession := createSession()
+		secondSession := createSession()
+
+		serverURL := "http://" + s.Server.Listener.Addr().String()
+		clientID := envstore.EnvInMemoryStoreObj.GetStringStoreEnvVariable(constants.EnvKeyClientID)
+		post := func(path string, params url.Values) (int, map[string]interface{}) {
+			params.Set("client_id", clientID)
+			res, err := http.PostForm(serverURL+path, params)
+			assert.Nil(t, err)
+			defer res.Body.Close()
+			var data map[string]interface{}
+			json.NewDecoder(res.Body).Decode(&data)
+			return res.StatusCode, data
+		}
+
+		// introspection is only allowed for confidential clients
+		status, _ := post("/oauth/introspect", url.Values{"token": {firstSession.AccessToken.Token}})
+		assert.Equal(t, http.StatusUnauthorized, status, "first party client id is public")
+
+		introspectionClientSecret := uuid.New().String()
+		introspectionClientSecretHash, err := utils.EncryptPassword(introspectionClientSecret)
+		assert.Nil(t, err)
+		introspectionClient, err := db.Provider.AddClient(models.Client{
+			ClientID:     uuid.New().String(),
+			ClientSecret: introspectionClientSecretHash,
+			Name:         "introspection client",
+			RedirectURIs: "https://api.example.com/callback",
+			GrantTypes:   constants.GrantTypeClientCredentials,
+		})
+		assert.Nil(t, err)
+		defer db.Provider.DeleteClient(introspectionClient)
+
+		introspect := func(tokenString string) map[string]interface{} {
+			req, err := http.NewRequest(http.MethodPost, serverURL+"/oauth/introspect", strings.NewReader(url.Values{"token": {tokenString}}.Encode()))
+			assert.Nil(t, err)
+			req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+			req.SetBasicAuth(introspectionClient.ClientID, introspectionClientSecret)
+			res, err := http.DefaultClient.Do(req)
+			assert.Nil(t, err)
+			defer res.Body.Close()
+			assert.Equal(t, http.StatusOK, res.StatusCode)
+			var data map[string]interface{}
+			json.NewDecoder(res.Body).Decode(&data)
+			return data
+		}
+
+		data := introspect("invalid-token")
+		assert.Equal(t, false, data["active"])
+
+		data = introspect(firstSession.AccessToken.Token)
+		assert.Equal(t, true, data["active"])
+		assert.Equal(t, user.ID, data["sub"])
+		assert.Equal(t, email, data["username"])
+		assert.Equal(t, clientID, data["client_id"])
+		assert.Equal(t, "Bearer", data["token_type"])
+
+		data = introspect(firstSession.RefreshToken.Token)
+		assert.Equal(t, true, data["active"])
+
+		data = introspect(firstSession.IDToken.Token)
+		assert.Equal(t, false, data["active"], "id token is not an access token")
+
+		// revoking access token does not affect other tokens of user
+		status, _ = post("/oauth/revoke", url.Values{"token": {firstSession.AccessToken.Token}})
+		assert.Equal(t, http.StatusOK, status)
+		assert.Equal(t, false, introspect(firstSession.AccessToken.Token)["active"])
+		assert.Equal(t, true, introspect(firstSession.RefreshToken.Token)["active"])
+		assert.Equal(t, true, introspect(secondSession.AccessToken.Token)["active"])
+
+		// revoking refresh token ends its session only
+		status, _ = post("/oauth/revoke", url.Values{"token": {secondSession.RefreshToken.Token}})
+		assert.Equal(t, http.StatusOK, status)
+		assert.Equal(t, false, introspect(secondSession.RefreshToken.Token)["active"])
+		assert.Equal(t, false, introspect(secondSession.AccessToken.Token)["active"])
+		assert.Equal(t, true, introspect(firstSession.RefreshToken.Token)["active"])
+
+		status, _ = post("/oauth/revoke", url.Values{"token": {"invalid-token"}})
+		assert.Equal(t, http.StatusOK, status, "invalid tokens should be ignored")
+
+		res, err := http.PostForm(serverURL+"/oauth/introspect", url.Values{
+			"client_id": {"invalid"},
+			"token":     {firstSession.RefreshToken.Token},
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusUnauthorized, res.StatusCode, "client should be authenticated")
+
+		sessionstore.DeleteAllUserSession(user.ID)
+		cleanData(email)
+	})
+}
@@ -27,7 +27,7 @@ func isValidJWTTests(t *testing.T, s TestSetup) {
 		authToken, err := token.CreateAuthToken(models.User{
 			ID:    uuid.New().String(),
 			Email: "john.doe@gmail.com",
-		}, []string{})
+		}, []string{}, token.AuthTokenOptions{})
 		assert.Nil(t, err)
 		res, err := resolvers.IsValidJwtResolver(ctx, &model.IsValidJWTQueryInput{
 			Jwt: &authToken.AccessToken.Token,
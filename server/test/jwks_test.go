@@ -0,0 +1,75 @@
+package test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/authorizerdev/authorizer/server/constants"
+	"github.com/authorizerdev/authorizer/server/db/models"
+	"github.com/authorizerdev/authorizer/server/env"
+	"github.com/authorizerdev/authorizer/server/envstore"
+	"github.com/authorizerdev/authorizer/server/token"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func jwksTests(t *testing.T, s TestSetup) {
+	t.Helper()
+	// keep a copy of env to restore the jwt config for other tests
+	envStore := envstore.EnvInMemoryStoreObj.GetEnvStoreClone()
+	defer envstore.EnvInMemoryStoreObj.UpdateEnvStore(envStore)
+
+	getKeys := func(t *testing.T) []map[string]interface{} {
+		res, err := http.Get("http://" + s.Server.Listener.Addr().String() + "/.well-known/jwks.json")
+		assert.Nil(t, err)
+		defer res.Body.Close()
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+
+		var data struct {
+			Keys []map[string]interface{} `json:"keys"`
+		}
+		assert.Nil(t, json.NewDecoder(res.Body).Decode(&data))
+		return data.Keys
+	}
+
+	t.Run(`should return empty keys for HMAC`, func(t *testing.T) {
+		assert.Len(t, getKeys(t), 0)
+	})
+
+	for _, algo := range []string{"RS256", "ES256", "ES512", "EdDSA"} {
+		t.Run(`should sign and publish keys for `+algo, func(t *testing.T) {
+			updatedStore := envstore.EnvInMemoryStoreObj.GetEnvStoreClone()
+			updatedStore.StringEnv[constants.EnvKeyJwtType] = algo
+			updatedStore.StringEnv[constants.EnvKeyJwtPrivateKey] = ""
+			changed, err := env.SetJwtKeys(updatedStore.StringEnv)
+			assert.Nil(t, err)
+			assert.True(t, changed)
+			assert.NotEmpty(t, updatedStore.StringEnv[constants.EnvKeyJwtPublicKey])
+			envstore.EnvInMemoryStoreObj.UpdateEnvStore(updatedStore)
+
+			accessToken, _, err := token.CreateAccessToken(models.User{
+				ID:    uuid.New().String(),
+				Email: "john.doe@gmail.com",
+			}, []string{}, token.AuthTokenOptions{})
+			assert.Nil(t, err)
+
+			claims, err := token.VerifyJWTToken(accessToken)
+			assert.Nil(t, err)
+			assert.Equal(t, "john.doe@gmail.com", claims["email"])
+
+			keys := getKeys(t)
+			assert.Len(t, keys, 1)
+			assert.Equal(t, algo, keys[0]["alg"])
+			assert.Equal(t, "sig", keys[0]["use"])
+		})
+	}
+
+	t.Run(`should reject invalid private key`, func(t *testing.T) {
+		updatedStore := envstore.EnvInMemoryStoreObj.GetEnvStoreClone()
+		updatedStore.StringEnv[constants.EnvKeyJwtType] = "RS256"
+		updatedStore.StringEnv[constants.EnvKeyJwtPrivateKey] = "invalid"
+		_, err := env.SetJwtKeys(updatedStore.StringEnv)
+		assert.NotNil(t, err)
+	})
+}
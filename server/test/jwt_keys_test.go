@@ -0,0 +1,140 @@
+package test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/authorizerdev/authorizer/server/constants"
+	"github.com/authorizerdev/authorizer/server/crypto"
+	"github.com/authorizerdev/authorizer/server/db/models"
+	"github.com/authorizerdev/authorizer/server/env"
+	"github.com/authorizerdev/authorizer/server/envstore"
+	"github.com/authorizerdev/authorizer/server/graph/model"
+	"github.com/authorizerdev/authorizer/server/resolvers"
+	"github.com/authorizerdev/authorizer/server/token"
+	"github.com/authorizerdev/authorizer/server/utils"
+	"github.com/golang-jwt/jwt"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func jwtKeysTests(t *testing.T, s TestSetup) {
+	t.Helper()
+	t.Run(`should rotate jwt keys`, func(t *testing.T) {
+		req, ctx := createContext(s)
+		_, err := resolvers.GenerateJwtKeyResolver(ctx, nil)
+		assert.NotNil(t, err)
+
+		h, err := utils.EncryptPassword(envstore.EnvInMemoryStoreObj.GetStringStoreEnvVariable(constants.EnvKeyAdminSecret))
+		assert.Nil(t, err)
+		req.Header.Set("Cookie", fmt.Sprintf("%s=%s", envstore.EnvInMemoryStoreObj.GetStringStoreEnvVariable(constants.EnvKeyAdminCookieName), h))
+
+		createToken := func() string {
+			accessToken, _, err := token.CreateAccessToken(models.User{
+				ID:    uuid.New().String(),
+				Email: s.TestInfo.Email,
+			}, []string{}, token.AuthTokenOptions{})
+			assert.Nil(t, err)
+			return accessToken
+		}
+
+		// signing key configured before the key ring existed
+		envstore.EnvInMemoryStoreObj.UpdateEnvVariable(constants.StringStoreIdentifier, constants.EnvKeyJwtKeyID, env.LegacyJwtKeyID)
+		oldKeyID := envstore.EnvInMemoryStoreObj.GetStringStoreEnvVariable(constants.EnvKeyJwtKeyID)
+		oldToken := createToken()
+
+		// token signed before the key ring existed doesn't have kid header
+		legacyKey := env.GetActiveJwtKey(envstore.EnvInMemoryStoreObj.GetEnvStoreClone().StringEnv)
+		var signingKey interface{} = []byte(legacyKey.Secret)
+		if !crypto.IsHMACAlgo(legacyKey.Type) {
+			signingKey, err = crypto.ParsePrivateKey(legacyKey.Type, legacyKey.PrivateKey)
+			assert.Nil(t, err)
+		}
+		legacyToken, err := jwt.NewWithClaims(jwt.GetSigningMethod(legacyKey.Type), jwt.MapClaims{
+			"sub": uuid.New().String(),
+			"iat": time.Now().Unix(),
+			"exp": time.Now().Add(time.Hour).Unix(),
+		}).SignedString(signingKey)
+		assert.Nil(t, err)
+		_, err = token.VerifyJWTToken(legacyToken)
+		assert.Nil(t, err)
+
+		key, err := resolvers.GenerateJwtKeyResolver(ctx, nil)
+		assert.Nil(t, err)
+		assert.Equal(t, constants.JwtKeyStatusPending, key.Status)
+
+		// pending key is not used for signing
+		assert.Equal(t, oldKeyID, envstore.EnvInMemoryStoreObj.GetStringStoreEnvVariable(constants.EnvKeyJwtKeyID))
+
+		_, err = resolvers.PromoteJwtKeyResolver(ctx, model.JWTKeyInput{ID: key.ID})
+		assert.Nil(t, err)
+		assert.Equal(t, key.ID, envstore.EnvInMemoryStoreObj.GetStringStoreEnvVariable(constants.EnvKeyJwtKeyID))
+
+		keys, err := resolvers.JwtKeysResolver(ctx)
+		assert.Nil(t, err)
+		assert.Len(t, keys, 2)
+		assert.Equal(t, constants.JwtKeyStatusActive, keys[0].Status)
+		assert.Equal(t, oldKeyID, keys[1].ID)
+		assert.Equal(t, constants.JwtKeyStatusRetired, keys[1].Status)
+		assert.NotNil(t, keys[1].ExpiresAt)
+
+		// retired key keeps verifying tokens during grace period
+		_, err = token.VerifyJWTToken(oldToken)
+		assert.Nil(t, err)
+		_, err = token.VerifyJWTToken(legacyToken)
+		assert.Nil(t, err)
+
+		newToken := createToken()
+		_, err = token.VerifyJWTToken(newToken)
+		assert.Nil(t, err)
+
+		_, err = resolvers.RetireJwtKeyResolver(ctx, model.JWTKeyInput{ID: key.ID})
+		assert.NotNil(t, err, "active key should not be retired")
+
+		// retiring shortens the grace period to the longest token lifetime,
+		// tokens already signed with the key keep working
+		envstore.EnvInMemoryStoreObj.UpdateEnvVariable(constants.StringStoreIdentifier, constants.EnvKeyRefreshTokenExpiryTime, "1h")
+		_, err = resolvers.RetireJwtKeyResolver(ctx, model.JWTKeyInput{ID: oldKeyID})
+		envstore.EnvInMemoryStoreObj.UpdateEnvVariable(constants.StringStoreIdentifier, constants.EnvKeyRefreshTokenExpiryTime, "")
+		assert.Nil(t, err)
+		_, err = token.VerifyJWTToken(oldToken)
+		assert.Nil(t, err)
+		keys, err = resolvers.JwtKeysResolver(ctx)
+		assert.Nil(t, err)
+		assert.Len(t, keys, 2)
+		assert.LessOrEqual(t, *keys[1].ExpiresAt, time.Now().Add(time.Hour).Unix())
+
+		// pending key is removed right away
+		pendingKey, err := resolvers.GenerateJwtKeyResolver(ctx, nil)
+		assert.Nil(t, err)
+		_, err = resolvers.RetireJwtKeyResolver(ctx, model.JWTKeyInput{ID: pendingKey.ID})
+		assert.Nil(t, err)
+		keys, err = resolvers.JwtKeysResolver(ctx)
+		assert.Nil(t, err)
+		assert.Len(t, keys, 2)
+
+		// revoked key stops verifying tokens right away
+		_, err = resolvers.RevokeJwtKeyResolver(ctx, model.JWTKeyInput{ID: key.ID})
+		assert.NotNil(t, err, "active key should not be revoked")
+		_, err = resolvers.RevokeJwtKeyResolver(ctx, model.JWTKeyInput{ID: oldKeyID})
+		assert.Nil(t, err)
+		_, err = token.VerifyJWTToken(oldToken)
+		assert.NotNil(t, err)
+		_, err = token.VerifyJWTToken(legacyToken)
+		assert.NotNil(t, err)
+		keys, err = resolvers.JwtKeysResolver(ctx)
+		assert.Nil(t, err)
+		assert.Len(t, keys, 1)
+
+		// changing secret via _update_env retires the active key with grace period
+		newSecret := uuid.New().String()
+		_, err = resolvers.UpdateEnvResolver(ctx, model.UpdateEnvInput{
+			JwtSecret: &newSecret,
+		})
+		assert.Nil(t, err)
+		assert.NotEqual(t, key.ID, envstore.EnvInMemoryStoreObj.GetStringStoreEnvVariable(constants.EnvKeyJwtKeyID))
+		_, err = token.VerifyJWTToken(newToken)
+		assert.Nil(t, err)
+	})
+}
@@ -5,8 +5,10 @@ import (
 
 	"github.com/authorizerdev/authorizer/server/constants"
 	"github.com/authorizerdev/authorizer/server/db"
+	"github.com/authorizerdev/authorizer/server/envstore"
 	"github.com/authorizerdev/authorizer/server/graph/model"
 	"github.com/authorizerdev/authorizer/server/resolvers"
+	"github.com/authorizerdev/authorizer/server/token"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -54,6 +56,15 @@ func loginTests(t *testing.T, s TestSetup) {
 		assert.Nil(t, err, "login successful")
 		assert.NotNil(t, loginRes.AccessToken, "access token should not be empty")
 
+		assert.NotNil(t, loginRes.IDToken, "id token should not be empty")
+		claims, err := token.VerifyJWTToken(*loginRes.IDToken)
+		assert.Nil(t, err)
+		assert.Equal(t, constants.TokenTypeIDToken, claims["token_type"])
+		assert.Equal(t, loginRes.User.ID, claims["sub"])
+		assert.Equal(t, envstore.EnvInMemoryStoreObj.GetStringStoreEnvVariable(constants.EnvKeyClientID), claims["aud"])
+		assert.Equal(t, email, claims["email"])
+		assert.NotNil(t, claims["auth_time"])
+
 		cleanData(email)
 	})
 }
@@ -0,0 +1,63 @@
+package test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/authorizerdev/authorizer/server/constants"
+	"github.com/authorizerdev/authorizer/server/db"
+	"github.com/authorizerdev/authorizer/server/envstore"
+	"github.com/authorizerdev/authorizer/server/graph/model"
+	"github.com/authorizerdev/authorizer/server/resolvers"
+	"github.com/stretchr/testify/assert"
+)
+
+func logoutAllTests(t *testing.T, s TestSetup) {
+	t.Helper()
+	t.Run(`should logout user from all sessions`, func(t *testing.T) {
+		req, ctx := createContext(s)
+		email := "logout_all." + s.TestInfo.Email
+		accessTokenCookieName := envstore.EnvInMemoryStoreObj.GetStringStoreEnvVariable(constants.EnvKeyCookieName) + ".access_token"
+
+		_, err := resolvers.SignupResolver(ctx, model.SignUpInput{
+			Email:           email,
+			Password:        s.TestInfo.Password,
+			ConfirmPassword: s.TestInfo.Password,
+		})
+		assert.Nil(t, err)
+
+		verificationRequest, err := db.Provider.GetVerificationRequestByEmail(email, constants.VerificationTypeBasicAuthSignup)
+		assert.Nil(t, err)
+		verifyRes, err := resolvers.VerifyEmailResolver(ctx, model.VerifyEmailInput{
+			Token: verificationRequest.Token,
+		})
+		assert.Nil(t, err)
+
+		loginRes, err := resolvers.LoginResolver(ctx, model.LoginInput{
+			Email:    email,
+			Password: s.TestInfo.Password,
+		})
+		assert.Nil(t, err)
+
+		req.Header.Del("Cookie")
+		_, err = resolvers.LogoutAllResolver(ctx)
+		assert.NotNil(t, err, "unauthorized")
+
+		req.Header.Set("Cookie", fmt.Sprintf("%s=%s", accessTokenCookieName, *loginRes.AccessToken))
+		_, err = resolvers.LogoutAllResolver(ctx)
+		assert.Nil(t, err)
+
+		_, err = resolvers.ProfileResolver(ctx)
+		assert.NotNil(t, err, "unauthorized")
+		req.Header.Set("Cookie", fmt.Sprintf("%s=%s", accessTokenCookieName, *verifyRes.AccessToken))
+		_, err = resolvers.ProfileResolver(ctx)
+		assert.NotNil(t, err, "unauthorized")
+
+		sessions, err := db.Provider.ListSessionsByUserID(verifyRes.User.ID)
+		assert.Nil(t, err)
+		assert.Len(t, sessions, 0)
+
+		req.Header.Del("Cookie")
+		cleanData(email)
+	})
+}
@@ -0,0 +1,333 @@
+package test
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/authorizerdev/authorizer/server/constants"
+	"github.com/authorizerdev/authorizer/server/crypto"
+	"github.com/authorizerdev/authorizer/server/db"
+	"github.com/authorizerdev/authorizer/server/envstore"
+	"github.com/authorizerdev/authorizer/server/oauth"
+	"github.com/authorizerdev/authorizer/server/resolvers"
+	"github.com/golang-jwt/jwt"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/oauth2"
+)
+
+func oauthProvidersTests(t *testing.T, s TestSetup) {
+	t.Helper()
+	t.Run(`should list enabled oauth providers and start login with them`, func(t *testing.T) {
+		_, ctx := createContext(s)
+		applePrivateKey, applePublicKey, err := crypto.NewKeyPair("ES256")
+		assert.Nil(t, err)
+
+		env := map[string]string{
+			constants.EnvKeyDiscordClientID:     "discord-client-id",
+			constants.EnvKeyDiscordClientSecret: "discord-client-secret",
+			constants.EnvKeyTwitterClientID:     "twitter-client-id",
+			constants.EnvKeyTwitterClientSecret: "twitter-client-secret",
+			constants.EnvKeyAppleClientID:       "com.example.app",
+			constants.EnvKeyAppleTeamID:         "TEAMID1234",
+			constants.EnvKeyAppleKeyID:          "KEYID12345",
+			// private key with escaped new lines, as it is usually set in env
+			constants.EnvKeyApplePrivateKey: strings.ReplaceAll(applePrivateKey, "\n", `\n`),
+		}
+		for key, value := range env {
+			envstore.EnvInMemoryStoreObj.UpdateEnvVariable(constants.StringStoreIdentifier, key, value)
+		}
+		defer func() {
+			for key := range env {
+				envstore.EnvInMemoryStoreObj.UpdateEnvVariable(constants.StringStoreIdentifier, key, "")
+			}
+			oauth.OAuthProviders = oauth.OAuthProvider{}
+		}()
+
+		clientSecret, err := oauth.GetAppleClientSecret()
+		assert.Nil(t, err)
+		claims := jwt.StandardClaims{}
+		parsedClientSecret, err := jwt.ParseWithClaims(clientSecret, &claims, func(token *jwt.Token) (interface{}, error) {
+			return crypto.ParsePublicKey("ES256", applePublicKey)
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, "ES256", parsedClientSecret.Header["alg"])
+		assert.Equal(t, "KEYID12345", parsedClientSecret.Header["kid"])
+		assert.Equal(t, "TEAMID1234", claims.Issuer)
+		assert.Equal(t, "com.example.app", claims.Subject)
+		assert.Equal(t, "https://appleid.apple.com", claims.Audience)
+
+		// apple is not initialized, as it requires the discovery document from apple
+		envstore.EnvInMemoryStoreObj.UpdateEnvVariable(constants.StringStoreIdentifier, constants.EnvKeyAppleClientID, "")
+		oauth.InitOAuth()
+		envstore.EnvInMemoryStoreObj.UpdateEnvVariable(constants.StringStoreIdentifier, constants.EnvKeyAppleClientID, "com.example.app")
+		assert.Nil(t, oauth.OAuthProviders.AppleConfig)
+
+		// meta lists only the initialized providers, apple is configured in env but not initialized
+		meta, err := resolvers.MetaResolver(ctx)
+		assert.Nil(t, err)
+		assert.Equal(t, []string{constants.SignupMethodDiscord, constants.SignupMethodTwitter}, meta.OauthProviders)
+		assert.False(t, meta.IsGoogleLoginEnabled)
+
+		serverURL := "http://" + s.Server.Listener.Addr().String()
+		client := &http.Client{
+			CheckRedirect: func(req *http.Request, via []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		}
+		oauthLogin := func(provider string) *http.Response {
+			res, err := client.Get(serverURL + "/oauth_login/" + provider + "?redirectURL=" + url.QueryEscape("http://localhost:3000/app"))
+			assert.Nil(t, err)
+			res.Body.Close()
+			return res
+		}
+
+		res := oauthLogin(constants.SignupMethodDiscord)
+		assert.Equal(t, http.StatusTemporaryRedirect, res.StatusCode)
+		location, err := url.Parse(res.Header.Get("Location"))
+		assert.Nil(t, err)
+		assert.Equal(t, "discord.com", location.Host)
+		assert.Equal(t, "discord-client-id", location.Query().Get("client_id"))
+		assert.Equal(t, "identify email", location.Query().Get("scope"))
+		assert.True(t, strings.HasSuffix(location.Query().Get("redirect_uri"), "/oauth_callback/discord"))
+
+		res = oauthLogin(constants.SignupMethodTwitter)
+		assert.Equal(t, http.StatusTemporaryRedirect, res.StatusCode)
+		location, err = url.Parse(res.Header.Get("Location"))
+		assert.Nil(t, err)
+		assert.Equal(t, "twitter.com", location.Host)
+		assert.NotEmpty(t, location.Query().Get("code_challenge"))
+		assert.Equal(t, crypto.CodeChallengeMethodS256, location.Query().Get("code_challenge_method"))
+
+		res = oauthLogin(constants.SignupMethodGitlab)
+		assert.Equal(t, 422, res.StatusCode)
+
+		// redirect url should be allowed for the app
+		allowedOrigins := envstore.EnvInMemoryStoreObj.GetSliceStoreEnvVariable(constants.EnvKeyAllowedOrigins)
+		envstore.EnvInMemoryStoreObj.UpdateEnvVariable(constants.SliceStoreIdentifier, constants.EnvKeyAllowedOrigins, []string{"localhost:3000"})
+		for _, redirectURL := range []string{"", "https://example.com/app", "javascript://localhost:3000/%0aalert(1)", "http://localhost:3000/app___admin"} {
+			res, err = client.Get(serverURL + "/oauth_login/" + constants.SignupMethodDiscord + "?redirectURL=" + url.QueryEscape(redirectURL))
+			assert.Nil(t, err)
+			res.Body.Close()
+			assert.Equal(t, http.StatusBadRequest, res.StatusCode, redirectURL)
+		}
+		res = oauthLogin(constants.SignupMethodDiscord)
+		assert.Equal(t, http.StatusTemporaryRedirect, res.StatusCode)
+		envstore.EnvInMemoryStoreObj.UpdateEnvVariable(constants.SliceStoreIdentifier, constants.EnvKeyAllowedOrigins, allowedOrigins)
+
+		// apple posts the callback with form_post response mode
+		res, err = client.PostForm(serverURL+"/oauth_callback/"+constants.SignupMethodApple, url.Values{
+			"state": {"invalid-state"},
+			"code":  {"invalid-code"},
+		})
+		assert.Nil(t, err)
+		res.Body.Close()
+		assert.Equal(t, http.StatusBadRequest, res.StatusCode)
+	})
+
+	t.Run(`should map user info of oauth providers and require verified email`, func(t *testing.T) {
+		mockServer := newMockOIDCServer(t)
+		defer mockServer.Close()
+		// apple client secret is a JWT signed by authorizer, hence it is not checked by mock server
+		mockServer.ClientSecret = ""
+
+		applePrivateKey, _, err := crypto.NewKeyPair("ES256")
+		assert.Nil(t, err)
+		env := map[string]string{
+			constants.EnvKeyAppleClientID:   mockServer.ClientID,
+			constants.EnvKeyAppleTeamID:     "TEAMID1234",
+			constants.EnvKeyAppleKeyID:      "KEYID12345",
+			constants.EnvKeyApplePrivateKey: applePrivateKey,
+		}
+		for key, value := range env {
+			envstore.EnvInMemoryStoreObj.UpdateEnvVariable(constants.StringStoreIdentifier, key, value)
+		}
+
+		oidcProvider, err := oauth.DiscoverOIDCProvider(mockServer.URL)
+		assert.Nil(t, err)
+		userInfoURLs := map[string]string{}
+		for provider, userInfoURL := range oauth.UserInfoURLs {
+			userInfoURLs[provider] = userInfoURL
+			oauth.UserInfoURLs[provider] = mockServer.URL + "/userinfo"
+		}
+		newConfig := func() *oauth2.Config {
+			return &oauth2.Config{
+				ClientID:     mockServer.ClientID,
+				ClientSecret: mockServer.ClientSecret,
+				Endpoint:     oidcProvider.Endpoint(),
+			}
+		}
+		oauth.OAuthProviders = oauth.OAuthProvider{
+			AppleConfig:     newConfig(),
+			MicrosoftConfig: newConfig(),
+			GitlabConfig:    newConfig(),
+			LinkedinConfig:  newConfig(),
+			DiscordConfig:   newConfig(),
+			TwitterConfig:   newConfig(),
+		}
+		oauth.OIDCProviders = oauth.OIDCProvider{
+			AppleOIDC:     oidcProvider,
+			MicrosoftOIDC: oidcProvider,
+		}
+		defer func() {
+			for key := range env {
+				envstore.EnvInMemoryStoreObj.UpdateEnvVariable(constants.StringStoreIdentifier, key, "")
+			}
+			for provider, userInfoURL := range userInfoURLs {
+				oauth.UserInfoURLs[provider] = userInfoURL
+			}
+			oauth.OAuthProviders = oauth.OAuthProvider{}
+			oauth.OIDCProviders = oauth.OIDCProvider{}
+		}()
+
+		serverURL := "http://" + s.Server.Listener.Addr().String()
+		client := &http.Client{
+			CheckRedirect: func(req *http.Request, via []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		}
+		// oauthLogin starts the login with provider and returns the state sent to provider
+		oauthLogin := func(provider string) string {
+			res, err := client.Get(serverURL + "/oauth_login/" + provider + "?redirectURL=" + url.QueryEscape("http://localhost:3000/app"))
+			assert.Nil(t, err)
+			res.Body.Close()
+			assert.Equal(t, http.StatusTemporaryRedirect, res.StatusCode)
+			location, err := url.Parse(res.Header.Get("Location"))
+			assert.Nil(t, err)
+			return location.Query().Get("state")
+		}
+		oauthCallback := func(provider, code string, form url.Values) *http.Response {
+			form.Set("state", oauthLogin(provider))
+			form.Set("code", code)
+			var res *http.Response
+			var err error
+			if provider == constants.SignupMethodApple {
+				// apple posts the callback with form_post response mode
+				res, err = client.PostForm(serverURL+"/oauth_callback/"+provider, form)
+			} else {
+				res, err = client.Get(serverURL + "/oauth_callback/" + provider + "?" + form.Encode())
+			}
+			assert.Nil(t, err)
+			res.Body.Close()
+			return res
+		}
+
+		now := time.Now().Unix()
+		cases := []struct {
+			provider string
+			// user info sent by provider, it is returned in id_token and from userinfo endpoint
+			verified   func(email string) map[string]interface{}
+			unverified func(email string) map[string]interface{}
+			form       url.Values
+			status     int
+			// expected profile fields of user, i.e. given name, family name, nickname and picture
+			expected []string
+		}{
+			{
+				provider: constants.SignupMethodApple,
+				verified: func(email string) map[string]interface{} {
+					return map[string]interface{}{"email": email, "email_verified": "true"}
+				},
+				unverified: func(email string) map[string]interface{} {
+					return map[string]interface{}{"email": email}
+				},
+				// name of user is only posted with the first authorization
+				form:     url.Values{"user": {`{"name":{"firstName":"Jane","lastName":"Doe"}}`}},
+				status:   http.StatusSeeOther,
+				expected: []string{"Jane", "Doe", "", ""},
+			},
+			{
+				provider: constants.SignupMethodMicrosoft,
+				verified: func(email string) map[string]interface{} {
+					return map[string]interface{}{"email": email, "xms_edov": true, "given_name": "Jane", "family_name": "Doe"}
+				},
+				// mail and upn are not verified by microsoft, hence they are never used
+				unverified: func(email string) map[string]interface{} {
+					return map[string]interface{}{"email": email, "mail": email, "upn": email}
+				},
+				status:   http.StatusTemporaryRedirect,
+				expected: []string{"Jane", "Doe", "", ""},
+			},
+			{
+				provider: constants.SignupMethodGitlab,
+				verified: func(email string) map[string]interface{} {
+					return map[string]interface{}{"name": "Jane Doe", "username": "jane", "email": email, "confirmed_at": "2024-01-01T00:00:00Z", "avatar_url": "https://gitlab.com/jane.png"}
+				},
+				unverified: func(email string) map[string]interface{} {
+					return map[string]interface{}{"name": "Jane Doe", "username": "jane", "email": email}
+				},
+				status:   http.StatusTemporaryRedirect,
+				expected: []string{"Jane", "Doe", "jane", "https://gitlab.com/jane.png"},
+			},
+			{
+				provider: constants.SignupMethodLinkedin,
+				verified: func(email string) map[string]interface{} {
+					return map[string]interface{}{"given_name": "Jane", "family_name": "Doe", "picture": "https://linkedin.com/jane.png", "email": email, "email_verified": true}
+				},
+				unverified: func(email string) map[string]interface{} {
+					return map[string]interface{}{"given_name": "Jane", "family_name": "Doe", "email": email}
+				},
+				status:   http.StatusTemporaryRedirect,
+				expected: []string{"Jane", "Doe", "", "https://linkedin.com/jane.png"},
+			},
+			{
+				provider: constants.SignupMethodDiscord,
+				verified: func(email string) map[string]interface{} {
+					return map[string]interface{}{"id": "1234", "username": "jane", "global_name": "Jane", "avatar": "abcd", "email": email, "verified": true}
+				},
+				unverified: func(email string) map[string]interface{} {
+					return map[string]interface{}{"id": "1234", "username": "jane", "email": email, "verified": false}
+				},
+				status:   http.StatusTemporaryRedirect,
+				expected: []string{"Jane", "", "jane", "https://cdn.discordapp.com/avatars/1234/abcd.png"},
+			},
+			{
+				provider: constants.SignupMethodTwitter,
+				verified: func(email string) map[string]interface{} {
+					return map[string]interface{}{"data": map[string]interface{}{"name": "Jane", "username": "jane", "profile_image_url": "https://twitter.com/jane.png", "confirmed_email": email}}
+				},
+				// email is not returned if app does not have the permission to request it
+				unverified: func(email string) map[string]interface{} {
+					return map[string]interface{}{"data": map[string]interface{}{"name": "Jane", "username": "jane"}}
+				},
+				status:   http.StatusTemporaryRedirect,
+				expected: []string{"Jane", "", "jane", "https://twitter.com/jane.png"},
+			},
+		}
+
+		for _, c := range cases {
+			email := fmt.Sprintf("%s_%d_OAuth_tester@yopmail.com", c.provider, now)
+			defer cleanData(strings.ToLower(email))
+			form := c.form
+			if form == nil {
+				form = url.Values{}
+			}
+
+			mockServer.AddCode(c.provider+"-unverified-code", c.unverified(email))
+			res := oauthCallback(c.provider, c.provider+"-unverified-code", form)
+			assert.Equal(t, http.StatusBadRequest, res.StatusCode, c.provider)
+			_, err := db.Provider.GetUserByEmail(strings.ToLower(email))
+			assert.NotNil(t, err, c.provider)
+
+			mockServer.AddCode(c.provider+"-verified-code", c.verified(email))
+			res = oauthCallback(c.provider, c.provider+"-verified-code", form)
+			assert.Equal(t, c.status, res.StatusCode, c.provider)
+			user, err := db.Provider.GetUserByEmail(strings.ToLower(email))
+			assert.Nil(t, err, c.provider)
+			assert.Equal(t, c.provider, user.SignupMethods)
+			assert.NotNil(t, user.EmailVerifiedAt, c.provider)
+			profile := []string{}
+			for _, value := range []*string{user.GivenName, user.FamilyName, user.Nickname, user.Picture} {
+				if value == nil {
+					profile = append(profile, "")
+					continue
+				}
+				profile = append(profile, *value)
+			}
+			assert.Equal(t, c.expected, profile, c.provider)
+		}
+	})
+}
@@ -0,0 +1,343 @@
+package test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/authorizerdev/authorizer/server/constants"
+	"github.com/authorizerdev/authorizer/server/crypto"
+	"github.com/authorizerdev/authorizer/server/db"
+	"github.com/authorizerdev/authorizer/server/envstore"
+	"github.com/authorizerdev/authorizer/server/graph/model"
+	"github.com/authorizerdev/authorizer/server/resolvers"
+	"github.com/authorizerdev/authorizer/server/utils"
+	"github.com/golang-jwt/jwt"
+	"github.com/stretchr/testify/assert"
+)
+
+// mockOIDCServer is the OpenID Connect provider used in tests,
+// it issues id_token with the claims registered for the authorization code
+// and returns the same claims from /userinfo for the access token
+type mockOIDCServer struct {
+	*httptest.Server
+	ClientID string
+	// ClientSecret is not checked if empty, e.g. for apple client secret JWT
+	ClientSecret string
+
+	mutex    sync.Mutex
+	claims   map[string]map[string]interface{}
+	userInfo map[string]map[string]interface{}
+}
+
+// AddCode registers the claims of id_token issued for the authorization code
+func (m *mockOIDCServer) AddCode(code string, claims map[string]interface{}) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.claims[code] = claims
+}
+
+func newMockOIDCServer(t *testing.T) *mockOIDCServer {
+	privateKey, publicKey, err := crypto.NewRSAKey()
+	assert.Nil(t, err)
+	signingKey, err := crypto.ParsePrivateKey("RS256", privateKey)
+	assert.Nil(t, err)
+	jwk, err := crypto.GetPubJWK("RS256", "mock-key", publicKey)
+	assert.Nil(t, err)
+
+	m := &mockOIDCServer{
+		ClientID:     "mock-client-id",
+		ClientSecret: "mock-client-secret",
+		claims:       map[string]map[string]interface{}{},
+		userInfo:     map[string]map[string]interface{}{},
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"issuer":                                m.URL,
+			"authorization_endpoint":                m.URL + "/authorize",
+			"token_endpoint":                        m.URL + "/token",
+			"jwks_uri":                              m.URL + "/jwks",
+			"id_token_signing_alg_values_supported": []string{"RS256"},
+		})
+	})
+	mux.HandleFunc("/jwks", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"keys": []interface{}{jwk},
+		})
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		clientID, clientSecret, ok := r.BasicAuth()
+		if !ok {
+			clientID, clientSecret = r.FormValue("client_id"), r.FormValue("client_secret")
+		}
+		if clientID != m.ClientID || (m.ClientSecret != "" && clientSecret != m.ClientSecret) {
+			w.WriteHeader(http.StatusUnauthorized)
+			json.NewEncoder(w).Encode(map[string]string{"error": "invalid_client"})
+			return
+		}
+
+		m.mutex.Lock()
+		claims, ok := m.claims[r.FormValue("code")]
+		delete(m.claims, r.FormValue("code"))
+		accessToken := "access-token-" + r.FormValue("code")
+		if ok {
+			m.userInfo[accessToken] = claims
+		}
+		m.mutex.Unlock()
+		if !ok {
+			w.WriteHeader(http.StatusBadRequest)
+			json.NewEncoder(w).Encode(map[string]string{"error": "invalid_grant"})
+			return
+		}
+
+		idTokenClaims := jwt.MapClaims{
+			"iss": m.URL,
+			"sub": fmt.Sprintf("%v", claims["email"]),
+			"aud": m.ClientID,
+			"iat": time.Now().Unix(),
+			"exp": time.Now().Add(5 * time.Minute).Unix(),
+		}
+		for key, value := range claims {
+			idTokenClaims[key] = value
+		}
+		idToken := jwt.NewWithClaims(jwt.SigningMethodRS256, idTokenClaims)
+		idToken.Header["kid"] = "mock-key"
+		rawIDToken, err := idToken.SignedString(signingKey)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"access_token": accessToken,
+			"token_type":   "Bearer",
+			"expires_in":   300,
+			"id_token":     rawIDToken,
+		})
+	})
+	mux.HandleFunc("/userinfo", func(w http.ResponseWriter, r *http.Request) {
+		m.mutex.Lock()
+		userInfo, ok := m.userInfo[strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")]
+		m.mutex.Unlock()
+		if !ok {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(userInfo)
+	})
+	m.Server = httptest.NewServer(mux)
+
+	return m
+}
+
+func oidcProviderTests(t *testing.T, s TestSetup) {
+	t.Helper()
+	t.Run(`should login with generic oidc provider`, func(t *testing.T) {
+		mockServer := newMockOIDCServer(t)
+		defer mockServer.Close()
+
+		req, ctx := createContext(s)
+		_, err := resolvers.AddOidcProviderResolver(ctx, model.AddOIDCProviderInput{
+			Name:         "corporate",
+			IssuerURL:    mockServer.URL,
+			ClientID:     mockServer.ClientID,
+			ClientSecret: mockServer.ClientSecret,
+		})
+		assert.NotNil(t, err, "unauthorized")
+
+		h, err := utils.EncryptPassword(envstore.EnvInMemoryStoreObj.GetStringStoreEnvVariable(constants.EnvKeyAdminSecret))
+		assert.Nil(t, err)
+		req.Header.Set("Cookie", fmt.Sprintf("%s=%s", envstore.EnvInMemoryStoreObj.GetStringStoreEnvVariable(constants.EnvKeyAdminCookieName), h))
+
+		invalidInputs := []model.AddOIDCProviderInput{
+			// reserved name of built in signup method
+			{Name: constants.SignupMethodGoogle, IssuerURL: mockServer.URL, ClientID: mockServer.ClientID, ClientSecret: mockServer.ClientSecret},
+			// name is not url safe
+			{Name: "Corporate IdP", IssuerURL: mockServer.URL, ClientID: mockServer.ClientID, ClientSecret: mockServer.ClientSecret},
+			// issuer without discovery document
+			{Name: "corporate", IssuerURL: s.Server.URL + "/unknown", ClientID: mockServer.ClientID, ClientSecret: mockServer.ClientSecret},
+			// missing openid scope
+			{Name: "corporate", IssuerURL: mockServer.URL, ClientID: mockServer.ClientID, ClientSecret: mockServer.ClientSecret, Scopes: []string{"email"}},
+			// unknown user field in claim mapping
+			{Name: "corporate", IssuerURL: mockServer.URL, ClientID: mockServer.ClientID, ClientSecret: mockServer.ClientSecret, ClaimMapping: map[string]interface{}{"password": "pwd"}},
+			// missing client secret
+			{Name: "corporate", IssuerURL: mockServer.URL, ClientID: mockServer.ClientID},
+		}
+		for _, input := range invalidInputs {
+			_, err = resolvers.AddOidcProviderResolver(ctx, input)
+			assert.NotNil(t, err)
+		}
+
+		displayName := "Corporate IdP"
+		oidcProvider, err := resolvers.AddOidcProviderResolver(ctx, model.AddOIDCProviderInput{
+			Name:         "corporate",
+			DisplayName:  &displayName,
+			IssuerURL:    mockServer.URL + "/",
+			ClientID:     mockServer.ClientID,
+			ClientSecret: mockServer.ClientSecret,
+			ClaimMapping: map[string]interface{}{
+				"given_name": "first_name",
+			},
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, mockServer.URL, oidcProvider.IssuerURL)
+		assert.Equal(t, constants.OIDCProviderDefaultScopes, oidcProvider.Scopes)
+		assert.Equal(t, "first_name", oidcProvider.ClaimMapping["given_name"])
+		assert.Equal(t, "family_name", oidcProvider.ClaimMapping["family_name"])
+		assert.True(t, oidcProvider.Enabled)
+		assert.False(t, oidcProvider.TrustEmail)
+		defer resolvers.DeleteOidcProviderResolver(ctx, model.OIDCProviderInput{ID: oidcProvider.ID})
+
+		dbOIDCProvider, err := db.Provider.GetOIDCProviderByName("corporate")
+		assert.Nil(t, err)
+		assert.NotEqual(t, mockServer.ClientSecret, dbOIDCProvider.ClientSecret)
+
+		_, err = resolvers.AddOidcProviderResolver(ctx, model.AddOIDCProviderInput{
+			Name:         "corporate",
+			IssuerURL:    mockServer.URL,
+			ClientID:     mockServer.ClientID,
+			ClientSecret: mockServer.ClientSecret,
+		})
+		assert.NotNil(t, err, "name already exists")
+
+		oidcProviders, err := resolvers.OidcProvidersResolver(ctx, nil)
+		assert.Nil(t, err)
+		assert.Equal(t, int64(1), oidcProviders.Pagination.Total)
+
+		meta, err := resolvers.MetaResolver(ctx)
+		assert.Nil(t, err)
+		assert.Len(t, meta.OidcProviders, 1)
+		assert.Equal(t, "corporate", meta.OidcProviders[0].Name)
+		assert.Equal(t, displayName, meta.OidcProviders[0].DisplayName)
+
+		serverURL := "http://" + s.Server.Listener.Addr().String()
+		redirectURL := "http://localhost:3000/app"
+		client := &http.Client{
+			CheckRedirect: func(req *http.Request, via []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		}
+
+		// oauthLogin starts the login with provider and returns the state sent to provider
+		oauthLogin := func(provider string) string {
+			res, err := client.Get(serverURL + "/oauth_login/" + provider + "?redirectURL=" + url.QueryEscape(redirectURL))
+			assert.Nil(t, err)
+			defer res.Body.Close()
+			assert.Equal(t, http.StatusTemporaryRedirect, res.StatusCode)
+
+			location, err := url.Parse(res.Header.Get("Location"))
+			assert.Nil(t, err)
+			assert.True(t, strings.HasPrefix(location.String(), mockServer.URL+"/authorize"))
+			assert.Equal(t, mockServer.ClientID, location.Query().Get("client_id"))
+			assert.Equal(t, "openid profile email", location.Query().Get("scope"))
+			assert.True(t, strings.HasSuffix(location.Query().Get("redirect_uri"), "/oauth_callback/"+provider))
+			return location.Query().Get("state")
+		}
+
+		oauthCallback := func(provider, state, code string) *http.Response {
+			res, err := client.Get(serverURL + "/oauth_callback/" + provider + "?" + url.Values{"state": {state}, "code": {code}}.Encode())
+			assert.Nil(t, err)
+			res.Body.Close()
+			return res
+		}
+
+		email := fmt.Sprintf("%d_OIDC_tester@yopmail.com", time.Now().Unix())
+		defer cleanData(strings.ToLower(email))
+
+		mockServer.AddCode("unverified-code", map[string]interface{}{
+			"email":          email,
+			"email_verified": false,
+		})
+		res := oauthCallback("corporate", oauthLogin("corporate"), "unverified-code")
+		assert.Equal(t, http.StatusBadRequest, res.StatusCode)
+		_, err = db.Provider.GetUserByEmail(strings.ToLower(email))
+		assert.NotNil(t, err, "user should not be created with unverified email")
+
+		// email without email_verified claim is not trusted by default
+		mockServer.AddCode("missing-verified-code", map[string]interface{}{
+			"email": email,
+		})
+		res = oauthCallback("corporate", oauthLogin("corporate"), "missing-verified-code")
+		assert.Equal(t, http.StatusBadRequest, res.StatusCode)
+		_, err = db.Provider.GetUserByEmail(strings.ToLower(email))
+		assert.NotNil(t, err, "user should not be created without email_verified claim")
+
+		// state issued for other provider should be rejected
+		res = oauthCallback(constants.SignupMethodGoogle, oauthLogin("corporate"), "valid-code")
+		assert.Equal(t, http.StatusBadRequest, res.StatusCode)
+
+		mockServer.AddCode("valid-code", map[string]interface{}{
+			"email":          email,
+			"email_verified": true,
+			"first_name":     "Jane",
+			"family_name":    "Doe",
+		})
+		res = oauthCallback("corporate", oauthLogin("corporate"), "valid-code")
+		assert.Equal(t, http.StatusTemporaryRedirect, res.StatusCode)
+		assert.Equal(t, redirectURL, res.Header.Get("Location"))
+
+		user, err := db.Provider.GetUserByEmail(strings.ToLower(email))
+		assert.Nil(t, err)
+		assert.Equal(t, "corporate", user.SignupMethods)
+		assert.NotNil(t, user.EmailVerifiedAt)
+		assert.Equal(t, "Jane", *user.GivenName)
+		assert.Equal(t, "Doe", *user.FamilyName)
+
+		trustEmail := true
+		updatedOIDCProvider, err := resolvers.UpdateOidcProviderResolver(ctx, model.UpdateOIDCProviderInput{
+			ID:         oidcProvider.ID,
+			TrustEmail: &trustEmail,
+		})
+		assert.Nil(t, err)
+		assert.True(t, updatedOIDCProvider.TrustEmail)
+		mockServer.AddCode("trusted-code", map[string]interface{}{
+			"email": email,
+		})
+		res = oauthCallback("corporate", oauthLogin("corporate"), "trusted-code")
+		assert.Equal(t, http.StatusTemporaryRedirect, res.StatusCode)
+		// explicitly unverified email is still rejected
+		mockServer.AddCode("trusted-unverified-code", map[string]interface{}{
+			"email":          email,
+			"email_verified": "false",
+		})
+		res = oauthCallback("corporate", oauthLogin("corporate"), "trusted-unverified-code")
+		assert.Equal(t, http.StatusBadRequest, res.StatusCode)
+
+		enabled := false
+		_, err = resolvers.UpdateOidcProviderResolver(ctx, model.UpdateOIDCProviderInput{
+			ID:           oidcProvider.ID,
+			ClaimMapping: map[string]interface{}{"email": 1},
+		})
+		assert.NotNil(t, err, "invalid claim name")
+		updatedOIDCProvider, err = resolvers.UpdateOidcProviderResolver(ctx, model.UpdateOIDCProviderInput{
+			ID:      oidcProvider.ID,
+			Enabled: &enabled,
+		})
+		assert.Nil(t, err)
+		assert.False(t, updatedOIDCProvider.Enabled)
+
+		meta, err = resolvers.MetaResolver(ctx)
+		assert.Nil(t, err)
+		assert.Len(t, meta.OidcProviders, 0)
+
+		res, err = client.Get(serverURL + "/oauth_login/corporate?redirectURL=" + url.QueryEscape(redirectURL))
+		assert.Nil(t, err)
+		res.Body.Close()
+		assert.Equal(t, 422, res.StatusCode)
+
+		_, err = resolvers.DeleteOidcProviderResolver(ctx, model.OIDCProviderInput{ID: oidcProvider.ID})
+		assert.Nil(t, err)
+		_, err = db.Provider.GetOIDCProviderByName("corporate")
+		assert.NotNil(t, err)
+	})
+}
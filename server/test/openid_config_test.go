@@ -0,0 +1,32 @@
+package test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/authorizerdev/authorizer/server/constants"
+	"github.com/authorizerdev/authorizer/server/envstore"
+	"github.com/stretchr/testify/assert"
+)
+
+func openIDConfigurationTests(t *testing.T, s TestSetup) {
+	t.Helper()
+	t.Run(`should return openid configuration`, func(t *testing.T) {
+		authorizerURL := envstore.EnvInMemoryStoreObj.GetStringStoreEnvVariable(constants.EnvKeyAuthorizerURL)
+		envstore.EnvInMemoryStoreObj.UpdateEnvVariable(constants.StringStoreIdentifier, constants.EnvKeyAuthorizerURL, "http://localhost:8080")
+		defer envstore.EnvInMemoryStoreObj.UpdateEnvVariable(constants.StringStoreIdentifier, constants.EnvKeyAuthorizerURL, authorizerURL)
+
+		res, err := http.Get("http://" + s.Server.Listener.Addr().String() + "/.well-known/openid-configuration")
+		assert.Nil(t, err)
+		defer res.Body.Close()
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+
+		var data map[string]interface{}
+		assert.Nil(t, json.NewDecoder(res.Body).Decode(&data))
+		assert.Equal(t, "http://localhost:8080", data["issuer"])
+		assert.Equal(t, "http://localhost:8080/.well-known/jwks.json", data["jwks_uri"])
+		assert.Equal(t, "http://localhost:8080/userinfo", data["userinfo_endpoint"])
+		assert.Contains(t, data["id_token_signing_alg_values_supported"], envstore.EnvInMemoryStoreObj.GetStringStoreEnvVariable(constants.EnvKeyJwtType))
+	})
+}
@@ -0,0 +1,94 @@
+package test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/authorizerdev/authorizer/server/constants"
+	"github.com/authorizerdev/authorizer/server/crypto"
+	"github.com/authorizerdev/authorizer/server/db"
+	"github.com/authorizerdev/authorizer/server/graph/model"
+	"github.com/authorizerdev/authorizer/server/resolvers"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func TestPasswordHash(t *testing.T) {
+	for _, algorithm := range []string{crypto.PasswordHashAlgorithmArgon2id, crypto.PasswordHashAlgorithmBcrypt, crypto.PasswordHashAlgorithmScrypt} {
+		hash, err := crypto.HashPassword(algorithm, "Test@123")
+		assert.Nil(t, err)
+		assert.True(t, crypto.VerifyPassword(hash, "Test@123"), algorithm)
+		assert.False(t, crypto.VerifyPassword(hash, "Test@1234"), algorithm)
+		assert.False(t, crypto.PasswordNeedsRehash(algorithm, hash), algorithm)
+	}
+
+	hash, err := crypto.HashPassword(crypto.PasswordHashAlgorithmArgon2id, "Test@123")
+	assert.Nil(t, err)
+	assert.True(t, strings.HasPrefix(hash, "$argon2id$v=19$m=19456,t=2,p=1$"))
+	assert.True(t, crypto.PasswordNeedsRehash(crypto.PasswordHashAlgorithmScrypt, hash))
+
+	// weaker parameters than the current ones
+	weakHash := strings.Replace(hash, "t=2", "t=1", 1)
+	assert.True(t, crypto.PasswordNeedsRehash(crypto.PasswordHashAlgorithmArgon2id, weakHash))
+	assert.False(t, crypto.VerifyPassword(weakHash, "Test@123"))
+
+	bcryptHash, err := bcrypt.GenerateFromPassword([]byte("Test@123"), bcrypt.MinCost)
+	assert.Nil(t, err)
+	assert.True(t, crypto.VerifyPassword(string(bcryptHash), "Test@123"))
+	assert.True(t, crypto.PasswordNeedsRehash(crypto.PasswordHashAlgorithmBcrypt, string(bcryptHash)))
+	assert.True(t, crypto.PasswordNeedsRehash(crypto.PasswordHashAlgorithmArgon2id, string(bcryptHash)))
+
+	assert.False(t, crypto.VerifyPassword("invalid", "Test@123"))
+	assert.False(t, crypto.VerifyPassword("$argon2id$v=19$m=0,t=0,p=0$c2FsdA$a2V5", "Test@123"))
+	_, err = crypto.HashPassword("md5", "Test@123")
+	assert.NotNil(t, err)
+}
+
+func passwordHashTests(t *testing.T, s TestSetup) {
+	t.Helper()
+	t.Run(`should rehash outdated password hash on login`, func(t *testing.T) {
+		_, ctx := createContext(s)
+		email := "password_hash." + s.TestInfo.Email
+		resolvers.SignupResolver(ctx, model.SignUpInput{
+			Email:           email,
+			Password:        s.TestInfo.Password,
+			ConfirmPassword: s.TestInfo.Password,
+		})
+		verificationRequest, err := db.Provider.GetVerificationRequestByEmail(email, constants.VerificationTypeBasicAuthSignup)
+		assert.Nil(t, err)
+		_, err = resolvers.VerifyEmailResolver(ctx, model.VerifyEmailInput{
+			Token: verificationRequest.Token,
+		})
+		assert.Nil(t, err)
+
+		user, err := db.Provider.GetUserByEmail(email)
+		assert.Nil(t, err)
+		assert.True(t, strings.HasPrefix(*user.Password, "$argon2id$"))
+
+		// existing bcrypt hash
+		bcryptHash, err := bcrypt.GenerateFromPassword([]byte(s.TestInfo.Password), bcrypt.DefaultCost)
+		assert.Nil(t, err)
+		password := string(bcryptHash)
+		user.Password = &password
+		_, err = db.Provider.UpdateUser(user)
+		assert.Nil(t, err)
+
+		_, err = resolvers.LoginResolver(ctx, model.LoginInput{
+			Email:    email,
+			Password: s.TestInfo.Password,
+		})
+		assert.Nil(t, err)
+
+		user, err = db.Provider.GetUserByEmail(email)
+		assert.Nil(t, err)
+		assert.True(t, strings.HasPrefix(*user.Password, "$argon2id$"))
+
+		_, err = resolvers.LoginResolver(ctx, model.LoginInput{
+			Email:    email,
+			Password: s.TestInfo.Password,
+		})
+		assert.Nil(t, err)
+
+		cleanData(email)
+	})
+}
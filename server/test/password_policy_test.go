@@ -0,0 +1,122 @@
+package test
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/authorizerdev/authorizer/server/constants"
+	"github.com/authorizerdev/authorizer/server/db"
+	"github.com/authorizerdev/authorizer/server/envstore"
+	"github.com/authorizerdev/authorizer/server/graph/model"
+	"github.com/authorizerdev/authorizer/server/passwordpolicy"
+	"github.com/authorizerdev/authorizer/server/resolvers"
+	"github.com/stretchr/testify/assert"
+)
+
+func passwordPolicyTests(t *testing.T, s TestSetup) {
+	t.Helper()
+	t.Run(`should enforce password policy on signup and reset password`, func(t *testing.T) {
+		_, ctx := createContext(s)
+		email := "password_policy." + s.TestInfo.Email
+
+		envstore.EnvInMemoryStoreObj.UpdateEnvVariable(constants.StringStoreIdentifier, constants.EnvKeyPasswordMinLength, "8")
+		envstore.EnvInMemoryStoreObj.UpdateEnvVariable(constants.SliceStoreIdentifier, constants.EnvKeyPasswordCharacterClasses, []string{constants.PasswordCharacterClassUppercase, constants.PasswordCharacterClassNumber})
+		envstore.EnvInMemoryStoreObj.UpdateEnvVariable(constants.StringStoreIdentifier, constants.EnvKeyPasswordHistorySize, "2")
+		defer func() {
+			envstore.EnvInMemoryStoreObj.UpdateEnvVariable(constants.StringStoreIdentifier, constants.EnvKeyPasswordMinLength, "")
+			envstore.EnvInMemoryStoreObj.UpdateEnvVariable(constants.SliceStoreIdentifier, constants.EnvKeyPasswordCharacterClasses, []string{})
+			envstore.EnvInMemoryStoreObj.UpdateEnvVariable(constants.StringStoreIdentifier, constants.EnvKeyPasswordHistorySize, "")
+		}()
+
+		emailPassword := strings.Split(email, "@")[0] + "X1"
+		for _, password := range []string{"Short1", "lowercase123", "NoNumbers", emailPassword} {
+			_, err := resolvers.SignupResolver(ctx, model.SignUpInput{
+				Email:           email,
+				Password:        password,
+				ConfirmPassword: password,
+			})
+			assert.NotNil(t, err, password)
+		}
+
+		password := "Password1"
+		_, err := resolvers.SignupResolver(ctx, model.SignUpInput{
+			Email:           email,
+			Password:        password,
+			ConfirmPassword: password,
+		})
+		assert.Nil(t, err)
+
+		resetPassword := func(newPassword string) error {
+			_, err := resolvers.ForgotPasswordResolver(ctx, model.ForgotPasswordInput{
+				Email: email,
+			})
+			assert.Nil(t, err)
+			verificationRequest, err := db.Provider.GetVerificationRequestByEmail(email, constants.VerificationTypeForgotPassword)
+			assert.Nil(t, err)
+			_, err = resolvers.ResetPasswordResolver(ctx, model.ResetPasswordInput{
+				Token:           verificationRequest.Token,
+				Password:        newPassword,
+				ConfirmPassword: newPassword,
+			})
+			return err
+		}
+
+		assert.NotNil(t, resetPassword(password), "current password can not be reused")
+		assert.Nil(t, resetPassword("Password2"))
+		assert.NotNil(t, resetPassword(password), "previous password can not be reused")
+
+		cleanData(email)
+	})
+
+	t.Run(`should reject breached passwords`, func(t *testing.T) {
+		_, ctx := createContext(s)
+		email := "breached_password." + s.TestInfo.Email
+
+		breachedPasswords := []string{"Password1", "Qwerty123", "Letmein1"}
+		for i := 0; i < 100; i++ {
+			breachedPasswords = append(breachedPasswords, fmt.Sprintf("Breached%d", i))
+		}
+		lines := []string{}
+		for i, password := range breachedPasswords {
+			sum := sha1.Sum([]byte(password))
+			lines = append(lines, fmt.Sprintf("%s:%d", strings.ToUpper(hex.EncodeToString(sum[:])), i+1))
+		}
+		sort.Strings(lines)
+
+		file, err := ioutil.TempFile("", "breached_passwords")
+		assert.Nil(t, err)
+		defer os.Remove(file.Name())
+		_, err = file.WriteString(strings.Join(lines, "\n") + "\n")
+		assert.Nil(t, err)
+		file.Close()
+
+		envstore.EnvInMemoryStoreObj.UpdateEnvVariable(constants.StringStoreIdentifier, constants.EnvKeyBreachedPasswordsFile, file.Name())
+		defer envstore.EnvInMemoryStoreObj.UpdateEnvVariable(constants.StringStoreIdentifier, constants.EnvKeyBreachedPasswordsFile, "")
+
+		for _, password := range breachedPasswords {
+			breached, err := passwordpolicy.IsBreached(password)
+			assert.Nil(t, err)
+			assert.True(t, breached, password)
+		}
+		for _, password := range []string{"NotBreached1", "Breached100", s.TestInfo.Password} {
+			breached, err := passwordpolicy.IsBreached(password)
+			assert.Nil(t, err)
+			assert.False(t, breached, password)
+		}
+
+		_, err = resolvers.SignupResolver(ctx, model.SignUpInput{
+			Email:           email,
+			Password:        "Qwerty123",
+			ConfirmPassword: "Qwerty123",
+		})
+		assert.NotNil(t, err)
+
+		cleanData(email)
+	})
+}
@@ -0,0 +1,160 @@
+package test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/authorizerdev/authorizer/server/constants"
+	"github.com/authorizerdev/authorizer/server/db"
+	"github.com/authorizerdev/authorizer/server/envstore"
+	"github.com/authorizerdev/authorizer/server/graph/model"
+	"github.com/authorizerdev/authorizer/server/resolvers"
+	"github.com/authorizerdev/authorizer/server/sms"
+	"github.com/stretchr/testify/assert"
+)
+
+func phoneOtpTests(t *testing.T, s TestSetup) {
+	t.Helper()
+	t.Run(`should verify phone number and login with phone otp`, func(t *testing.T) {
+		localSender := sms.NewLocalSender()
+		sms.Provider = localSender
+		defer func() {
+			sms.Provider = nil
+		}()
+
+		req, ctx := createContext(s)
+		email := "phone_otp." + s.TestInfo.Email
+		phoneNumber := fmt.Sprintf("+1415%07d", time.Now().UnixNano()%10000000)
+		resolvers.SignupResolver(ctx, model.SignUpInput{
+			Email:           email,
+			Password:        s.TestInfo.Password,
+			ConfirmPassword: s.TestInfo.Password,
+		})
+		verificationRequest, err := db.Provider.GetVerificationRequestByEmail(email, constants.VerificationTypeBasicAuthSignup)
+		assert.Nil(t, err)
+		verifyRes, err := resolvers.VerifyEmailResolver(ctx, model.VerifyEmailInput{
+			Token: verificationRequest.Token,
+		})
+		assert.Nil(t, err)
+		req.Header.Set("Cookie", fmt.Sprintf("%s=%s", envstore.EnvInMemoryStoreObj.GetStringStoreEnvVariable(constants.EnvKeyCookieName)+".access_token", *verifyRes.AccessToken))
+
+		_, err = resolvers.SendPhoneVerificationOtpResolver(ctx)
+		assert.NotNil(t, err, "phone number is not set")
+
+		_, err = resolvers.UpdateProfileResolver(ctx, model.UpdateProfileInput{
+			PhoneNumber: &phoneNumber,
+		})
+		assert.Nil(t, err)
+
+		// unverified phone number can not be used for login
+		_, err = resolvers.PhoneLoginResolver(ctx, model.PhoneLoginInput{PhoneNumber: phoneNumber})
+		assert.NotNil(t, err)
+
+		_, err = resolvers.SendPhoneVerificationOtpResolver(ctx)
+		assert.Nil(t, err)
+		otp := localSender.LastMessage(phoneNumber)[:6]
+		_, err = resolvers.VerifyPhoneNumberResolver(ctx, model.OTPInput{Otp: "000000"})
+		assert.NotNil(t, err)
+		_, err = resolvers.VerifyPhoneNumberResolver(ctx, model.OTPInput{Otp: otp})
+		assert.Nil(t, err)
+
+		profile, err := resolvers.ProfileResolver(ctx)
+		assert.Nil(t, err)
+		assert.True(t, *profile.PhoneNumberVerified)
+
+		_, err = resolvers.PhoneLoginResolver(ctx, model.PhoneLoginInput{PhoneNumber: "4155552671"})
+		assert.NotNil(t, err, "invalid phone number")
+
+		_, err = resolvers.PhoneLoginResolver(ctx, model.PhoneLoginInput{PhoneNumber: phoneNumber})
+		assert.Nil(t, err)
+		otp = localSender.LastMessage(phoneNumber)[:6]
+		loginRes, err := resolvers.VerifyPhoneOtpResolver(ctx, model.VerifyPhoneOTPInput{PhoneNumber: phoneNumber, Otp: otp})
+		assert.Nil(t, err)
+		assert.NotNil(t, loginRes.AccessToken)
+		assert.Equal(t, email, loginRes.User.Email)
+
+		// code can be used only once
+		_, err = resolvers.VerifyPhoneOtpResolver(ctx, model.VerifyPhoneOTPInput{PhoneNumber: phoneNumber, Otp: otp})
+		assert.NotNil(t, err)
+
+		// otp verification is throttled per phone number
+		envstore.EnvInMemoryStoreObj.UpdateEnvVariable(constants.SliceStoreIdentifier, constants.EnvKeyRateLimits, []string{"verify_phone_otp:email:2/1m"})
+		_, err = resolvers.VerifyPhoneOtpResolver(ctx, model.VerifyPhoneOTPInput{PhoneNumber: phoneNumber, Otp: otp})
+		assert.Equal(t, constants.ErrorCodeTooManyRequests, errorCode(err))
+		envstore.EnvInMemoryStoreObj.UpdateEnvVariable(constants.SliceStoreIdentifier, constants.EnvKeyRateLimits, []string{})
+
+		// phone number verified by the user can not be used by another user
+		otherEmail := "phone_otp_other." + s.TestInfo.Email
+		_, err = resolvers.SignupResolver(ctx, model.SignUpInput{
+			Email:           otherEmail,
+			Password:        s.TestInfo.Password,
+			ConfirmPassword: s.TestInfo.Password,
+		})
+		assert.Nil(t, err)
+		verificationRequest, err = db.Provider.GetVerificationRequestByEmail(otherEmail, constants.VerificationTypeBasicAuthSignup)
+		assert.Nil(t, err)
+		otherVerifyRes, err := resolvers.VerifyEmailResolver(ctx, model.VerifyEmailInput{
+			Token: verificationRequest.Token,
+		})
+		assert.Nil(t, err)
+		req.Header.Set("Cookie", fmt.Sprintf("%s=%s", envstore.EnvInMemoryStoreObj.GetStringStoreEnvVariable(constants.EnvKeyCookieName)+".access_token", *otherVerifyRes.AccessToken))
+		_, err = resolvers.UpdateProfileResolver(ctx, model.UpdateProfileInput{
+			PhoneNumber: &phoneNumber,
+		})
+		assert.NotNil(t, err)
+
+		// phone verification otp is throttled per phone number
+		otherPhoneNumber := phoneNumber + "2"
+		_, err = resolvers.UpdateProfileResolver(ctx, model.UpdateProfileInput{
+			PhoneNumber: &otherPhoneNumber,
+		})
+		assert.Nil(t, err)
+		envstore.EnvInMemoryStoreObj.UpdateEnvVariable(constants.SliceStoreIdentifier, constants.EnvKeyRateLimits, []string{"send_phone_verification_otp:email:1/1m"})
+		_, err = resolvers.SendPhoneVerificationOtpResolver(ctx)
+		assert.Nil(t, err)
+		_, err = resolvers.SendPhoneVerificationOtpResolver(ctx)
+		assert.Equal(t, constants.ErrorCodeTooManyRequests, errorCode(err))
+		envstore.EnvInMemoryStoreObj.UpdateEnvVariable(constants.SliceStoreIdentifier, constants.EnvKeyRateLimits, []string{})
+		req.Header.Set("Cookie", fmt.Sprintf("%s=%s", envstore.EnvInMemoryStoreObj.GetStringStoreEnvVariable(constants.EnvKeyCookieName)+".access_token", *verifyRes.AccessToken))
+		cleanData(otherEmail)
+
+		// changed phone number needs to be verified again
+		newPhoneNumber := phoneNumber + "1"
+		_, err = resolvers.UpdateProfileResolver(ctx, model.UpdateProfileInput{
+			PhoneNumber: &newPhoneNumber,
+		})
+		assert.Nil(t, err)
+		profile, err = resolvers.ProfileResolver(ctx)
+		assert.Nil(t, err)
+		assert.False(t, *profile.PhoneNumberVerified)
+
+		cleanData(email)
+	})
+}
+
+func TestWebhookSender(t *testing.T) {
+	var body map[string]string
+	var authorization string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorization = r.Header.Get("Authorization")
+		json.NewDecoder(r.Body).Decode(&body)
+		if body["to"] == "+10000000000" {
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	defer server.Close()
+
+	sender := sms.NewWebhookSender(server.URL, "Bearer secret")
+	err := sender.SendSMS("+14155552671", "123456 is your code")
+	assert.Nil(t, err)
+	assert.Equal(t, "Bearer secret", authorization)
+	assert.Equal(t, "+14155552671", body["to"])
+	assert.Equal(t, "123456 is your code", body["message"])
+
+	err = sender.SendSMS("+10000000000", "123456 is your code")
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,125 @@
+package test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/authorizerdev/authorizer/server/constants"
+	"github.com/authorizerdev/authorizer/server/db"
+	"github.com/authorizerdev/authorizer/server/envstore"
+	"github.com/authorizerdev/authorizer/server/graph/model"
+	"github.com/authorizerdev/authorizer/server/resolvers"
+	"github.com/authorizerdev/authorizer/server/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// errorCode returns the code extension of graphql error
+func errorCode(err error) string {
+	var gqlErr *gqlerror.Error
+	if !errors.As(err, &gqlErr) {
+		return ""
+	}
+	code, _ := gqlErr.Extensions["code"].(string)
+	return code
+}
+
+func rateLimitTests(t *testing.T, s TestSetup) {
+	t.Helper()
+	t.Run(`should lock account after failed logins and unlock with admin secret`, func(t *testing.T) {
+		req, ctx := createContext(s)
+		email := "rate_limit_lockout." + s.TestInfo.Email
+		resolvers.SignupResolver(ctx, model.SignUpInput{
+			Email:           email,
+			Password:        s.TestInfo.Password,
+			ConfirmPassword: s.TestInfo.Password,
+		})
+		verificationRequest, err := db.Provider.GetVerificationRequestByEmail(email, constants.VerificationTypeBasicAuthSignup)
+		assert.Nil(t, err)
+		_, err = resolvers.VerifyEmailResolver(ctx, model.VerifyEmailInput{
+			Token: verificationRequest.Token,
+		})
+		assert.Nil(t, err)
+
+		envstore.EnvInMemoryStoreObj.UpdateEnvVariable(constants.StringStoreIdentifier, constants.EnvKeyAccountLockoutThreshold, "3")
+		defer envstore.EnvInMemoryStoreObj.UpdateEnvVariable(constants.StringStoreIdentifier, constants.EnvKeyAccountLockoutThreshold, "")
+
+		for i := 0; i < 2; i++ {
+			_, err = resolvers.LoginResolver(ctx, model.LoginInput{
+				Email:    email,
+				Password: "wrong_password",
+			})
+			assert.NotNil(t, err)
+			assert.Equal(t, "", errorCode(err))
+		}
+
+		_, err = resolvers.LoginResolver(ctx, model.LoginInput{
+			Email:    email,
+			Password: "wrong_password",
+		})
+		assert.Equal(t, constants.ErrorCodeAccountLocked, errorCode(err))
+
+		// correct password is also rejected while account is locked
+		_, err = resolvers.LoginResolver(ctx, model.LoginInput{
+			Email:    email,
+			Password: s.TestInfo.Password,
+		})
+		assert.Equal(t, constants.ErrorCodeAccountLocked, errorCode(err))
+
+		_, err = resolvers.UnlockUserResolver(ctx, model.UnlockUserInput{
+			Email: email,
+		})
+		assert.NotNil(t, err, "unauthorized")
+
+		h, err := utils.EncryptPassword(envstore.EnvInMemoryStoreObj.GetStringStoreEnvVariable(constants.EnvKeyAdminSecret))
+		assert.Nil(t, err)
+		req.Header.Set("Cookie", fmt.Sprintf("%s=%s", envstore.EnvInMemoryStoreObj.GetStringStoreEnvVariable(constants.EnvKeyAdminCookieName), h))
+		_, err = resolvers.UnlockUserResolver(ctx, model.UnlockUserInput{
+			Email: email,
+		})
+		assert.Nil(t, err)
+		req.Header.Del("Cookie")
+
+		res, err := resolvers.LoginResolver(ctx, model.LoginInput{
+			Email:    email,
+			Password: s.TestInfo.Password,
+		})
+		assert.Nil(t, err)
+		assert.NotNil(t, res.AccessToken)
+
+		cleanData(email)
+	})
+
+	t.Run(`should throttle requests per email and ip`, func(t *testing.T) {
+		req, ctx := createContext(s)
+		email := "rate_limit_throttle." + s.TestInfo.Email
+		for i := 0; i < 3; i++ {
+			_, err := resolvers.ForgotPasswordResolver(ctx, model.ForgotPasswordInput{
+				Email: email,
+			})
+			assert.NotEqual(t, constants.ErrorCodeTooManyRequests, errorCode(err))
+		}
+		_, err := resolvers.ForgotPasswordResolver(ctx, model.ForgotPasswordInput{
+			Email: email,
+		})
+		assert.Equal(t, constants.ErrorCodeTooManyRequests, errorCode(err))
+
+		envstore.EnvInMemoryStoreObj.UpdateEnvVariable(constants.SliceStoreIdentifier, constants.EnvKeyRateLimits, []string{"login:ip:2/1m"})
+		defer envstore.EnvInMemoryStoreObj.UpdateEnvVariable(constants.SliceStoreIdentifier, constants.EnvKeyRateLimits, []string{})
+		req.Header.Set("X-Real-Ip", "10.0.0.1")
+		defer req.Header.Del("X-Real-Ip")
+		for i := 0; i < 2; i++ {
+			_, err = resolvers.LoginResolver(ctx, model.LoginInput{
+				Email:    fmt.Sprintf("%d.%s", i, email),
+				Password: s.TestInfo.Password,
+			})
+			assert.NotEqual(t, constants.ErrorCodeTooManyRequests, errorCode(err))
+		}
+		_, err = resolvers.LoginResolver(ctx, model.LoginInput{
+			Email:    "2." + email,
+			Password: s.TestInfo.Password,
+		})
+		assert.Equal(t, constants.ErrorCodeTooManyRequests, errorCode(err))
+	})
+}
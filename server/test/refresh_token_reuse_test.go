@@ -0,0 +1,113 @@
+package test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/authorizerdev/authorizer/server/constants"
+	"github.com/authorizerdev/authorizer/server/db"
+	"github.com/authorizerdev/authorizer/server/envstore"
+	"github.com/authorizerdev/authorizer/server/graph/model"
+	"github.com/authorizerdev/authorizer/server/resolvers"
+	"github.com/authorizerdev/authorizer/server/sessionstore"
+	"github.com/authorizerdev/authorizer/server/token"
+	"github.com/stretchr/testify/assert"
+)
+
+func refreshTokenReuseTests(t *testing.T, s TestSetup) {
+	t.Helper()
+	t.Run(`should revoke token family on refresh token reuse`, func(t *testing.T) {
+		_, ctx := createContext(s)
+		email := "reuse." + s.TestInfo.Email
+		resolvers.SignupResolver(ctx, model.SignUpInput{
+			Email:           email,
+			Password:        s.TestInfo.Password,
+			ConfirmPassword: s.TestInfo.Password,
+		})
+		verificationRequest, err := db.Provider.GetVerificationRequestByEmail(email, constants.VerificationTypeBasicAuthSignup)
+		assert.Nil(t, err)
+		_, err = resolvers.VerifyEmailResolver(ctx, model.VerifyEmailInput{
+			Token: verificationRequest.Token,
+		})
+		assert.Nil(t, err)
+		user, err := db.Provider.GetUserByEmail(email)
+		assert.Nil(t, err)
+
+		createSession := func() *token.Token {
+			authToken, err := token.CreateAuthToken(user, []string{"user"}, token.AuthTokenOptions{})
+			assert.Nil(t, err)
+			sessionstore.SetUserSession(user.ID, authToken.FingerPrint, authToken.RefreshToken.Token)
+			return authToken
+		}
+		stolenSession := createSession()
+		otherSession := createSession()
+
+		clientID := envstore.EnvInMemoryStoreObj.GetStringStoreEnvVariable(constants.EnvKeyClientID)
+		refresh := func(refreshToken string) (int, map[string]interface{}) {
+			res, err := http.PostForm("http://"+s.Server.Listener.Addr().String()+"/oauth/token", url.Values{
+				"grant_type":    {constants.GrantTypeRefreshToken},
+				"client_id":     {clientID},
+				"refresh_token": {refreshToken},
+			})
+			assert.Nil(t, err)
+			defer res.Body.Close()
+			var data map[string]interface{}
+			json.NewDecoder(res.Body).Decode(&data)
+			return res.StatusCode, data
+		}
+
+		status, data := refresh(stolenSession.RefreshToken.Token)
+		assert.Equal(t, http.StatusOK, status)
+		rotatedRefreshToken := data["refresh_token"].(string)
+		rotatedAccessToken := data["access_token"].(string)
+
+		oldClaims, err := token.VerifyJWTToken(stolenSession.RefreshToken.Token)
+		assert.Nil(t, err)
+		rotatedClaims, err := token.VerifyJWTToken(rotatedRefreshToken)
+		assert.Nil(t, err)
+		assert.Equal(t, oldClaims["fid"], rotatedClaims["fid"], "rotated token should stay in the family")
+		assert.False(t, token.IsRefreshTokenReused(rotatedClaims))
+		assert.False(t, token.IsRefreshTokenReused(oldClaims), "rotated token should not be reused within grace period")
+
+		accessClaims, err := token.VerifyJWTToken(rotatedAccessToken)
+		assert.Nil(t, err)
+		assert.True(t, token.IsAccessTokenActive(accessClaims))
+
+		// parallel request within grace period gets the latest session instead of revoking the family
+		status, data = refresh(stolenSession.RefreshToken.Token)
+		assert.Equal(t, http.StatusOK, status)
+		assert.Equal(t, rotatedRefreshToken, data["refresh_token"])
+		parallelAccessClaims, err := token.VerifyJWTToken(data["access_token"].(string))
+		assert.Nil(t, err)
+		assert.Equal(t, accessClaims["sid"], parallelAccessClaims["sid"])
+		assert.True(t, token.IsAccessTokenActive(accessClaims))
+
+		// grace period is over
+		familyKey := "refresh_token_family_" + token.GetRefreshTokenFamilyID(oldClaims)
+		var family map[string]interface{}
+		assert.Nil(t, json.Unmarshal([]byte(sessionstore.GetState(familyKey)), &family))
+		family["rotated_at"] = time.Now().Add(-time.Minute).Unix()
+		familyBytes, _ := json.Marshal(family)
+		sessionstore.SetState(familyKey, string(familyBytes), time.Hour)
+		assert.True(t, token.IsRefreshTokenReused(oldClaims))
+
+		// replaying the rotated token revokes the whole family
+		status, data = refresh(stolenSession.RefreshToken.Token)
+		assert.Equal(t, http.StatusBadRequest, status)
+		assert.Equal(t, "invalid_grant", data["error"])
+		assert.False(t, token.IsAccessTokenActive(accessClaims))
+
+		status, _ = refresh(rotatedRefreshToken)
+		assert.Equal(t, http.StatusBadRequest, status, "latest token of revoked family should not work")
+
+		// other families of the user are not affected
+		status, _ = refresh(otherSession.RefreshToken.Token)
+		assert.Equal(t, http.StatusOK, status)
+
+		sessionstore.DeleteAllUserSession(user.ID)
+		cleanData(email)
+	})
+}
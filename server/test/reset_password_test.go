@@ -1,10 +1,12 @@
 package test
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/authorizerdev/authorizer/server/constants"
 	"github.com/authorizerdev/authorizer/server/db"
+	"github.com/authorizerdev/authorizer/server/envstore"
 	"github.com/authorizerdev/authorizer/server/graph/model"
 	"github.com/authorizerdev/authorizer/server/resolvers"
 	"github.com/stretchr/testify/assert"
@@ -31,20 +33,58 @@ func resetPasswordTest(t *testing.T, s TestSetup) {
 
 		_, err = resolvers.ResetPasswordResolver(ctx, model.ResetPasswordInput{
 			Token:           verificationRequest.Token,
-			Password:        "test1",
-			ConfirmPassword: "test",
+			Password:        "Test@1234",
+			ConfirmPassword: "Test@123",
 		})
 
 		assert.NotNil(t, err, "passowrds don't match")
 
 		_, err = resolvers.ResetPasswordResolver(ctx, model.ResetPasswordInput{
 			Token:           verificationRequest.Token,
-			Password:        "test1",
-			ConfirmPassword: "test1",
+			Password:        "Test@1234",
+			ConfirmPassword: "Test@1234",
 		})
 
 		assert.Nil(t, err, "password changed successfully")
 
 		cleanData(email)
 	})
+
+	t.Run(`should revoke all sessions on password reset`, func(t *testing.T) {
+		email := "reset_password_sessions." + s.TestInfo.Email
+		req, ctx := createContext(s)
+		_, err := resolvers.SignupResolver(ctx, model.SignUpInput{
+			Email:           email,
+			Password:        s.TestInfo.Password,
+			ConfirmPassword: s.TestInfo.Password,
+		})
+		assert.Nil(t, err)
+
+		verificationRequest, err := db.Provider.GetVerificationRequestByEmail(email, constants.VerificationTypeBasicAuthSignup)
+		assert.Nil(t, err)
+		verifyRes, err := resolvers.VerifyEmailResolver(ctx, model.VerifyEmailInput{
+			Token: verificationRequest.Token,
+		})
+		assert.Nil(t, err)
+
+		_, err = resolvers.ForgotPasswordResolver(ctx, model.ForgotPasswordInput{
+			Email: email,
+		})
+		assert.Nil(t, err)
+		verificationRequest, err = db.Provider.GetVerificationRequestByEmail(email, constants.VerificationTypeForgotPassword)
+		assert.Nil(t, err)
+		_, err = resolvers.ResetPasswordResolver(ctx, model.ResetPasswordInput{
+			Token:           verificationRequest.Token,
+			Password:        "Test@1234",
+			ConfirmPassword: "Test@1234",
+		})
+		assert.Nil(t, err)
+
+		req.Header.Set("Cookie", fmt.Sprintf("%s=%s", envstore.EnvInMemoryStoreObj.GetStringStoreEnvVariable(constants.EnvKeyCookieName)+".access_token", *verifyRes.AccessToken))
+		_, err = resolvers.ProfileResolver(ctx)
+		assert.NotNil(t, err, "unauthorized")
+
+		req.Header.Del("Cookie")
+		cleanData(email)
+	})
 }
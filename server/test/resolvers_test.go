@@ -46,6 +46,8 @@ func TestResolvers(t *testing.T) {
 			adminSessionTests(t, s)
 			updateEnvTests(t, s)
 			envTests(t, s)
+			jwtKeysTests(t, s)
+			jwksTests(t, s)
 
 			// user tests
 			loginTests(t, s)
@@ -61,6 +63,28 @@ func TestResolvers(t *testing.T) {
 			logoutTests(t, s)
 			metaTests(t, s)
 			isValidJWTTests(t, s)
+			openIDConfigurationTests(t, s)
+			authorizeTests(t, s)
+			clientTests(t, s)
+			deviceCodeTests(t, s)
+			introspectTests(t, s)
+			refreshTokenReuseTests(t, s)
+			tokenExpiryTests(t, s)
+			accessTokenScriptTests(t, s)
+			totpTests(t, s)
+			webauthnTests(t, s)
+			verifyEmailOtpTests(t, s)
+			phoneOtpTests(t, s)
+			rateLimitTests(t, s)
+			passwordPolicyTests(t, s)
+			passwordHashTests(t, s)
+			importUsersTests(t, s)
+			auditLogTests(t, s)
+			webhookTests(t, s)
+			sessionsTests(t, s)
+			logoutAllTests(t, s)
+			oidcProviderTests(t, s)
+			oauthProvidersTests(t, s)
 		})
 	}
 }
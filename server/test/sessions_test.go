@@ -0,0 +1,123 @@
+package test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/authorizerdev/authorizer/server/constants"
+	"github.com/authorizerdev/authorizer/server/db"
+	"github.com/authorizerdev/authorizer/server/envstore"
+	"github.com/authorizerdev/authorizer/server/graph/model"
+	"github.com/authorizerdev/authorizer/server/resolvers"
+	"github.com/authorizerdev/authorizer/server/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func sessionsTests(t *testing.T, s TestSetup) {
+	t.Helper()
+	t.Run(`should list and revoke single session`, func(t *testing.T) {
+		req, ctx := createContext(s)
+		email := "sessions." + s.TestInfo.Email
+		accessTokenCookieName := envstore.EnvInMemoryStoreObj.GetStringStoreEnvVariable(constants.EnvKeyCookieName) + ".access_token"
+
+		_, err := resolvers.SignupResolver(ctx, model.SignUpInput{
+			Email:           email,
+			Password:        s.TestInfo.Password,
+			ConfirmPassword: s.TestInfo.Password,
+		})
+		assert.Nil(t, err)
+
+		req.Header.Set("User-Agent", "laptop")
+		verificationRequest, err := db.Provider.GetVerificationRequestByEmail(email, constants.VerificationTypeBasicAuthSignup)
+		assert.Nil(t, err)
+		laptopRes, err := resolvers.VerifyEmailResolver(ctx, model.VerifyEmailInput{
+			Token: verificationRequest.Token,
+		})
+		assert.Nil(t, err)
+
+		req.Header.Set("User-Agent", "phone")
+		phoneRes, err := resolvers.LoginResolver(ctx, model.LoginInput{
+			Email:    email,
+			Password: s.TestInfo.Password,
+		})
+		assert.Nil(t, err)
+
+		req.Header.Del("Cookie")
+		_, err = resolvers.SessionsResolver(ctx)
+		assert.NotNil(t, err, "unauthorized")
+
+		req.Header.Set("Cookie", fmt.Sprintf("%s=%s", accessTokenCookieName, *phoneRes.AccessToken))
+		sessions, err := resolvers.SessionsResolver(ctx)
+		assert.Nil(t, err)
+		assert.Len(t, sessions, 2)
+		laptopSessionID := ""
+		for _, session := range sessions {
+			assert.Equal(t, session.UserAgent == "phone", session.IsCurrent)
+			assert.NotZero(t, session.LastUsedAt)
+			if session.UserAgent == "laptop" {
+				laptopSessionID = session.ID
+			}
+		}
+		assert.NotEmpty(t, laptopSessionID)
+
+		res, err := resolvers.RevokeSessionResolver(ctx, model.RevokeSessionInput{
+			ID: laptopSessionID,
+		})
+		assert.Nil(t, err)
+		assert.NotEmpty(t, res.Message)
+
+		_, err = resolvers.RevokeSessionResolver(ctx, model.RevokeSessionInput{
+			ID: laptopSessionID,
+		})
+		assert.NotNil(t, err, "session not found")
+
+		sessions, err = resolvers.SessionsResolver(ctx)
+		assert.Nil(t, err)
+		assert.Len(t, sessions, 1)
+
+		// other session is still active
+		_, err = resolvers.ProfileResolver(ctx)
+		assert.Nil(t, err)
+
+		// tokens of revoked session are inactive
+		req.Header.Set("Cookie", fmt.Sprintf("%s=%s", accessTokenCookieName, *laptopRes.AccessToken))
+		_, err = resolvers.ProfileResolver(ctx)
+		assert.NotNil(t, err, "unauthorized")
+
+		_, err = resolvers.UserSessionsResolver(ctx, model.ListSessionsInput{
+			UserID: phoneRes.User.ID,
+		})
+		assert.NotNil(t, err, "unauthorized")
+
+		h, err := utils.EncryptPassword(envstore.EnvInMemoryStoreObj.GetStringStoreEnvVariable(constants.EnvKeyAdminSecret))
+		assert.Nil(t, err)
+		req.Header.Set("Cookie", fmt.Sprintf("%s=%s", envstore.EnvInMemoryStoreObj.GetStringStoreEnvVariable(constants.EnvKeyAdminCookieName), h))
+
+		sessions, err = resolvers.UserSessionsResolver(ctx, model.ListSessionsInput{
+			UserID: phoneRes.User.ID,
+		})
+		assert.Nil(t, err)
+		assert.Len(t, sessions, 1)
+		if len(sessions) == 1 {
+			assert.False(t, sessions[0].IsCurrent)
+			_, err = resolvers.RevokeUserSessionResolver(ctx, model.RevokeSessionInput{
+				ID: sessions[0].ID,
+			})
+			assert.Nil(t, err)
+		}
+
+		sessions, err = resolvers.UserSessionsResolver(ctx, model.ListSessionsInput{
+			UserID: phoneRes.User.ID,
+		})
+		assert.Nil(t, err)
+		assert.Len(t, sessions, 0)
+
+		req.Header.Set("Cookie", fmt.Sprintf("%s=%s", accessTokenCookieName, *phoneRes.AccessToken))
+		_, err = resolvers.ProfileResolver(ctx)
+		assert.NotNil(t, err, "unauthorized")
+
+		req.Header.Del("Cookie")
+		req.Header.Del("User-Agent")
+		cleanData(email)
+	})
+}
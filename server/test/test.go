@@ -69,7 +69,7 @@ func createContext(s TestSetup) (*http.Request, context.Context) {
 func testSetup() TestSetup {
 	testData := TestData{
 		Email:    fmt.Sprintf("%d_authorizer_tester@yopmail.com", time.Now().Unix()),
-		Password: "test",
+		Password: "Test@123",
 	}
 
 	envstore.EnvInMemoryStoreObj.UpdateEnvVariable(constants.StringStoreIdentifier, constants.EnvKeyEnvPath, "../../.env.sample")
@@ -84,6 +84,17 @@ func testSetup() TestSetup {
 	r.Use(middlewares.CORSMiddleware())
 
 	r.POST("/graphql", handlers.GraphqlHandler())
+	r.GET("/.well-known/openid-configuration", handlers.OpenIDConfigurationHandler())
+	r.GET("/.well-known/jwks.json", handlers.JWKsHandler())
+	r.GET("/authorize", middlewares.AuditMiddleware(constants.AuditActionAuthorize), handlers.AuthorizeHandler())
+	r.POST("/oauth/token", middlewares.AuditMiddleware(constants.AuditActionOAuthToken), handlers.OAuthTokenHandler())
+	r.POST("/oauth/device_authorization", middlewares.AuditMiddleware(constants.AuditActionDeviceAuthorization), handlers.DeviceAuthorizationHandler())
+	r.POST("/oauth/introspect", middlewares.AuditMiddleware(constants.AuditActionOAuthIntrospect), handlers.IntrospectHandler())
+	r.POST("/oauth/revoke", middlewares.AuditMiddleware(constants.AuditActionOAuthRevoke), handlers.RevokeHandler())
+	r.GET("/userinfo", middlewares.AuditMiddleware(constants.AuditActionUserInfo), handlers.UserInfoHandler())
+	r.GET("/oauth_login/:oauth_provider", middlewares.AuditMiddleware(constants.AuditActionOAuthLogin), handlers.OAuthLoginHandler())
+	r.GET("/oauth_callback/:oauth_provider", middlewares.AuditMiddleware(constants.AuditActionOAuthCallback), handlers.OAuthCallbackHandler())
+	r.POST("/oauth_callback/:oauth_provider", middlewares.AuditMiddleware(constants.AuditActionOAuthCallback), handlers.OAuthCallbackHandler())
 
 	server := httptest.NewServer(r)
 
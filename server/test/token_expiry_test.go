@@ -0,0 +1,115 @@
+package test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/authorizerdev/authorizer/server/constants"
+	"github.com/authorizerdev/authorizer/server/db/models"
+	"github.com/authorizerdev/authorizer/server/env"
+	"github.com/authorizerdev/authorizer/server/envstore"
+	"github.com/authorizerdev/authorizer/server/graph/model"
+	"github.com/authorizerdev/authorizer/server/resolvers"
+	"github.com/authorizerdev/authorizer/server/token"
+	"github.com/authorizerdev/authorizer/server/utils"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func tokenExpiryTests(t *testing.T, s TestSetup) {
+	t.Helper()
+	t.Run(`should configure token lifetimes`, func(t *testing.T) {
+		req, ctx := createContext(s)
+		h, err := utils.EncryptPassword(envstore.EnvInMemoryStoreObj.GetStringStoreEnvVariable(constants.EnvKeyAdminSecret))
+		assert.Nil(t, err)
+		req.Header.Set("Cookie", fmt.Sprintf("%s=%s", envstore.EnvInMemoryStoreObj.GetStringStoreEnvVariable(constants.EnvKeyAdminCookieName), h))
+
+		invalidExpiryTime := "thirty minutes"
+		_, err = resolvers.UpdateEnvResolver(ctx, model.UpdateEnvInput{
+			AccessTokenExpiryTime: &invalidExpiryTime,
+		})
+		assert.NotNil(t, err)
+
+		_, err = resolvers.UpdateEnvResolver(ctx, model.UpdateEnvInput{
+			RoleAccessTokenExpiryTimes: []string{"admin"},
+		})
+		assert.NotNil(t, err)
+
+		accessTokenExpiryTime := "1h"
+		refreshTokenExpiryTime := "48h"
+		verificationTokenExpiryTime := "10m"
+		sessionInactivityTimeout := "24h"
+		_, err = resolvers.UpdateEnvResolver(ctx, model.UpdateEnvInput{
+			AccessTokenExpiryTime:       &accessTokenExpiryTime,
+			RefreshTokenExpiryTime:      &refreshTokenExpiryTime,
+			VerificationTokenExpiryTime: &verificationTokenExpiryTime,
+			SessionInactivityTimeout:    &sessionInactivityTimeout,
+			RoleAccessTokenExpiryTimes:  []string{"admin:5m"},
+			RoleRefreshTokenExpiryTimes: []string{"admin:1h"},
+		})
+		assert.Nil(t, err)
+
+		user := models.User{
+			ID:    uuid.New().String(),
+			Email: s.TestInfo.Email,
+		}
+		assertExpiresIn := func(expected time.Duration, expiresAt int64) {
+			assert.InDelta(t, time.Now().Add(expected).Unix(), expiresAt, 5)
+		}
+
+		_, expiresAt, err := token.CreateAccessToken(user, []string{"user"}, token.AuthTokenOptions{})
+		assert.Nil(t, err)
+		assertExpiresIn(time.Hour, expiresAt)
+
+		// admin role gets shorter lifetime
+		_, expiresAt, err = token.CreateAccessToken(user, []string{"user", "admin"}, token.AuthTokenOptions{})
+		assert.Nil(t, err)
+		assertExpiresIn(5*time.Minute, expiresAt)
+
+		_, expiresAt, err = token.CreateIDToken(user, []string{"admin"}, token.AuthTokenOptions{})
+		assert.Nil(t, err)
+		assertExpiresIn(5*time.Minute, expiresAt)
+
+		// refresh token lifetime is limited by inactivity timeout
+		_, expiresAt, err = token.CreateRefreshToken(user, []string{"user"}, token.AuthTokenOptions{})
+		assert.Nil(t, err)
+		assertExpiresIn(24*time.Hour, expiresAt)
+
+		_, expiresAt, err = token.CreateRefreshToken(user, []string{"admin"}, token.AuthTokenOptions{})
+		assert.Nil(t, err)
+		assertExpiresIn(time.Hour, expiresAt)
+
+		// client lifetime is used when it is shorter
+		client := &models.Client{
+			ClientID:              uuid.New().String(),
+			AccessTokenExpiresIn:  60,
+			RefreshTokenExpiresIn: 7200,
+		}
+		_, expiresAt, err = token.CreateAccessToken(user, []string{"user"}, token.AuthTokenOptions{Client: client})
+		assert.Nil(t, err)
+		assertExpiresIn(time.Minute, expiresAt)
+
+		_, expiresAt, err = token.CreateRefreshToken(user, []string{"user"}, token.AuthTokenOptions{Client: client})
+		assert.Nil(t, err)
+		assertExpiresIn(2*time.Hour, expiresAt)
+
+		assert.Equal(t, 10*time.Minute, env.GetVerificationTokenExpiryTime())
+
+		emptyExpiryTime := ""
+		_, err = resolvers.UpdateEnvResolver(ctx, model.UpdateEnvInput{
+			AccessTokenExpiryTime:       &emptyExpiryTime,
+			RefreshTokenExpiryTime:      &emptyExpiryTime,
+			VerificationTokenExpiryTime: &emptyExpiryTime,
+			SessionInactivityTimeout:    &emptyExpiryTime,
+			RoleAccessTokenExpiryTimes:  []string{},
+			RoleRefreshTokenExpiryTimes: []string{},
+		})
+		assert.Nil(t, err)
+
+		_, expiresAt, err = token.CreateAccessToken(user, []string{"admin"}, token.AuthTokenOptions{})
+		assert.Nil(t, err)
+		assertExpiresIn(30*time.Minute, expiresAt)
+		assert.Equal(t, 30*time.Minute, env.GetVerificationTokenExpiryTime())
+	})
+}
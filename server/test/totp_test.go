@@ -0,0 +1,168 @@
+package test
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/authorizerdev/authorizer/server/constants"
+	"github.com/authorizerdev/authorizer/server/crypto"
+	"github.com/authorizerdev/authorizer/server/db"
+	"github.com/authorizerdev/authorizer/server/envstore"
+	"github.com/authorizerdev/authorizer/server/graph/model"
+	"github.com/authorizerdev/authorizer/server/resolvers"
+	"github.com/authorizerdev/authorizer/server/token"
+	"github.com/stretchr/testify/assert"
+)
+
+func totpTests(t *testing.T, s TestSetup) {
+	t.Helper()
+	t.Run(`should require totp for login once enrolled`, func(t *testing.T) {
+		req, ctx := createContext(s)
+		email := "totp." + s.TestInfo.Email
+		resolvers.SignupResolver(ctx, model.SignUpInput{
+			Email:           email,
+			Password:        s.TestInfo.Password,
+			ConfirmPassword: s.TestInfo.Password,
+		})
+
+		_, err := resolvers.EnrollTotpResolver(ctx)
+		assert.NotNil(t, err, "unauthorized")
+
+		verificationRequest, err := db.Provider.GetVerificationRequestByEmail(email, constants.VerificationTypeBasicAuthSignup)
+		assert.Nil(t, err)
+		verifyRes, err := resolvers.VerifyEmailResolver(ctx, model.VerifyEmailInput{
+			Token: verificationRequest.Token,
+		})
+		assert.Nil(t, err)
+		req.Header.Set("Cookie", fmt.Sprintf("%s=%s", envstore.EnvInMemoryStoreObj.GetStringStoreEnvVariable(constants.EnvKeyCookieName)+".access_token", *verifyRes.AccessToken))
+
+		enrollRes, err := resolvers.EnrollTotpResolver(ctx)
+		assert.Nil(t, err)
+		assert.Len(t, enrollRes.BackupCodes, 10)
+		provisioningURI, err := url.Parse(enrollRes.ProvisioningURI)
+		assert.Nil(t, err)
+		assert.Equal(t, "otpauth", provisioningURI.Scheme)
+		assert.Equal(t, enrollRes.Secret, provisioningURI.Query().Get("secret"))
+
+		// secret is stored encrypted
+		user, err := db.Provider.GetUserByEmail(email)
+		assert.Nil(t, err)
+		assert.NotNil(t, user.TotpSecret)
+		assert.NotContains(t, *user.TotpSecret, enrollRes.Secret)
+
+		login := func() *model.AuthResponse {
+			res, err := resolvers.LoginResolver(ctx, model.LoginInput{
+				Email:    email,
+				Password: s.TestInfo.Password,
+			})
+			assert.Nil(t, err)
+			return res
+		}
+
+		// totp is not required till enrollment is confirmed
+		loginRes := login()
+		assert.NotNil(t, loginRes.AccessToken)
+		assert.Nil(t, loginRes.MfaToken)
+
+		_, err = resolvers.ConfirmTotpResolver(ctx, model.OTPInput{Otp: "000000"})
+		assert.NotNil(t, err)
+		code, err := crypto.GenerateTOTPCode(enrollRes.Secret, time.Now())
+		assert.Nil(t, err)
+		_, err = resolvers.ConfirmTotpResolver(ctx, model.OTPInput{Otp: code})
+		assert.Nil(t, err)
+
+		profile, err := resolvers.ProfileResolver(ctx)
+		assert.Nil(t, err)
+		assert.True(t, *profile.TotpEnabled)
+
+		loginRes = login()
+		assert.Nil(t, loginRes.AccessToken)
+		assert.NotNil(t, loginRes.MfaToken)
+
+		// used code can not be replayed
+		_, err = resolvers.VerifyOtpResolver(ctx, model.VerifyOTPInput{MfaToken: *loginRes.MfaToken, Otp: code})
+		assert.NotNil(t, err)
+
+		nextCode, err := crypto.GenerateTOTPCode(enrollRes.Secret, time.Now().Add(crypto.TOTPPeriod))
+		assert.Nil(t, err)
+		verifyOtpRes, err := resolvers.VerifyOtpResolver(ctx, model.VerifyOTPInput{MfaToken: *loginRes.MfaToken, Otp: nextCode})
+		assert.Nil(t, err)
+		assert.NotNil(t, verifyOtpRes.AccessToken)
+
+		// mfa token can be used only once
+		_, err = resolvers.VerifyOtpResolver(ctx, model.VerifyOTPInput{MfaToken: *loginRes.MfaToken, Otp: nextCode})
+		assert.NotNil(t, err)
+
+		// backup code can be used only once
+		backupCode := strings.ToUpper(enrollRes.BackupCodes[0])
+		loginRes = login()
+		verifyOtpRes, err = resolvers.VerifyOtpResolver(ctx, model.VerifyOTPInput{MfaToken: *loginRes.MfaToken, Otp: backupCode})
+		assert.Nil(t, err)
+		assert.NotNil(t, verifyOtpRes.AccessToken)
+
+		loginRes = login()
+		_, err = resolvers.VerifyOtpResolver(ctx, model.VerifyOTPInput{MfaToken: *loginRes.MfaToken, Otp: backupCode})
+		assert.NotNil(t, err)
+
+		// backup codes read by parallel requests can be removed only by one of them
+		user, err = db.Provider.GetUserByEmail(email)
+		assert.Nil(t, err)
+		backupCodeHashes := strings.Split(*user.TotpBackupCodes, ",")
+		updated, err := db.Provider.UpdateUserTotpBackupCodes(user, strings.Join(backupCodeHashes[:len(backupCodeHashes)-1], ","))
+		assert.Nil(t, err)
+		assert.True(t, updated)
+		updated, err = db.Provider.UpdateUserTotpBackupCodes(user, strings.Join(backupCodeHashes[1:], ","))
+		assert.Nil(t, err)
+		assert.False(t, updated)
+
+		// challenge is removed after too many invalid attempts, even if they are made in parallel
+		var wg sync.WaitGroup
+		for i := 1; i < token.MaxMfaChallengeAttempts; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				_, err := resolvers.VerifyOtpResolver(ctx, model.VerifyOTPInput{MfaToken: *loginRes.MfaToken, Otp: "000000"})
+				assert.NotNil(t, err)
+			}()
+		}
+		wg.Wait()
+		_, err = resolvers.VerifyOtpResolver(ctx, model.VerifyOTPInput{MfaToken: *loginRes.MfaToken, Otp: enrollRes.BackupCodes[1]})
+		assert.NotNil(t, err)
+
+		// otp verification is throttled per user
+		envstore.EnvInMemoryStoreObj.UpdateEnvVariable(constants.SliceStoreIdentifier, constants.EnvKeyRateLimits, []string{"verify_otp:email:1/1m"})
+		loginRes = login()
+		_, err = resolvers.VerifyOtpResolver(ctx, model.VerifyOTPInput{MfaToken: *loginRes.MfaToken, Otp: enrollRes.BackupCodes[2]})
+		assert.Equal(t, constants.ErrorCodeTooManyRequests, errorCode(err))
+		envstore.EnvInMemoryStoreObj.UpdateEnvVariable(constants.SliceStoreIdentifier, constants.EnvKeyRateLimits, []string{})
+
+		_, err = resolvers.DisableTotpResolver(ctx, model.OTPInput{Otp: "000000"})
+		assert.NotNil(t, err)
+		_, err = resolvers.DisableTotpResolver(ctx, model.OTPInput{Otp: enrollRes.BackupCodes[1]})
+		assert.Nil(t, err)
+
+		loginRes = login()
+		assert.NotNil(t, loginRes.AccessToken)
+
+		cleanData(email)
+	})
+}
+
+func TestGenerateTOTPCode(t *testing.T) {
+	// test vectors of RFC 6238 (last 6 digits) for secret "12345678901234567890"
+	secret := "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+	for unixTime, expected := range map[int64]string{
+		59:         "287082",
+		1111111109: "081804",
+		1234567890: "005924",
+		2000000000: "279037",
+	} {
+		code, err := crypto.GenerateTOTPCode(secret, time.Unix(unixTime, 0))
+		assert.Nil(t, err)
+		assert.Equal(t, expected, code)
+	}
+}
@@ -53,4 +53,50 @@ func updateProfileTests(t *testing.T, s TestSetup) {
 		cleanData(newEmail)
 		cleanData(email)
 	})
+
+	t.Run(`should revoke other sessions on password change`, func(t *testing.T) {
+		req, ctx := createContext(s)
+		email := "update_profile_password." + s.TestInfo.Email
+		accessTokenCookieName := envstore.EnvInMemoryStoreObj.GetStringStoreEnvVariable(constants.EnvKeyCookieName) + ".access_token"
+
+		resolvers.SignupResolver(ctx, model.SignUpInput{
+			Email:           email,
+			Password:        s.TestInfo.Password,
+			ConfirmPassword: s.TestInfo.Password,
+		})
+		verificationRequest, err := db.Provider.GetVerificationRequestByEmail(email, constants.VerificationTypeBasicAuthSignup)
+		assert.Nil(t, err)
+		otherRes, err := resolvers.VerifyEmailResolver(ctx, model.VerifyEmailInput{
+			Token: verificationRequest.Token,
+		})
+		assert.Nil(t, err)
+		currentRes, err := resolvers.LoginResolver(ctx, model.LoginInput{
+			Email:    email,
+			Password: s.TestInfo.Password,
+		})
+		assert.Nil(t, err)
+
+		req.Header.Set("Cookie", fmt.Sprintf("%s=%s", accessTokenCookieName, *currentRes.AccessToken))
+		newPassword := "Test@1234"
+		_, err = resolvers.UpdateProfileResolver(ctx, model.UpdateProfileInput{
+			OldPassword:        &s.TestInfo.Password,
+			NewPassword:        &newPassword,
+			ConfirmNewPassword: &newPassword,
+		})
+		assert.Nil(t, err)
+
+		// current session is kept
+		_, err = resolvers.ProfileResolver(ctx)
+		assert.Nil(t, err)
+		sessions, err := resolvers.SessionsResolver(ctx)
+		assert.Nil(t, err)
+		assert.Len(t, sessions, 1)
+
+		req.Header.Set("Cookie", fmt.Sprintf("%s=%s", accessTokenCookieName, *otherRes.AccessToken))
+		_, err = resolvers.ProfileResolver(ctx)
+		assert.NotNil(t, err, "unauthorized")
+
+		req.Header.Del("Cookie")
+		cleanData(email)
+	})
 }
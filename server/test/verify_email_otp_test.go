@@ -0,0 +1,97 @@
+package test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/authorizerdev/authorizer/server/constants"
+	"github.com/authorizerdev/authorizer/server/db"
+	"github.com/authorizerdev/authorizer/server/envstore"
+	"github.com/authorizerdev/authorizer/server/graph/model"
+	"github.com/authorizerdev/authorizer/server/resolvers"
+	"github.com/authorizerdev/authorizer/server/token"
+	"github.com/authorizerdev/authorizer/server/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func verifyEmailOtpTests(t *testing.T, s TestSetup) {
+	t.Helper()
+	t.Run(`should login with email otp`, func(t *testing.T) {
+		_, ctx := createContext(s)
+		email := "email_otp." + s.TestInfo.Email
+		useOtp := true
+
+		// replaces the emailed code with known code
+		sendOtp := func(otp string) {
+			_, err := resolvers.MagicLinkLoginResolver(ctx, model.MagicLinkLoginInput{
+				Email:  email,
+				UseOtp: &useOtp,
+			})
+			assert.Nil(t, err)
+
+			verificationRequest, err := db.Provider.GetVerificationRequestByEmail(email, constants.VerificationTypeEmailOTPLogin)
+			assert.Nil(t, err)
+			assert.NotEqual(t, otp, verificationRequest.Token, "otp should be stored hashed")
+			db.Provider.DeleteVerificationRequest(verificationRequest)
+			verificationRequest.ID = ""
+			verificationRequest.Key = ""
+			verificationRequest.Token = utils.HashOTP(email, otp)
+			_, err = db.Provider.AddVerificationRequest(verificationRequest)
+			assert.Nil(t, err)
+		}
+
+		sendOtp("123456")
+		_, err := resolvers.VerifyEmailOtpResolver(ctx, model.VerifyEmailOTPInput{Email: email, Otp: "654321"})
+		assert.NotNil(t, err)
+		res, err := resolvers.VerifyEmailOtpResolver(ctx, model.VerifyEmailOTPInput{Email: email, Otp: "123456"})
+		assert.Nil(t, err)
+		assert.NotNil(t, res.AccessToken)
+		assert.True(t, res.User.EmailVerified)
+
+		// code can be used only once
+		_, err = resolvers.VerifyEmailOtpResolver(ctx, model.VerifyEmailOTPInput{Email: email, Otp: "123456"})
+		assert.NotNil(t, err)
+
+		// code is redeemed only once, even if it is used in parallel
+		sendOtp("222222")
+		var mutex sync.Mutex
+		redeemed := 0
+		var redeemWg sync.WaitGroup
+		for i := 0; i < 3; i++ {
+			redeemWg.Add(1)
+			go func() {
+				defer redeemWg.Done()
+				if token.VerifyOTPVerificationRequest(email, constants.VerificationTypeEmailOTPLogin, email, "222222") == nil {
+					mutex.Lock()
+					redeemed++
+					mutex.Unlock()
+				}
+			}()
+		}
+		redeemWg.Wait()
+		assert.Equal(t, 1, redeemed)
+
+		// code is removed after too many invalid attempts, even if they are made in parallel
+		sendOtp("111111")
+		var wg sync.WaitGroup
+		for i := 0; i < token.MaxOTPAttempts; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				_, err := resolvers.VerifyEmailOtpResolver(ctx, model.VerifyEmailOTPInput{Email: email, Otp: "000000"})
+				assert.NotNil(t, err)
+			}()
+		}
+		wg.Wait()
+		_, err = resolvers.VerifyEmailOtpResolver(ctx, model.VerifyEmailOTPInput{Email: email, Otp: "111111"})
+		assert.NotNil(t, err)
+
+		// otp verification is throttled per email
+		envstore.EnvInMemoryStoreObj.UpdateEnvVariable(constants.SliceStoreIdentifier, constants.EnvKeyRateLimits, []string{"verify_email_otp:email:1/1m"})
+		_, err = resolvers.VerifyEmailOtpResolver(ctx, model.VerifyEmailOTPInput{Email: email, Otp: "111111"})
+		assert.Equal(t, constants.ErrorCodeTooManyRequests, errorCode(err))
+		envstore.EnvInMemoryStoreObj.UpdateEnvVariable(constants.SliceStoreIdentifier, constants.EnvKeyRateLimits, []string{})
+
+		cleanData(email)
+	})
+}
@@ -0,0 +1,309 @@
+package test
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"testing"
+
+	"github.com/authorizerdev/authorizer/server/constants"
+	"github.com/authorizerdev/authorizer/server/db"
+	"github.com/authorizerdev/authorizer/server/envstore"
+	"github.com/authorizerdev/authorizer/server/graph/model"
+	"github.com/authorizerdev/authorizer/server/resolvers"
+	"github.com/authorizerdev/authorizer/server/webauthn"
+	"github.com/stretchr/testify/assert"
+)
+
+// testAuthenticator is a software authenticator with P-256 key
+type testAuthenticator struct {
+	key          *ecdsa.PrivateKey
+	credentialID []byte
+	signCount    uint32
+	origin       string
+	rpID         string
+}
+
+func newTestAuthenticator(t *testing.T, origin, rpID string) *testAuthenticator {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.Nil(t, err)
+	credentialID := make([]byte, 16)
+	rand.Read(credentialID)
+	return &testAuthenticator{key: key, credentialID: credentialID, origin: origin, rpID: rpID}
+}
+
+func (a *testAuthenticator) clientDataJSON(clientDataType, challenge string) []byte {
+	clientData, _ := json.Marshal(map[string]interface{}{
+		"type":      clientDataType,
+		"challenge": challenge,
+		"origin":    a.origin,
+	})
+	return clientData
+}
+
+func (a *testAuthenticator) authenticatorData(flags byte, attestedCredentialData []byte) []byte {
+	rpIDHash := sha256.Sum256([]byte(a.rpID))
+	authData := append([]byte{}, rpIDHash[:]...)
+	authData = append(authData, flags)
+	signCount := make([]byte, 4)
+	binary.BigEndian.PutUint32(signCount, a.signCount)
+	authData = append(authData, signCount...)
+	return append(authData, attestedCredentialData...)
+}
+
+// create returns the base64url encoded client data & attestation object of new credential
+func (a *testAuthenticator) create(challenge string) (string, string) {
+	coseKey := encodeTestCBOR(map[int64]interface{}{
+		1:  int64(2),
+		3:  int64(webauthn.AlgES256),
+		-1: int64(1),
+		-2: a.key.X.FillBytes(make([]byte, 32)),
+		-3: a.key.Y.FillBytes(make([]byte, 32)),
+	})
+	attestedCredentialData := make([]byte, 16)
+	credentialIDLength := make([]byte, 2)
+	binary.BigEndian.PutUint16(credentialIDLength, uint16(len(a.credentialID)))
+	attestedCredentialData = append(attestedCredentialData, credentialIDLength...)
+	attestedCredentialData = append(attestedCredentialData, a.credentialID...)
+	attestedCredentialData = append(attestedCredentialData, coseKey...)
+
+	attestationObject := encodeTestCBOR(map[string]interface{}{
+		"fmt":      "none",
+		"attStmt":  map[string]interface{}{},
+		"authData": a.authenticatorData(0x45, attestedCredentialData),
+	})
+	return webauthn.EncodeToString(a.clientDataJSON(webauthn.ClientDataTypeCreate, challenge)), webauthn.EncodeToString(attestationObject)
+}
+
+// get returns the assertion for given challenge
+func (a *testAuthenticator) get(t *testing.T, challenge string, userVerified bool) model.WebauthnLoginInput {
+	a.signCount++
+	flags := byte(0x01)
+	if userVerified {
+		flags |= 0x04
+	}
+	authData := a.authenticatorData(flags, nil)
+	clientDataJSON := a.clientDataJSON(webauthn.ClientDataTypeGet, challenge)
+	clientDataHash := sha256.Sum256(clientDataJSON)
+	hash := sha256.Sum256(append(append([]byte{}, authData...), clientDataHash[:]...))
+	signature, err := ecdsa.SignASN1(rand.Reader, a.key, hash[:])
+	assert.Nil(t, err)
+
+	return model.WebauthnLoginInput{
+		ID:                webauthn.EncodeToString(a.credentialID),
+		ClientDataJSON:    webauthn.EncodeToString(clientDataJSON),
+		AuthenticatorData: webauthn.EncodeToString(authData),
+		Signature:         webauthn.EncodeToString(signature),
+	}
+}
+
+// encodeTestCBOR encodes the subset of CBOR used by attestation object & COSE keys
+func encodeTestCBOR(value interface{}) []byte {
+	header := func(majorType byte, length uint64) []byte {
+		switch {
+		case length < 24:
+			return []byte{majorType<<5 | byte(length)}
+		case length < 256:
+			return []byte{majorType<<5 | 24, byte(length)}
+		default:
+			return []byte{majorType<<5 | 25, byte(length >> 8), byte(length)}
+		}
+	}
+
+	switch v := value.(type) {
+	case int64:
+		if v < 0 {
+			return header(1, uint64(-1-v))
+		}
+		return header(0, uint64(v))
+	case []byte:
+		return append(header(2, uint64(len(v))), v...)
+	case string:
+		return append(header(3, uint64(len(v))), v...)
+	case map[int64]interface{}:
+		keys := []int64{}
+		for k := range v {
+			keys = append(keys, k)
+		}
+		sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+		var buf bytes.Buffer
+		buf.Write(header(5, uint64(len(v))))
+		for _, k := range keys {
+			buf.Write(encodeTestCBOR(k))
+			buf.Write(encodeTestCBOR(v[k]))
+		}
+		return buf.Bytes()
+	case map[string]interface{}:
+		var buf bytes.Buffer
+		buf.Write(header(5, uint64(len(v))))
+		for k, item := range v {
+			buf.Write(encodeTestCBOR(k))
+			buf.Write(encodeTestCBOR(item))
+		}
+		return buf.Bytes()
+	}
+
+	panic(fmt.Sprintf("unsupported cbor value %T", value))
+}
+
+func webauthnTests(t *testing.T, s TestSetup) {
+	t.Helper()
+	t.Run(`should register passkey and login with it`, func(t *testing.T) {
+		envstore.EnvInMemoryStoreObj.UpdateEnvVariable(constants.StringStoreIdentifier, constants.EnvKeyAuthorizerURL, "http://localhost:8080")
+		defer envstore.EnvInMemoryStoreObj.UpdateEnvVariable(constants.StringStoreIdentifier, constants.EnvKeyAuthorizerURL, "")
+
+		req, ctx := createContext(s)
+		email := "webauthn." + s.TestInfo.Email
+		resolvers.SignupResolver(ctx, model.SignUpInput{
+			Email:           email,
+			Password:        s.TestInfo.Password,
+			ConfirmPassword: s.TestInfo.Password,
+		})
+
+		_, err := resolvers.WebauthnRegistrationOptionsResolver(ctx)
+		assert.NotNil(t, err, "unauthorized")
+
+		verificationRequest, err := db.Provider.GetVerificationRequestByEmail(email, constants.VerificationTypeBasicAuthSignup)
+		assert.Nil(t, err)
+		verifyRes, err := resolvers.VerifyEmailResolver(ctx, model.VerifyEmailInput{
+			Token: verificationRequest.Token,
+		})
+		assert.Nil(t, err)
+		req.Header.Set("Cookie", fmt.Sprintf("%s=%s", envstore.EnvInMemoryStoreObj.GetStringStoreEnvVariable(constants.EnvKeyCookieName)+".access_token", *verifyRes.AccessToken))
+
+		authenticator := newTestAuthenticator(t, "http://localhost:8080", "localhost")
+		registrationOptions, err := resolvers.WebauthnRegistrationOptionsResolver(ctx)
+		assert.Nil(t, err)
+		assert.Equal(t, "localhost", registrationOptions.Options["rp"].(map[string]interface{})["id"])
+		challenge := registrationOptions.Options["challenge"].(string)
+
+		// credential from other origin, subdomain or scheme is rejected
+		var clientDataJSON, attestationObject string
+		for _, origin := range []string{"https://localhost.evil.com", "http://app.localhost:8080", "https://localhost:8080"} {
+			phishingAuthenticator := newTestAuthenticator(t, origin, "localhost")
+			clientDataJSON, attestationObject = phishingAuthenticator.create(challenge)
+			_, err = resolvers.WebauthnRegisterResolver(ctx, model.WebauthnRegisterInput{
+				ID:                webauthn.EncodeToString(phishingAuthenticator.credentialID),
+				ClientDataJSON:    clientDataJSON,
+				AttestationObject: attestationObject,
+			})
+			assert.NotNil(t, err)
+
+			registrationOptions, err = resolvers.WebauthnRegistrationOptionsResolver(ctx)
+			assert.Nil(t, err)
+			challenge = registrationOptions.Options["challenge"].(string)
+		}
+
+		// passkey is not required as second factor unless enabled
+		otherAuthenticator := newTestAuthenticator(t, "http://localhost:8080", "localhost")
+		clientDataJSON, attestationObject = otherAuthenticator.create(challenge)
+		otherCredential, err := resolvers.WebauthnRegisterResolver(ctx, model.WebauthnRegisterInput{
+			ID:                webauthn.EncodeToString(otherAuthenticator.credentialID),
+			ClientDataJSON:    clientDataJSON,
+			AttestationObject: attestationObject,
+		})
+		assert.Nil(t, err)
+		assert.False(t, otherCredential.MfaEnabled)
+		loginRes, err := resolvers.LoginResolver(ctx, model.LoginInput{
+			Email:    email,
+			Password: s.TestInfo.Password,
+		})
+		assert.Nil(t, err)
+		assert.NotNil(t, loginRes.AccessToken)
+
+		registrationOptions, err = resolvers.WebauthnRegistrationOptionsResolver(ctx)
+		assert.Nil(t, err)
+		name := "Laptop"
+		mfaEnabled := true
+		clientDataJSON, attestationObject = authenticator.create(registrationOptions.Options["challenge"].(string))
+		credential, err := resolvers.WebauthnRegisterResolver(ctx, model.WebauthnRegisterInput{
+			Name:              &name,
+			MfaEnabled:        &mfaEnabled,
+			ID:                webauthn.EncodeToString(authenticator.credentialID),
+			ClientDataJSON:    clientDataJSON,
+			AttestationObject: attestationObject,
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, name, credential.Name)
+		assert.True(t, credential.MfaEnabled)
+
+		// challenge can be used only once
+		_, err = resolvers.WebauthnRegisterResolver(ctx, model.WebauthnRegisterInput{
+			ID:                webauthn.EncodeToString(authenticator.credentialID),
+			ClientDataJSON:    clientDataJSON,
+			AttestationObject: attestationObject,
+		})
+		assert.NotNil(t, err)
+
+		credentials, err := resolvers.WebauthnCredentialsResolver(ctx)
+		assert.Nil(t, err)
+		assert.Len(t, credentials, 2)
+
+		// passkey enabled as second factor is required for password login
+		loginRes, err = resolvers.LoginResolver(ctx, model.LoginInput{
+			Email:    email,
+			Password: s.TestInfo.Password,
+		})
+		assert.Nil(t, err)
+		assert.Nil(t, loginRes.AccessToken)
+		assert.Contains(t, loginRes.MfaMethods, constants.MfaMethodWebauthn)
+
+		loginOptions, err := resolvers.WebauthnLoginOptionsResolver(ctx, &model.WebauthnLoginOptionsInput{MfaToken: loginRes.MfaToken})
+		assert.Nil(t, err)
+		assert.Len(t, loginOptions.Options["allowCredentials"], 1)
+		_, err = resolvers.WebauthnLoginResolver(ctx, otherAuthenticator.get(t, loginOptions.Options["challenge"].(string), false))
+		assert.NotNil(t, err, "passkey not enabled as second factor")
+		loginOptions, err = resolvers.WebauthnLoginOptionsResolver(ctx, &model.WebauthnLoginOptionsInput{MfaToken: loginRes.MfaToken})
+		assert.Nil(t, err)
+		webauthnLoginRes, err := resolvers.WebauthnLoginResolver(ctx, authenticator.get(t, loginOptions.Options["challenge"].(string), false))
+		assert.Nil(t, err)
+		assert.NotNil(t, webauthnLoginRes.AccessToken)
+
+		// passwordless login requires user verification
+		loginOptions, err = resolvers.WebauthnLoginOptionsResolver(ctx, &model.WebauthnLoginOptionsInput{Email: &email})
+		assert.Nil(t, err)
+		_, err = resolvers.WebauthnLoginResolver(ctx, authenticator.get(t, loginOptions.Options["challenge"].(string), false))
+		assert.NotNil(t, err)
+
+		loginOptions, err = resolvers.WebauthnLoginOptionsResolver(ctx, nil)
+		assert.Nil(t, err)
+		assertion := authenticator.get(t, loginOptions.Options["challenge"].(string), true)
+		webauthnLoginRes, err = resolvers.WebauthnLoginResolver(ctx, assertion)
+		assert.Nil(t, err)
+		assert.NotNil(t, webauthnLoginRes.AccessToken)
+		assert.Equal(t, email, webauthnLoginRes.User.Email)
+
+		// assertion with old signature counter is rejected
+		loginOptions, err = resolvers.WebauthnLoginOptionsResolver(ctx, nil)
+		assert.Nil(t, err)
+		authenticator.signCount--
+		_, err = resolvers.WebauthnLoginResolver(ctx, authenticator.get(t, loginOptions.Options["challenge"].(string), true))
+		assert.NotNil(t, err)
+
+		// passkey login is throttled per ip
+		envstore.EnvInMemoryStoreObj.UpdateEnvVariable(constants.SliceStoreIdentifier, constants.EnvKeyRateLimits, []string{"webauthn_login:ip:1/1m"})
+		req.Header.Set("X-Real-Ip", "10.0.0.2")
+		for i := 0; i < 2; i++ {
+			_, err = resolvers.WebauthnLoginResolver(ctx, assertion)
+		}
+		assert.Equal(t, constants.ErrorCodeTooManyRequests, errorCode(err))
+		req.Header.Del("X-Real-Ip")
+		envstore.EnvInMemoryStoreObj.UpdateEnvVariable(constants.SliceStoreIdentifier, constants.EnvKeyRateLimits, []string{})
+
+		for _, c := range []*model.WebauthnCredential{credential, otherCredential} {
+			_, err = resolvers.DeleteWebauthnCredentialResolver(ctx, model.DeleteWebauthnCredentialInput{ID: c.ID})
+			assert.Nil(t, err)
+		}
+		credentials, err = resolvers.WebauthnCredentialsResolver(ctx)
+		assert.Nil(t, err)
+		assert.Len(t, credentials, 0)
+
+		cleanData(email)
+	})
+}
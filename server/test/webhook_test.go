@@ -0,0 +1,258 @@
+package test
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/authorizerdev/authorizer/server/constants"
+	"github.com/authorizerdev/authorizer/server/db"
+	"github.com/authorizerdev/authorizer/server/db/models"
+	"github.com/authorizerdev/authorizer/server/envstore"
+	"github.com/authorizerdev/authorizer/server/graph/model"
+	"github.com/authorizerdev/authorizer/server/resolvers"
+	"github.com/authorizerdev/authorizer/server/utils"
+	"github.com/authorizerdev/authorizer/server/webhook"
+	"github.com/stretchr/testify/assert"
+)
+
+// webhookRequest is the request received by test webhook endpoint
+type webhookRequest struct {
+	Header http.Header
+	Body   string
+}
+
+// pendingWebhookDeliveries returns the pending deliveries of webhook, including the ones not yet due
+func pendingWebhookDeliveries(webhookID string) []models.WebhookDelivery {
+	deliveries := []models.WebhookDelivery{}
+	pendingDeliveries, _ := db.Provider.ListPendingWebhookDeliveries(time.Now().Add(24*time.Hour).Unix(), 100)
+	for _, delivery := range pendingDeliveries {
+		if delivery.WebhookID == webhookID {
+			deliveries = append(deliveries, delivery)
+		}
+	}
+
+	return deliveries
+}
+
+func webhookTests(t *testing.T, s TestSetup) {
+	t.Helper()
+	t.Run(`should deliver signed webhooks and retry failed deliveries`, func(t *testing.T) {
+		var mutex sync.Mutex
+		requests := []webhookRequest{}
+		endpoint := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, _ := ioutil.ReadAll(r.Body)
+			mutex.Lock()
+			defer mutex.Unlock()
+			requests = append(requests, webhookRequest{Header: r.Header, Body: string(body)})
+			// first delivery fails, so that it is retried
+			if len(requests) == 1 {
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer endpoint.Close()
+
+		req, ctx := createContext(s)
+		_, err := resolvers.AddWebhookResolver(ctx, model.AddWebhookInput{
+			EventName: constants.WebhookEventUserSignup,
+			Endpoint:  endpoint.URL,
+		})
+		assert.NotNil(t, err, "unauthorized")
+
+		h, err := utils.EncryptPassword(envstore.EnvInMemoryStoreObj.GetStringStoreEnvVariable(constants.EnvKeyAdminSecret))
+		assert.Nil(t, err)
+		req.Header.Set("Cookie", fmt.Sprintf("%s=%s", envstore.EnvInMemoryStoreObj.GetStringStoreEnvVariable(constants.EnvKeyAdminCookieName), h))
+
+		_, err = resolvers.AddWebhookResolver(ctx, model.AddWebhookInput{
+			EventName: "user.unknown",
+			Endpoint:  endpoint.URL,
+		})
+		assert.NotNil(t, err, "invalid event name")
+
+		_, err = resolvers.AddWebhookResolver(ctx, model.AddWebhookInput{
+			EventName: constants.WebhookEventUserSignup,
+			Endpoint:  "ftp://example.com",
+		})
+		assert.NotNil(t, err, "invalid endpoint")
+
+		signupWebhook, err := resolvers.AddWebhookResolver(ctx, model.AddWebhookInput{
+			EventName: constants.WebhookEventUserSignup,
+			Endpoint:  endpoint.URL,
+		})
+		assert.Nil(t, err)
+		assert.True(t, signupWebhook.Enabled)
+		assert.NotNil(t, signupWebhook.Secret)
+		if signupWebhook.Secret == nil {
+			return
+		}
+
+		// secret is encrypted in database
+		savedWebhook, err := db.Provider.GetWebhookByID(signupWebhook.ID)
+		assert.Nil(t, err)
+		assert.NotEqual(t, *signupWebhook.Secret, savedWebhook.Secret)
+
+		email := "webhook." + s.TestInfo.Email
+		_, err = resolvers.SignupResolver(ctx, model.SignUpInput{
+			Email:           email,
+			Password:        s.TestInfo.Password,
+			ConfirmPassword: s.TestInfo.Password,
+		})
+		assert.Nil(t, err)
+
+		// first attempt is sent in background
+		var deliveries []models.WebhookDelivery
+		for i := 0; i < 50; i++ {
+			deliveries = pendingWebhookDeliveries(signupWebhook.ID)
+			if len(deliveries) == 1 && deliveries[0].Attempts == 1 {
+				break
+			}
+			time.Sleep(100 * time.Millisecond)
+		}
+		assert.Len(t, deliveries, 1)
+		if len(deliveries) != 1 {
+			return
+		}
+		assert.Equal(t, int64(1), deliveries[0].Attempts)
+		assert.Equal(t, int64(http.StatusInternalServerError), deliveries[0].ResponseStatus)
+		assert.NotEmpty(t, deliveries[0].Error)
+		assert.Greater(t, deliveries[0].NextAttemptAt, time.Now().Unix())
+
+		mutex.Lock()
+		assert.Len(t, requests, 1)
+		request := requests[0]
+		mutex.Unlock()
+		assert.Equal(t, constants.WebhookEventUserSignup, request.Header.Get("X-Authorizer-Event"))
+		assert.Equal(t, "sha256="+webhook.Sign(*signupWebhook.Secret, request.Header.Get("X-Authorizer-Timestamp"), request.Body), request.Header.Get("X-Authorizer-Signature"))
+		assert.True(t, strings.Contains(request.Body, email))
+
+		// make the delivery due for retry
+		deliveries[0].NextAttemptAt = time.Now().Unix()
+		_, err = db.Provider.UpdateWebhookDelivery(deliveries[0])
+		assert.Nil(t, err)
+		webhook.RetryPendingDeliveries()
+		assert.Len(t, pendingWebhookDeliveries(signupWebhook.ID), 0)
+
+		webhookID := signupWebhook.ID
+		res, err := resolvers.WebhookDeliveriesResolver(ctx, &model.WebhookDeliveriesInput{
+			WebhookID: &webhookID,
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, int64(1), res.Pagination.Total)
+		if len(res.WebhookDeliveries) == 1 {
+			assert.Equal(t, constants.WebhookDeliveryStatusSuccess, res.WebhookDeliveries[0].Status)
+			assert.Equal(t, int64(2), res.WebhookDeliveries[0].Attempts)
+		}
+
+		mutex.Lock()
+		assert.Len(t, requests, 2)
+		if len(requests) == 2 {
+			// retries are the same delivery
+			assert.Equal(t, requests[0].Body, requests[1].Body)
+			assert.Equal(t, requests[0].Header.Get("X-Authorizer-Delivery"), requests[1].Header.Get("X-Authorizer-Delivery"))
+		}
+		mutex.Unlock()
+
+		disabled := false
+		updatedWebhook, err := resolvers.UpdateWebhookResolver(ctx, model.UpdateWebhookInput{
+			ID:      signupWebhook.ID,
+			Enabled: &disabled,
+		})
+		assert.Nil(t, err)
+		assert.False(t, updatedWebhook.Enabled)
+		assert.Nil(t, updatedWebhook.Secret)
+
+		webhooks, err := resolvers.WebhooksResolver(ctx, nil)
+		assert.Nil(t, err)
+		assert.GreaterOrEqual(t, webhooks.Pagination.Total, int64(1))
+		for _, listedWebhook := range webhooks.Webhooks {
+			assert.Nil(t, listedWebhook.Secret)
+		}
+
+		_, err = resolvers.DeleteWebhookResolver(ctx, model.WebhookInput{
+			ID: signupWebhook.ID,
+		})
+		assert.Nil(t, err)
+		_, err = resolvers.DeleteWebhookResolver(ctx, model.WebhookInput{
+			ID: signupWebhook.ID,
+		})
+		assert.NotNil(t, err, "webhook not found")
+
+		req.Header.Del("Cookie")
+		cleanData(email)
+	})
+
+	t.Run(`should trigger signup and login webhooks of magic link login`, func(t *testing.T) {
+		var mutex sync.Mutex
+		events := map[string]string{}
+		endpoint := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, _ := ioutil.ReadAll(r.Body)
+			mutex.Lock()
+			defer mutex.Unlock()
+			events[r.Header.Get("X-Authorizer-Event")] = string(body)
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer endpoint.Close()
+
+		req, ctx := createContext(s)
+		h, err := utils.EncryptPassword(envstore.EnvInMemoryStoreObj.GetStringStoreEnvVariable(constants.EnvKeyAdminSecret))
+		assert.Nil(t, err)
+		req.Header.Set("Cookie", fmt.Sprintf("%s=%s", envstore.EnvInMemoryStoreObj.GetStringStoreEnvVariable(constants.EnvKeyAdminCookieName), h))
+
+		webhookIDs := []string{}
+		for _, eventName := range []string{constants.WebhookEventUserSignup, constants.WebhookEventUserLogin} {
+			res, err := resolvers.AddWebhookResolver(ctx, model.AddWebhookInput{
+				EventName: eventName,
+				Endpoint:  endpoint.URL,
+			})
+			assert.Nil(t, err)
+			if err == nil {
+				webhookIDs = append(webhookIDs, res.ID)
+			}
+		}
+		req.Header.Del("Cookie")
+
+		email := "webhook_magic_link." + s.TestInfo.Email
+		_, err = resolvers.MagicLinkLoginResolver(ctx, model.MagicLinkLoginInput{
+			Email: email,
+		})
+		assert.Nil(t, err)
+		verificationRequest, err := db.Provider.GetVerificationRequestByEmail(email, constants.VerificationTypeMagicLinkLogin)
+		assert.Nil(t, err)
+		_, err = resolvers.VerifyEmailResolver(ctx, model.VerifyEmailInput{
+			Token: verificationRequest.Token,
+		})
+		assert.Nil(t, err)
+
+		// deliveries are sent in background
+		for i := 0; i < 50; i++ {
+			mutex.Lock()
+			delivered := len(events) == 2
+			mutex.Unlock()
+			if delivered {
+				break
+			}
+			time.Sleep(100 * time.Millisecond)
+		}
+		mutex.Lock()
+		assert.True(t, strings.Contains(events[constants.WebhookEventUserSignup], email))
+		assert.True(t, strings.Contains(events[constants.WebhookEventUserLogin], email))
+		mutex.Unlock()
+
+		req.Header.Set("Cookie", fmt.Sprintf("%s=%s", envstore.EnvInMemoryStoreObj.GetStringStoreEnvVariable(constants.EnvKeyAdminCookieName), h))
+		for _, webhookID := range webhookIDs {
+			_, err = resolvers.DeleteWebhookResolver(ctx, model.WebhookInput{
+				ID: webhookID,
+			})
+			assert.Nil(t, err)
+		}
+		req.Header.Del("Cookie")
+		cleanData(email)
+	})
+}
@@ -0,0 +1,99 @@
+package token
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/robertkrimen/otto"
+)
+
+// AccessTokenScriptTimeout is the maximum duration for which CUSTOM_ACCESS_TOKEN_SCRIPT can run
+const AccessTokenScriptTimeout = 200 * time.Millisecond
+
+// ProtectedAccessTokenClaims are the claims which can not be overridden by CUSTOM_ACCESS_TOKEN_SCRIPT
+var ProtectedAccessTokenClaims = []string{"exp", "iat", "token_type", "id", "jti", "sid", "fid", "aud"}
+
+var errAccessTokenScriptTimeout = errors.New("custom access token script timed out")
+
+// ValidateAccessTokenScript compiles the script & makes sure that it is a function
+func ValidateAccessTokenScript(script string) error {
+	vm := otto.New()
+	compiledScript, err := vm.Compile("", fmt.Sprintf(`var customFunction = %s;`, script))
+	if err != nil {
+		return fmt.Errorf("invalid custom access token script: %s", err.Error())
+	}
+
+	// script is evaluated (not called) here, but it can still be an endless expression
+	if _, err := runWithTimeout(vm, compiledScript); err != nil {
+		return fmt.Errorf("invalid custom access token script: %s", err.Error())
+	}
+
+	customFunction, err := vm.Get("customFunction")
+	if err != nil || !customFunction.IsFunction() {
+		return errors.New("invalid custom access token script: it should be a function like function(user, tokenPayload) { return {} }")
+	}
+
+	return nil
+}
+
+// RunAccessTokenScript runs the script with user & token payload in a new vm with timeout,
+// and returns the extra claims except the protected ones
+func RunAccessTokenScript(script string, user interface{}, tokenPayload interface{}) (map[string]interface{}, error) {
+	userBytes, err := json.Marshal(user)
+	if err != nil {
+		return nil, err
+	}
+
+	tokenPayloadBytes, err := json.Marshal(tokenPayload)
+	if err != nil {
+		return nil, err
+	}
+
+	vm := otto.New()
+	val, err := runWithTimeout(vm, fmt.Sprintf(`
+		var user = %s;
+		var tokenPayload = %s;
+		var customFunction = %s;
+		JSON.stringify(customFunction(user, tokenPayload));
+	`, string(userBytes), string(tokenPayloadBytes), script))
+	if err != nil {
+		return nil, err
+	}
+
+	extraPayload := make(map[string]interface{})
+	if err := json.Unmarshal([]byte(val.String()), &extraPayload); err != nil {
+		return nil, fmt.Errorf("custom access token script should return an object: %s", err.Error())
+	}
+
+	for _, claim := range ProtectedAccessTokenClaims {
+		delete(extraPayload, claim)
+	}
+
+	return extraPayload, nil
+}
+
+// runWithTimeout runs the src (string or compiled script) in the vm,
+// and interrupts it after AccessTokenScriptTimeout
+func runWithTimeout(vm *otto.Otto, src interface{}) (val otto.Value, err error) {
+	vm.Interrupt = make(chan func(), 1)
+	timer := time.AfterFunc(AccessTokenScriptTimeout, func() {
+		vm.Interrupt <- func() {
+			panic(errAccessTokenScriptTimeout)
+		}
+	})
+	defer timer.Stop()
+
+	defer func() {
+		if caught := recover(); caught != nil {
+			if caught == errAccessTokenScriptTimeout {
+				err = errAccessTokenScriptTimeout
+				return
+			}
+			panic(caught)
+		}
+	}()
+
+	return vm.Run(src)
+}
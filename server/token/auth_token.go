@@ -5,20 +5,18 @@ import (
 	"errors"
 	"fmt"
 	"log"
-	"os"
 	"strings"
 	"time"
 
 	"github.com/authorizerdev/authorizer/server/constants"
 	"github.com/authorizerdev/authorizer/server/cookie"
 	"github.com/authorizerdev/authorizer/server/db/models"
+	"github.com/authorizerdev/authorizer/server/env"
 	"github.com/authorizerdev/authorizer/server/envstore"
-	"github.com/authorizerdev/authorizer/server/sessionstore"
 	"github.com/authorizerdev/authorizer/server/utils"
 	"github.com/gin-gonic/gin"
 	"github.com/golang-jwt/jwt"
 	"github.com/google/uuid"
-	"github.com/robertkrimen/otto"
 )
 
 // JWTToken is a struct to hold JWT token and its expiration time
@@ -31,23 +29,78 @@ type JWTToken struct {
 type Token struct {
 	FingerPrint     string    `json:"fingerprint"`
 	FingerPrintHash string    `json:"fingerprint_hash"`
+	FamilyID        string    `json:"family_id"`
 	RefreshToken    *JWTToken `json:"refresh_token"`
 	AccessToken     *JWTToken `json:"access_token"`
+	IDToken         *JWTToken `json:"id_token"`
 }
 
-// CreateAuthToken creates a new auth token when userlogs in
-func CreateAuthToken(user models.User, roles []string) (*Token, error) {
+// AuthTokenOptions holds the optional information used while creating auth token
+type AuthTokenOptions struct {
+	// Nonce is added to the id token as it is
+	Nonce string
+	// AuthTime is the unix time when user authenticated, 0 means user authenticated now
+	AuthTime int64
+	// Client is the oauth client to which tokens are issued,
+	// nil means first party apps identified by CLIENT_ID
+	Client *models.Client
+	// SessionID binds the access, refresh & id tokens issued together (sid claim).
+	// It is generated by CreateAuthToken
+	SessionID string
+	// FamilyID is the refresh token family (fid claim), which is kept while rotating refresh token.
+	// Empty value starts a new family
+	FamilyID string
+}
+
+// GetAudience returns the aud claim of the tokens
+func (options AuthTokenOptions) GetAudience() string {
+	if options.Client != nil {
+		return options.Client.ClientID
+	}
+
+	return envstore.EnvInMemoryStoreObj.GetStringStoreEnvVariable(constants.EnvKeyClientID)
+}
+
+// GetExpiryTime returns the lifetime of given token type based on the roles & client
+func (options AuthTokenOptions) GetExpiryTime(tokenType string, roles []string) time.Duration {
+	var clientExpiresIn int64
+	if options.Client != nil {
+		clientExpiresIn = options.Client.AccessTokenExpiresIn
+		if tokenType == constants.TokenTypeRefreshToken {
+			clientExpiresIn = options.Client.RefreshTokenExpiresIn
+		}
+	}
+
+	return env.GetTokenExpiryTime(tokenType, roles, clientExpiresIn)
+}
+
+// CreateAuthToken creates a new auth token when userlogs in.
+func CreateAuthToken(user models.User, roles []string, options AuthTokenOptions) (*Token, error) {
 	fingerprint := uuid.NewString()
 	fingerPrintHashBytes, err := utils.EncryptAES([]byte(fingerprint))
 	if err != nil {
 		return nil, err
 	}
-	refreshToken, refreshTokenExpiresAt, err := CreateRefreshToken(user, roles)
+
+	if options.AuthTime == 0 {
+		options.AuthTime = time.Now().Unix()
+	}
+	options.SessionID = uuid.New().String()
+	if options.FamilyID == "" {
+		options.FamilyID = uuid.New().String()
+	}
+
+	refreshToken, refreshTokenExpiresAt, err := CreateRefreshToken(user, roles, options)
+	if err != nil {
+		return nil, err
+	}
+
+	accessToken, accessTokenExpiresAt, err := CreateAccessToken(user, roles, options)
 	if err != nil {
 		return nil, err
 	}
 
-	accessToken, accessTokenExpiresAt, err := CreateAccessToken(user, roles)
+	idToken, idTokenExpiresAt, err := CreateIDToken(user, roles, options)
 	if err != nil {
 		return nil, err
 	}
@@ -55,41 +108,69 @@ func CreateAuthToken(user models.User, roles []string) (*Token, error) {
 	return &Token{
 		FingerPrint:     fingerprint,
 		FingerPrintHash: string(fingerPrintHashBytes),
+		FamilyID:        options.FamilyID,
 		RefreshToken:    &JWTToken{Token: refreshToken, ExpiresAt: refreshTokenExpiresAt},
 		AccessToken:     &JWTToken{Token: accessToken, ExpiresAt: accessTokenExpiresAt},
+		IDToken:         &JWTToken{Token: idToken, ExpiresAt: idTokenExpiresAt},
 	}, nil
 }
 
-// CreateRefreshToken util to create JWT token
-func CreateRefreshToken(user models.User, roles []string) (string, int64, error) {
-	t := jwt.New(jwt.GetSigningMethod(envstore.EnvInMemoryStoreObj.GetStringStoreEnvVariable(constants.EnvKeyJwtType)))
-	// expires in 1 year
-	expiryBound := time.Hour * 8760
-	expiresAt := time.Now().Add(expiryBound).Unix()
+// CreateRefreshToken util to create JWT token.
+// jti makes sure that rotated refresh tokens never match the previous ones,
+// and it is saved as the latest token of the refresh token family
+func CreateRefreshToken(user models.User, roles []string, options AuthTokenOptions) (string, int64, error) {
+	expiresAt := time.Now().Add(options.GetExpiryTime(constants.TokenTypeRefreshToken, roles)).Unix()
+	jti := uuid.New().String()
 
 	customClaims := jwt.MapClaims{
+		"aud":        options.GetAudience(),
 		"exp":        expiresAt,
 		"iat":        time.Now().Unix(),
 		"token_type": constants.TokenTypeRefreshToken,
 		"roles":      roles,
 		"id":         user.ID,
+		"auth_time":  options.AuthTime,
+		"jti":        jti,
+	}
+	if options.SessionID != "" {
+		customClaims["sid"] = options.SessionID
+	}
+	if options.FamilyID != "" {
+		customClaims["fid"] = options.FamilyID
 	}
 
-	t.Claims = customClaims
-	token, err := t.SignedString([]byte(envstore.EnvInMemoryStoreObj.GetStringStoreEnvVariable(constants.EnvKeyJwtSecret)))
+	token, err := SignJWTToken(customClaims)
 	if err != nil {
 		return "", 0, err
 	}
+
+	if options.FamilyID != "" {
+		setRefreshTokenFamily(options.FamilyID, jti, options.SessionID, expiresAt)
+	}
 	return token, expiresAt, nil
 }
 
 // CreateAccessToken util to create JWT token, based on
 // user information, roles config and CUSTOM_ACCESS_TOKEN_SCRIPT
-func CreateAccessToken(user models.User, roles []string) (string, int64, error) {
-	t := jwt.New(jwt.GetSigningMethod(envstore.EnvInMemoryStoreObj.GetStringStoreEnvVariable(constants.EnvKeyJwtType)))
-	expiryBound := time.Minute * 30
+func CreateAccessToken(user models.User, roles []string, options AuthTokenOptions) (string, int64, error) {
+	customClaims, err := GetAccessTokenClaims(user, roles, options, envstore.EnvInMemoryStoreObj.GetStringStoreEnvVariable(constants.EnvKeyCustomAccessTokenScript))
+	if err != nil {
+		// failing script should not block the login, so token is issued without extra claims
+		log.Println("error running custom access token script:", err)
+	}
+
+	token, err := SignJWTToken(customClaims)
+	if err != nil {
+		return "", 0, err
+	}
+
+	return token, customClaims["exp"].(int64), nil
+}
 
-	expiresAt := time.Now().Add(expiryBound).Unix()
+// GetAccessTokenClaims returns the access token claims along with the extra claims of given
+// access token script. In case of script error, claims without extra claims are returned with the error
+func GetAccessTokenClaims(user models.User, roles []string, options AuthTokenOptions, accessTokenScript string) (jwt.MapClaims, error) {
+	expiresAt := time.Now().Add(options.GetExpiryTime(constants.TokenTypeAccessToken, roles)).Unix()
 
 	resUser := user.AsAPIUser()
 	userBytes, _ := json.Marshal(&resUser)
@@ -98,8 +179,10 @@ func CreateAccessToken(user models.User, roles []string) (string, int64, error)
 
 	claimKey := envstore.EnvInMemoryStoreObj.GetStringStoreEnvVariable(constants.EnvKeyJwtRoleClaim)
 	customClaims := jwt.MapClaims{
+		"aud":           options.GetAudience(),
 		"exp":           expiresAt,
 		"iat":           time.Now().Unix(),
+		"jti":           uuid.New().String(),
 		"token_type":    constants.TokenTypeAccessToken,
 		"allowed_roles": strings.Split(user.Roles, ","),
 		claimKey:        roles,
@@ -111,44 +194,26 @@ func CreateAccessToken(user models.User, roles []string) (string, int64, error)
 		}
 	}
 
+	if options.SessionID != "" {
+		customClaims["sid"] = options.SessionID
+	}
+	if options.FamilyID != "" {
+		customClaims["fid"] = options.FamilyID
+	}
+
 	// check for the extra access token script
-	accessTokenScript := os.Getenv(constants.EnvKeyCustomAccessTokenScript)
 	if accessTokenScript != "" {
-		vm := otto.New()
-
-		claimBytes, _ := json.Marshal(customClaims)
-		vm.Run(fmt.Sprintf(`
-			var user = %s;
-			var tokenPayload = %s;
-			var customFunction = %s;
-			var functionRes = JSON.stringify(customFunction(user, tokenPayload));
-		`, string(userBytes), string(claimBytes), accessTokenScript))
-
-		val, err := vm.Get("functionRes")
-
+		extraPayload, err := RunAccessTokenScript(accessTokenScript, userMap, customClaims)
 		if err != nil {
-			log.Println("error getting custom access token script:", err)
-		} else {
-			extraPayload := make(map[string]interface{})
-			err = json.Unmarshal([]byte(fmt.Sprintf("%s", val)), &extraPayload)
-			if err != nil {
-				log.Println("error converting accessTokenScript response to map:", err)
-			} else {
-				for k, v := range extraPayload {
-					customClaims[k] = v
-				}
-			}
+			return customClaims, err
 		}
-	}
-
-	t.Claims = customClaims
 
-	token, err := t.SignedString([]byte(envstore.EnvInMemoryStoreObj.GetStringStoreEnvVariable(constants.EnvKeyJwtSecret)))
-	if err != nil {
-		return "", 0, err
+		for k, v := range extraPayload {
+			customClaims[k] = v
+		}
 	}
 
-	return token, expiresAt, nil
+	return customClaims, nil
 }
 
 // GetAccessToken returns the access token from the request (either from header or cookie)
@@ -192,9 +257,7 @@ func VerifyJWTToken(token string) (map[string]interface{}, error) {
 	var res map[string]interface{}
 	claims := jwt.MapClaims{}
 
-	t, err := jwt.ParseWithClaims(token, claims, func(token *jwt.Token) (interface{}, error) {
-		return []byte(envstore.EnvInMemoryStoreObj.GetStringStoreEnvVariable(constants.EnvKeyJwtSecret)), nil
-	})
+	t, err := ParseJWTToken(token, claims)
 	if err != nil {
 		return res, err
 	}
@@ -228,9 +291,13 @@ func ValidateAccessToken(gc *gin.Context) (map[string]interface{}, error) {
 		return nil, err
 	}
 
-	// also validate if there is user session present with access token
-	sessions := sessionstore.GetUserSessions(claims["id"].(string))
-	if len(sessions) == 0 {
+	// tokens issued to clients via client_credentials grant have no user
+	if userID, ok := claims["id"].(string); !ok || userID == "" {
+		return nil, errors.New("unauthorized")
+	}
+
+	// also validate if the token is not revoked & its session is present
+	if !IsAccessTokenActive(claims) {
 		return nil, errors.New("unauthorized")
 	}
 
@@ -0,0 +1,39 @@
+package token
+
+import (
+	"strings"
+	"time"
+
+	"github.com/authorizerdev/authorizer/server/constants"
+	"github.com/authorizerdev/authorizer/server/db/models"
+	"github.com/authorizerdev/authorizer/server/env"
+	"github.com/authorizerdev/authorizer/server/envstore"
+	"github.com/golang-jwt/jwt"
+	"github.com/google/uuid"
+)
+
+// CreateClientAccessToken util to create access token for the client itself (client_credentials grant).
+// It carries the client scopes & roles and no user claims, so it can not be used for user apis
+func CreateClientAccessToken(client models.Client, scopes []string) (string, int64, error) {
+	expiresAt := time.Now().Add(env.GetTokenExpiryTime(constants.TokenTypeAccessToken, client.GetRoles(), client.AccessTokenExpiresIn)).Unix()
+
+	customClaims := jwt.MapClaims{
+		"iss":        envstore.EnvInMemoryStoreObj.GetStringStoreEnvVariable(constants.EnvKeyAuthorizerURL),
+		"sub":        client.ClientID,
+		"aud":        client.ClientID,
+		"client_id":  client.ClientID,
+		"exp":        expiresAt,
+		"iat":        time.Now().Unix(),
+		"jti":        uuid.New().String(),
+		"token_type": constants.TokenTypeAccessToken,
+		"scope":      strings.Join(scopes, " "),
+		envstore.EnvInMemoryStoreObj.GetStringStoreEnvVariable(constants.EnvKeyJwtRoleClaim): client.GetRoles(),
+	}
+
+	token, err := SignJWTToken(customClaims)
+	if err != nil {
+		return "", 0, err
+	}
+
+	return token, expiresAt, nil
+}
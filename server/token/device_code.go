@@ -0,0 +1,135 @@
+package token
+
+import (
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/authorizerdev/authorizer/server/constants"
+	"github.com/authorizerdev/authorizer/server/sessionstore"
+	"github.com/google/uuid"
+)
+
+const (
+	// deviceCodeStatePrefix is the prefix of session store key for device codes
+	deviceCodeStatePrefix = "device_code_"
+	// deviceUserCodeStatePrefix is the prefix of session store key for user codes,
+	// which points to the device code
+	deviceUserCodeStatePrefix = "device_user_code_"
+	// userCodeCharset excludes vowels & similar looking characters as suggested by RFC 8628
+	userCodeCharset = "BCDFGHJKLMNPQRSTVWXZ"
+	// DeviceCodeExpiry is the lifetime of device code
+	DeviceCodeExpiry = 10 * time.Minute
+	// DeviceCodePollingInterval is the minimum interval between token requests of device
+	DeviceCodePollingInterval = 5 * time.Second
+)
+
+// DeviceCode holds the information bound to the issued device code
+type DeviceCode struct {
+	DeviceCode   string   `json:"device_code"`
+	UserCode     string   `json:"user_code"`
+	ClientID     string   `json:"client_id"`
+	Scope        string   `json:"scope"`
+	Status       string   `json:"status"`
+	UserID       string   `json:"user_id"`
+	Roles        []string `json:"roles"`
+	ExpiresAt    int64    `json:"expires_at"`
+	LastPolledAt int64    `json:"last_polled_at"`
+}
+
+// CreateDeviceCode creates new pending device code for the client
+// and saves it in session store
+func CreateDeviceCode(clientID, scope string) (*DeviceCode, error) {
+	userCode, err := generateUserCode()
+	if err != nil {
+		return nil, err
+	}
+
+	deviceCode := &DeviceCode{
+		DeviceCode: uuid.New().String(),
+		UserCode:   userCode,
+		ClientID:   clientID,
+		Scope:      scope,
+		Status:     constants.DeviceCodeStatusPending,
+		ExpiresAt:  time.Now().Add(DeviceCodeExpiry).Unix(),
+	}
+
+	err = SaveDeviceCode(deviceCode)
+	if err != nil {
+		return nil, err
+	}
+	sessionstore.SetState(deviceUserCodeStatePrefix+userCode, deviceCode.DeviceCode, DeviceCodeExpiry)
+
+	return deviceCode, nil
+}
+
+// SaveDeviceCode saves the device code in session store till it expires
+func SaveDeviceCode(deviceCode *DeviceCode) error {
+	expiresIn := time.Until(time.Unix(deviceCode.ExpiresAt, 0))
+	if expiresIn <= 0 {
+		return fmt.Errorf("device code expired")
+	}
+
+	deviceCodeBytes, err := json.Marshal(deviceCode)
+	if err != nil {
+		return err
+	}
+
+	sessionstore.SetState(deviceCodeStatePrefix+deviceCode.DeviceCode, string(deviceCodeBytes), expiresIn)
+	return nil
+}
+
+// GetDeviceCode returns the device code information from session store
+func GetDeviceCode(code string) (*DeviceCode, error) {
+	deviceCodeData := sessionstore.GetState(deviceCodeStatePrefix + code)
+	if deviceCodeData == "" {
+		return nil, fmt.Errorf("invalid or expired device code")
+	}
+
+	var deviceCode DeviceCode
+	err := json.Unmarshal([]byte(deviceCodeData), &deviceCode)
+	if err != nil {
+		return nil, err
+	}
+
+	return &deviceCode, nil
+}
+
+// GetDeviceCodeByUserCode returns the device code information using user code entered by user.
+// User code is case insensitive and hyphen is optional
+func GetDeviceCodeByUserCode(userCode string) (*DeviceCode, error) {
+	userCode = strings.ToUpper(strings.ReplaceAll(strings.TrimSpace(userCode), "-", ""))
+	if len(userCode) == 8 {
+		userCode = userCode[:4] + "-" + userCode[4:]
+	}
+
+	code := sessionstore.GetState(deviceUserCodeStatePrefix + userCode)
+	if code == "" {
+		return nil, fmt.Errorf("invalid or expired user code")
+	}
+
+	return GetDeviceCode(code)
+}
+
+// RemoveDeviceCode removes the device code & user code from session store
+func RemoveDeviceCode(deviceCode *DeviceCode) {
+	sessionstore.RemoveState(deviceCodeStatePrefix + deviceCode.DeviceCode)
+	sessionstore.RemoveState(deviceUserCodeStatePrefix + deviceCode.UserCode)
+}
+
+// generateUserCode generates user code of format XXXX-XXXX
+func generateUserCode() (string, error) {
+	code := make([]byte, 8)
+	for i := range code {
+		n, err := rand.Int(rand.Reader, big.NewInt(int64(len(userCodeCharset))))
+		if err != nil {
+			return "", err
+		}
+		code[i] = userCodeCharset[n.Int64()]
+	}
+
+	return string(code[:4]) + "-" + string(code[4:]), nil
+}
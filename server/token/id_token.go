@@ -0,0 +1,59 @@
+package token
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/authorizerdev/authorizer/server/constants"
+	"github.com/authorizerdev/authorizer/server/db/models"
+	"github.com/authorizerdev/authorizer/server/envstore"
+	"github.com/golang-jwt/jwt"
+)
+
+// CreateIDToken util to create OpenID Connect ID token,
+// which contains the standard & profile claims of user
+func CreateIDToken(user models.User, roles []string, options AuthTokenOptions) (string, int64, error) {
+	expiresAt := time.Now().Add(options.GetExpiryTime(constants.TokenTypeAccessToken, roles)).Unix()
+
+	customClaims := jwt.MapClaims{}
+	for k, v := range GetUserInfoClaims(user) {
+		customClaims[k] = v
+	}
+
+	customClaims["iss"] = envstore.EnvInMemoryStoreObj.GetStringStoreEnvVariable(constants.EnvKeyAuthorizerURL)
+	customClaims["aud"] = options.GetAudience()
+	customClaims["exp"] = expiresAt
+	customClaims["iat"] = time.Now().Unix()
+	customClaims["auth_time"] = options.AuthTime
+	customClaims["token_type"] = constants.TokenTypeIDToken
+	customClaims[envstore.EnvInMemoryStoreObj.GetStringStoreEnvVariable(constants.EnvKeyJwtRoleClaim)] = roles
+	if options.SessionID != "" {
+		customClaims["sid"] = options.SessionID
+	}
+	if options.Nonce != "" {
+		customClaims["nonce"] = options.Nonce
+	}
+
+	token, err := SignJWTToken(customClaims)
+	if err != nil {
+		return "", 0, err
+	}
+
+	return token, expiresAt, nil
+}
+
+// GetUserInfoClaims returns the OpenID Connect profile claims of the user.
+// It is used for ID token and userinfo endpoint
+func GetUserInfoClaims(user models.User) map[string]interface{} {
+	userBytes, _ := json.Marshal(user.AsAPIUser())
+	var claims map[string]interface{}
+	json.Unmarshal(userBytes, &claims)
+
+	// user identifier is represented by sub claim,
+	// and roles are represented by JWT_ROLE_CLAIM
+	claims["sub"] = user.ID
+	delete(claims, "id")
+	delete(claims, "roles")
+
+	return claims
+}
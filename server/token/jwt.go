@@ -0,0 +1,70 @@
+package token
+
+import (
+	"fmt"
+
+	"github.com/authorizerdev/authorizer/server/crypto"
+	"github.com/authorizerdev/authorizer/server/env"
+	"github.com/authorizerdev/authorizer/server/envstore"
+	"github.com/golang-jwt/jwt"
+)
+
+// SignJWTToken signs the given claims with the active key of the key ring.
+// HMAC algorithms use JWT_SECRET, asymmetric algorithms use JWT_PRIVATE_KEY
+func SignJWTToken(claims jwt.Claims) (string, error) {
+	key := env.GetActiveJwtKey(getJwtKeyEnv())
+	signingMethod := jwt.GetSigningMethod(key.Type)
+	if signingMethod == nil {
+		return "", fmt.Errorf("unsupported signing method %s", key.Type)
+	}
+
+	t := jwt.New(signingMethod)
+	t.Claims = claims
+	if key.ID != "" {
+		t.Header["kid"] = key.ID
+	}
+
+	if crypto.IsHMACAlgo(key.Type) {
+		return t.SignedString([]byte(key.Secret))
+	}
+
+	privateKey, err := crypto.ParsePrivateKey(key.Type, key.PrivateKey)
+	if err != nil {
+		return "", err
+	}
+
+	return t.SignedString(privateKey)
+}
+
+// ParseJWTToken parses & verifies the JWT token into given claims.
+// Key is selected using the kid header, so tokens signed with
+// retired keys are valid till the grace period ends
+func ParseJWTToken(token string, claims jwt.Claims) (*jwt.Token, error) {
+	return jwt.ParseWithClaims(token, claims, func(t *jwt.Token) (interface{}, error) {
+		keyID, _ := t.Header["kid"].(string)
+		key, err := env.GetJwtKeyByID(getJwtKeyEnv(), keyID)
+		if err != nil {
+			return nil, err
+		}
+
+		if t.Method.Alg() != key.Type {
+			return nil, fmt.Errorf("unexpected signing method %s", t.Method.Alg())
+		}
+
+		if crypto.IsHMACAlgo(key.Type) {
+			return []byte(key.Secret), nil
+		}
+
+		return crypto.ParsePublicKey(key.Type, key.PublicKey)
+	})
+}
+
+// getJwtKeyEnv returns the key ring envs, without cloning the whole env store
+func getJwtKeyEnv() map[string]string {
+	stringEnv := make(map[string]string, len(env.JwtKeyEnvs))
+	for _, key := range env.JwtKeyEnvs {
+		stringEnv[key] = envstore.EnvInMemoryStoreObj.GetStringStoreEnvVariable(key)
+	}
+
+	return stringEnv
+}
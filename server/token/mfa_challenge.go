@@ -0,0 +1,105 @@
+package token
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/authorizerdev/authorizer/server/sessionstore"
+	"github.com/authorizerdev/authorizer/server/utils"
+)
+
+const (
+	// mfaChallengeStatePrefix is the prefix of session store key for mfa challenges
+	mfaChallengeStatePrefix = "mfa_challenge_"
+	// mfaChallengeAttemptsStatePrefix is the prefix of session store key for invalid attempts of mfa challenges
+	mfaChallengeAttemptsStatePrefix = "mfa_challenge_attempts_"
+	// MfaChallengeExpiry is the lifetime of mfa challenge token
+	MfaChallengeExpiry = 5 * time.Minute
+	// MaxMfaChallengeAttempts is the number of invalid codes after which the challenge is removed
+	MaxMfaChallengeAttempts = 5
+)
+
+// MfaChallenge holds the information of user who has passed the first factor
+// and has to verify the second factor to get the auth tokens
+type MfaChallenge struct {
+	Token     string   `json:"token"`
+	UserID    string   `json:"user_id"`
+	Roles     []string `json:"roles"`
+	AuthTime  int64    `json:"auth_time"`
+	ExpiresAt int64    `json:"expires_at"`
+}
+
+// CreateMfaChallenge creates new mfa challenge for the user and saves it in session store
+func CreateMfaChallenge(userID string, roles []string) (*MfaChallenge, error) {
+	challengeToken, err := utils.GenerateRandomString(32)
+	if err != nil {
+		return nil, err
+	}
+
+	challenge := &MfaChallenge{
+		Token:     challengeToken,
+		UserID:    userID,
+		Roles:     roles,
+		AuthTime:  time.Now().Unix(),
+		ExpiresAt: time.Now().Add(MfaChallengeExpiry).Unix(),
+	}
+
+	err = SaveMfaChallenge(challenge)
+	if err != nil {
+		return nil, err
+	}
+
+	return challenge, nil
+}
+
+// SaveMfaChallenge saves the mfa challenge in session store till it expires
+func SaveMfaChallenge(challenge *MfaChallenge) error {
+	expiresIn := time.Until(time.Unix(challenge.ExpiresAt, 0))
+	if expiresIn <= 0 {
+		return fmt.Errorf("mfa challenge expired")
+	}
+
+	challengeBytes, err := json.Marshal(challenge)
+	if err != nil {
+		return err
+	}
+
+	sessionstore.SetState(mfaChallengeStatePrefix+challenge.Token, string(challengeBytes), expiresIn)
+	return nil
+}
+
+// GetMfaChallenge returns the mfa challenge information from session store
+func GetMfaChallenge(challengeToken string) (*MfaChallenge, error) {
+	challengeData := sessionstore.GetState(mfaChallengeStatePrefix + challengeToken)
+	if challengeData == "" {
+		return nil, fmt.Errorf("invalid or expired mfa token")
+	}
+
+	var challenge MfaChallenge
+	err := json.Unmarshal([]byte(challengeData), &challenge)
+	if err != nil {
+		return nil, err
+	}
+
+	return &challenge, nil
+}
+
+// RecordMfaChallengeFailedAttempt counts the invalid attempt of mfa challenge atomically,
+// so that parallel requests can not exceed MaxMfaChallengeAttempts.
+// Challenge is removed and true is returned once the limit is reached
+func RecordMfaChallengeFailedAttempt(challenge *MfaChallenge) bool {
+	attempts, err := sessionstore.IncrementState(mfaChallengeAttemptsStatePrefix+challenge.Token, MfaChallengeExpiry)
+	if err == nil && attempts < MaxMfaChallengeAttempts {
+		return false
+	}
+
+	RemoveMfaChallenge(challenge)
+	return true
+}
+
+// RemoveMfaChallenge removes the mfa challenge & its invalid attempts from session store
+func RemoveMfaChallenge(challenge *MfaChallenge) {
+	sessionstore.RemoveState(mfaChallengeStatePrefix + challenge.Token)
+	sessionstore.RemoveState(mfaChallengeAttemptsStatePrefix + challenge.Token)
+}
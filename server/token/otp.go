@@ -0,0 +1,86 @@
+package token
+
+import (
+	"crypto/subtle"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/authorizerdev/authorizer/server/db"
+	"github.com/authorizerdev/authorizer/server/db/models"
+	"github.com/authorizerdev/authorizer/server/env"
+	"github.com/authorizerdev/authorizer/server/sessionstore"
+	"github.com/authorizerdev/authorizer/server/utils"
+)
+
+const (
+	// otpAttemptsStatePrefix is the prefix of session store key for the invalid attempts of one time code
+	otpAttemptsStatePrefix = "otp_attempts_"
+	// otpUsedStatePrefix is the prefix of session store key for the verification request whose code was redeemed
+	otpUsedStatePrefix = "otp_used_"
+	// MaxOTPAttempts is the number of invalid attempts after which the one time code is removed
+	MaxOTPAttempts = 5
+)
+
+// CreateOTPVerificationRequest generates new one time code to be sent to the recipient (email / phone number)
+// and saves its hash as verification request of the user email & identifier. Previous code is replaced
+func CreateOTPVerificationRequest(email, identifier, recipient string) (string, error) {
+	otp, err := utils.GenerateOTP()
+	if err != nil {
+		return "", err
+	}
+
+	if verificationRequest, err := db.Provider.GetVerificationRequestByEmail(email, identifier); err == nil {
+		db.Provider.DeleteVerificationRequest(verificationRequest)
+	}
+	sessionstore.RemoveState(otpAttemptsStatePrefix + identifier + "_" + email)
+
+	_, err = db.Provider.AddVerificationRequest(models.VerificationRequest{
+		Token:      utils.HashOTP(recipient, otp),
+		Identifier: identifier,
+		ExpiresAt:  time.Now().Add(env.GetVerificationTokenExpiryTime()).Unix(),
+		Email:      email,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return otp, nil
+}
+
+// VerifyOTPVerificationRequest verifies the one time code sent to the recipient and removes the verification request.
+// Code is removed after MaxOTPAttempts invalid attempts, so that it can not be brute forced.
+// Invalid attempts are counted and valid code is redeemed atomically,
+// so that parallel requests can not exceed the limit or use the same code twice
+func VerifyOTPVerificationRequest(email, identifier, recipient, otp string) error {
+	verificationRequest, err := db.Provider.GetVerificationRequestByEmail(email, identifier)
+	if err != nil {
+		return errors.New(`invalid otp`)
+	}
+
+	if verificationRequest.ExpiresAt < time.Now().Unix() {
+		db.Provider.DeleteVerificationRequest(verificationRequest)
+		return errors.New(`otp expired, please request new otp`)
+	}
+
+	attemptsKey := otpAttemptsStatePrefix + identifier + "_" + email
+	if subtle.ConstantTimeCompare([]byte(verificationRequest.Token), []byte(utils.HashOTP(recipient, strings.TrimSpace(otp)))) != 1 {
+		attempts, err := sessionstore.IncrementState(attemptsKey, time.Until(time.Unix(verificationRequest.ExpiresAt, 0)))
+		if err != nil || attempts >= MaxOTPAttempts {
+			sessionstore.RemoveState(attemptsKey)
+			db.Provider.DeleteVerificationRequest(verificationRequest)
+			return errors.New(`too many invalid attempts, please request new otp`)
+		}
+
+		return errors.New(`invalid otp`)
+	}
+
+	redeemed, err := sessionstore.IncrementState(otpUsedStatePrefix+verificationRequest.ID, time.Until(time.Unix(verificationRequest.ExpiresAt, 0)))
+	if err != nil || redeemed != 1 {
+		return errors.New(`invalid otp`)
+	}
+
+	sessionstore.RemoveState(attemptsKey)
+	db.Provider.DeleteVerificationRequest(verificationRequest)
+	return nil
+}
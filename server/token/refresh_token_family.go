@@ -0,0 +1,271 @@
+package token
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/authorizerdev/authorizer/server/audit"
+	"github.com/authorizerdev/authorizer/server/constants"
+	"github.com/authorizerdev/authorizer/server/db"
+	"github.com/authorizerdev/authorizer/server/db/models"
+	"github.com/authorizerdev/authorizer/server/email"
+	"github.com/authorizerdev/authorizer/server/envstore"
+	"github.com/authorizerdev/authorizer/server/sessionstore"
+	"github.com/authorizerdev/authorizer/server/utils"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	// refreshTokenFamilyStatePrefix is the prefix of session store key for refresh token families,
+	// which holds the latest refresh token of the family
+	refreshTokenFamilyStatePrefix = "refresh_token_family_"
+	// refreshTokenRotationStatePrefix is the prefix of session store key for the lock of refresh token rotation
+	refreshTokenRotationStatePrefix = "refresh_token_rotation_"
+	// refreshTokenReuseGracePeriod is the duration after rotation during which the previous refresh token
+	// is not considered as reused, so that parallel refresh requests (e.g. multiple tabs) do not revoke the family
+	refreshTokenReuseGracePeriod = 30 * time.Second
+)
+
+// refreshTokenFamily is the latest refresh token of the active family
+type refreshTokenFamily struct {
+	// Jti is the id of the latest refresh token
+	Jti string `json:"jti"`
+	// SessionID is the session id (sid) of the latest tokens, access tokens of older sessions are not active
+	SessionID string `json:"sid"`
+	// PreviousJti is the id of the refresh token rotated to the latest one
+	PreviousJti string `json:"previous_jti,omitempty"`
+	// RotatedAt is the unix time when the previous refresh token was rotated
+	RotatedAt int64 `json:"rotated_at,omitempty"`
+}
+
+// setRefreshTokenFamily saves the jti & sid of the latest refresh token of the family,
+// along with the jti of the rotated one
+func setRefreshTokenFamily(familyID, jti, sessionID string, expiresAt int64) {
+	expiresIn := time.Until(time.Unix(expiresAt, 0))
+	if expiresIn <= 0 {
+		return
+	}
+
+	family := refreshTokenFamily{
+		Jti:       jti,
+		SessionID: sessionID,
+	}
+	if previousFamily := getRefreshTokenFamily(familyID); previousFamily != nil && previousFamily.Jti != jti {
+		family.PreviousJti = previousFamily.Jti
+		family.RotatedAt = time.Now().Unix()
+	}
+
+	familyBytes, err := json.Marshal(family)
+	if err != nil {
+		log.Println("error saving refresh token family:", err)
+		return
+	}
+
+	sessionstore.SetState(refreshTokenFamilyStatePrefix+familyID, string(familyBytes), expiresIn)
+}
+
+// getRefreshTokenFamily returns the latest refresh token of the family, nil means family is not active
+func getRefreshTokenFamily(familyID string) *refreshTokenFamily {
+	familyData := sessionstore.GetState(refreshTokenFamilyStatePrefix + familyID)
+	if familyData == "" {
+		return nil
+	}
+
+	var family refreshTokenFamily
+	if err := json.Unmarshal([]byte(familyData), &family); err != nil {
+		return nil
+	}
+
+	return &family
+}
+
+// GetRefreshTokenFamilyID returns the family id (fid) of the verified refresh token
+func GetRefreshTokenFamilyID(claims map[string]interface{}) string {
+	familyID, _ := claims["fid"].(string)
+	return familyID
+}
+
+// isRecentlyRotated checks if the jti is of the refresh token rotated within the grace period
+func (family refreshTokenFamily) isRecentlyRotated(jti interface{}) bool {
+	return family.PreviousJti != "" && family.PreviousJti == jti &&
+		time.Since(time.Unix(family.RotatedAt, 0)) <= refreshTokenReuseGracePeriod
+}
+
+// IsRefreshTokenReused checks if the verified refresh token was already rotated,
+// i.e. it is neither the latest token of its active family nor the one rotated within the grace period
+func IsRefreshTokenReused(claims map[string]interface{}) bool {
+	familyID := GetRefreshTokenFamilyID(claims)
+	if familyID == "" {
+		return false
+	}
+
+	family := getRefreshTokenFamily(familyID)
+	return family != nil && family.Jti != claims["jti"] && !family.isRecentlyRotated(claims["jti"])
+}
+
+// LockRefreshTokenRotation makes sure that only one of the parallel requests rotates the refresh token.
+// false means the refresh token was already rotated within the grace period or is being rotated by another request,
+// in which case the tokens of the latest session of the family are returned using GetRefreshTokenFamilyAuthToken
+func LockRefreshTokenRotation(claims map[string]interface{}) bool {
+	familyID := GetRefreshTokenFamilyID(claims)
+	if familyID == "" {
+		return true
+	}
+
+	family := getRefreshTokenFamily(familyID)
+	if family == nil {
+		return true
+	}
+	if family.Jti != claims["jti"] {
+		return false
+	}
+
+	jti, _ := claims["jti"].(string)
+	count, err := sessionstore.IncrementState(refreshTokenRotationStatePrefix+jti, refreshTokenReuseGracePeriod)
+	return err == nil && count == 1
+}
+
+// GetRefreshTokenFamilyAuthToken returns the refresh token of the latest session of the family
+// along with the new access & id tokens of that session.
+// It is used for the parallel requests using the refresh token rotated within the grace period
+func GetRefreshTokenFamilyAuthToken(user models.User, roles []string, claims map[string]interface{}, options AuthTokenOptions) (*Token, error) {
+	options.FamilyID = GetRefreshTokenFamilyID(claims)
+	family := getRefreshTokenFamily(options.FamilyID)
+	if family == nil {
+		return nil, fmt.Errorf("refresh token family is not active")
+	}
+
+	for fingerPrint, refreshToken := range sessionstore.GetUserSessions(user.ID) {
+		refreshTokenClaims, err := VerifyJWTToken(refreshToken)
+		if err != nil || refreshTokenClaims["jti"] != family.Jti {
+			continue
+		}
+
+		fingerPrintHashBytes, err := utils.EncryptAES([]byte(fingerPrint))
+		if err != nil {
+			return nil, err
+		}
+
+		options.SessionID = family.SessionID
+		accessToken, accessTokenExpiresAt, err := CreateAccessToken(user, roles, options)
+		if err != nil {
+			return nil, err
+		}
+
+		idToken, idTokenExpiresAt, err := CreateIDToken(user, roles, options)
+		if err != nil {
+			return nil, err
+		}
+
+		return &Token{
+			FingerPrint:     fingerPrint,
+			FingerPrintHash: string(fingerPrintHashBytes),
+			FamilyID:        options.FamilyID,
+			RefreshToken:    &JWTToken{Token: refreshToken, ExpiresAt: getClaimInt64(refreshTokenClaims, "exp")},
+			AccessToken:     &JWTToken{Token: accessToken, ExpiresAt: accessTokenExpiresAt},
+			IDToken:         &JWTToken{Token: idToken, ExpiresAt: idTokenExpiresAt},
+		}, nil
+	}
+
+	return nil, fmt.Errorf("refresh token family session not found")
+}
+
+// IsRefreshTokenFamilySession checks if the session id (sid) belongs to the latest tokens of the active family
+func IsRefreshTokenFamilySession(familyID, sessionID string) bool {
+	family := getRefreshTokenFamily(familyID)
+	return family != nil && family.SessionID == sessionID
+}
+
+// RevokeRefreshTokenFamily ends the sessions holding the refresh tokens of the family,
+// which also makes the access tokens issued with them inactive
+func RevokeRefreshTokenFamily(userID, familyID string) {
+	for fingerPrint, refreshToken := range sessionstore.GetUserSessions(userID) {
+		claims, err := VerifyJWTToken(refreshToken)
+		if err == nil && GetRefreshTokenFamilyID(claims) == familyID {
+			sessionstore.DeleteUserSession(userID, fingerPrint)
+		}
+	}
+
+	sessionstore.RemoveState(refreshTokenFamilyStatePrefix + familyID)
+}
+
+// DeleteUserSession ends the session of the fingerprint along with its refresh token family,
+// which also makes the access tokens issued with it inactive
+func DeleteUserSession(userID, fingerPrint string) {
+	removeRefreshTokenFamily(sessionstore.GetUserSession(userID, fingerPrint))
+	sessionstore.DeleteUserSession(userID, fingerPrint)
+}
+
+// DeleteAllUserSessions ends all the sessions of user along with their refresh token families
+func DeleteAllUserSessions(userID string) {
+	for _, refreshToken := range sessionstore.GetUserSessions(userID) {
+		removeRefreshTokenFamily(refreshToken)
+	}
+	sessionstore.DeleteAllUserSession(userID)
+}
+
+// removeRefreshTokenFamily removes the family of the refresh token held by the session
+func removeRefreshTokenFamily(refreshToken string) {
+	if refreshToken == "" {
+		return
+	}
+
+	claims, err := VerifyJWTToken(refreshToken)
+	if err != nil {
+		return
+	}
+
+	if familyID := GetRefreshTokenFamilyID(claims); familyID != "" {
+		sessionstore.RemoveState(refreshTokenFamilyStatePrefix + familyID)
+	}
+}
+
+// HandleRefreshTokenReuse revokes the whole family of the reused refresh token,
+// records the security event and alerts the user via email if enabled
+func HandleRefreshTokenReuse(gc *gin.Context, claims map[string]interface{}) {
+	userID, _ := claims["id"].(string)
+	familyID := GetRefreshTokenFamilyID(claims)
+	RevokeRefreshTokenFamily(userID, familyID)
+
+	event := audit.NewEvent(constants.AuditActionRefreshTokenReuse)
+	event.TargetType = constants.AuditTargetTypeUser
+	event.TargetID = userID
+	audit.Log(gc, *event, fmt.Errorf("refresh token reuse detected, token family %s revoked", familyID))
+
+	if envstore.EnvInMemoryStoreObj.GetBoolStoreEnvVariable(constants.EnvKeyDisableSecurityAlertEmail) {
+		return
+	}
+
+	user, err := db.Provider.GetUserByID(userID)
+	if err != nil {
+		return
+	}
+
+	go func() {
+		err := email.SendTokenReuseAlertMail(user.Email)
+		if err != nil {
+			log.Println("error sending token reuse alert email:", err)
+		}
+	}()
+}
+
+// GetActiveRefreshTokenFamilies returns the refresh token families of user sessions present in session store,
+// mapped to the session id (sid) of their latest tokens
+func GetActiveRefreshTokenFamilies(userID string) map[string]string {
+	families := map[string]string{}
+	for _, refreshToken := range sessionstore.GetUserSessions(userID) {
+		claims, err := VerifyJWTToken(refreshToken)
+		if err != nil {
+			continue
+		}
+
+		if familyID := GetRefreshTokenFamilyID(claims); familyID != "" {
+			sessionID, _ := claims["sid"].(string)
+			families[familyID] = sessionID
+		}
+	}
+
+	return families
+}
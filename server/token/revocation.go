@@ -0,0 +1,84 @@
+package token
+
+import (
+	"time"
+
+	"github.com/authorizerdev/authorizer/server/constants"
+	"github.com/authorizerdev/authorizer/server/sessionstore"
+)
+
+// revokedTokenStatePrefix is the prefix of session store key for revoked token ids (jti)
+const revokedTokenStatePrefix = "revoked_token_"
+
+// RevokeToken adds the token id (jti) to the revoked list till the token expires
+func RevokeToken(claims map[string]interface{}) {
+	jti, _ := claims["jti"].(string)
+	if jti == "" {
+		return
+	}
+
+	expiresIn := time.Until(time.Unix(getClaimInt64(claims, "exp"), 0))
+	if expiresIn <= 0 {
+		return
+	}
+
+	sessionstore.SetState(revokedTokenStatePrefix+jti, "true", expiresIn)
+}
+
+// IsTokenRevoked checks if the token id (jti) is in the revoked list
+func IsTokenRevoked(claims map[string]interface{}) bool {
+	jti, _ := claims["jti"].(string)
+	if jti == "" {
+		return false
+	}
+
+	return sessionstore.GetState(revokedTokenStatePrefix+jti) != ""
+}
+
+// IsAccessTokenActive checks if the verified access token is not revoked and,
+// for user tokens, that the session it was issued with is the latest of its refresh token family
+func IsAccessTokenActive(claims map[string]interface{}) bool {
+	if claims["token_type"] != constants.TokenTypeAccessToken || IsTokenRevoked(claims) {
+		return false
+	}
+
+	// tokens issued via client_credentials grant are not bound to user session
+	userID, _ := claims["id"].(string)
+	if userID == "" {
+		return claims["client_id"] != nil
+	}
+
+	familyID, _ := claims["fid"].(string)
+	if familyID == "" {
+		// tokens issued before refresh token families only need an active user session
+		return len(sessionstore.GetUserSessions(userID)) > 0
+	}
+
+	sessionID, _ := claims["sid"].(string)
+	return IsRefreshTokenFamilySession(familyID, sessionID)
+}
+
+// GetRefreshTokenFingerPrint returns the fingerprint of the session holding
+// the exact refresh token, empty string means refresh token is not active
+func GetRefreshTokenFingerPrint(userID, refreshToken string) string {
+	for fingerPrint, value := range sessionstore.GetUserSessions(userID) {
+		if value == refreshToken {
+			return fingerPrint
+		}
+	}
+
+	return ""
+}
+
+// getClaimInt64 returns the numeric claim as int64.
+// VerifyJWTToken converts exp & iat to int64, while other numeric claims are float64
+func getClaimInt64(claims map[string]interface{}, key string) int64 {
+	switch v := claims[key].(type) {
+	case int64:
+		return v
+	case float64:
+		return int64(v)
+	}
+
+	return 0
+}
@@ -0,0 +1,76 @@
+package token
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/authorizerdev/authorizer/server/sessionstore"
+	"github.com/authorizerdev/authorizer/server/utils"
+)
+
+const (
+	// webauthnChallengeStatePrefix is the prefix of session store key for webauthn challenges
+	webauthnChallengeStatePrefix = "webauthn_challenge_"
+	// WebauthnChallengeExpiry is the lifetime of webauthn challenge
+	WebauthnChallengeExpiry = 5 * time.Minute
+	// WebauthnChallengeTypeRegistration is the challenge of passkey registration
+	WebauthnChallengeTypeRegistration = "registration"
+	// WebauthnChallengeTypeLogin is the challenge of login with passkey
+	WebauthnChallengeTypeLogin = "login"
+)
+
+// WebauthnChallenge holds the information bound to the challenge signed by authenticator
+type WebauthnChallenge struct {
+	Challenge string `json:"challenge"`
+	Type      string `json:"type"`
+	// UserID is the user registering passkey or the user expected to login, empty for discoverable login
+	UserID string `json:"user_id"`
+	// MfaToken is set when passkey is used as second factor
+	MfaToken string `json:"mfa_token"`
+}
+
+// CreateWebauthnChallenge creates new webauthn challenge and saves it in session store
+func CreateWebauthnChallenge(challengeType, userID, mfaToken string) (*WebauthnChallenge, error) {
+	challenge, err := utils.GenerateRandomString(32)
+	if err != nil {
+		return nil, err
+	}
+
+	webauthnChallenge := &WebauthnChallenge{
+		Challenge: challenge,
+		Type:      challengeType,
+		UserID:    userID,
+		MfaToken:  mfaToken,
+	}
+
+	webauthnChallengeBytes, err := json.Marshal(webauthnChallenge)
+	if err != nil {
+		return nil, err
+	}
+
+	sessionstore.SetState(webauthnChallengeStatePrefix+challenge, string(webauthnChallengeBytes), WebauthnChallengeExpiry)
+	return webauthnChallenge, nil
+}
+
+// ConsumeWebauthnChallenge returns the webauthn challenge of given type from session store and removes it,
+// so that a challenge can be verified only once
+func ConsumeWebauthnChallenge(challenge, challengeType string) (*WebauthnChallenge, error) {
+	// challenge is removed while reading, so that concurrent requests can't use it twice
+	webauthnChallengeData := sessionstore.PopState(webauthnChallengeStatePrefix + challenge)
+	if webauthnChallengeData == "" {
+		return nil, fmt.Errorf("invalid or expired challenge")
+	}
+
+	var webauthnChallenge WebauthnChallenge
+	err := json.Unmarshal([]byte(webauthnChallengeData), &webauthnChallenge)
+	if err != nil {
+		return nil, err
+	}
+
+	if webauthnChallenge.Type != challengeType {
+		return nil, fmt.Errorf("invalid challenge")
+	}
+
+	return &webauthnChallenge, nil
+}
@@ -0,0 +1,257 @@
+package userimport
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/authorizerdev/authorizer/server/constants"
+	"github.com/authorizerdev/authorizer/server/crypto"
+	"github.com/authorizerdev/authorizer/server/db"
+	"github.com/authorizerdev/authorizer/server/db/models"
+	"github.com/authorizerdev/authorizer/server/envstore"
+	"github.com/authorizerdev/authorizer/server/utils"
+)
+
+const (
+	// FormatJSON is the format of JSON array of users
+	FormatJSON = "json"
+	// FormatCSV is the format of CSV with header row of user fields
+	FormatCSV = "csv"
+)
+
+// User is the user record of export from other identity systems.
+// In CSV, roles are comma separated and verified flags are true or false
+type User struct {
+	Email               string   `json:"email"`
+	EmailVerified       bool     `json:"email_verified"`
+	PasswordHash        string   `json:"password_hash"`
+	GivenName           string   `json:"given_name"`
+	FamilyName          string   `json:"family_name"`
+	MiddleName          string   `json:"middle_name"`
+	Nickname            string   `json:"nickname"`
+	Gender              string   `json:"gender"`
+	Birthdate           string   `json:"birthdate"`
+	PhoneNumber         string   `json:"phone_number"`
+	PhoneNumberVerified bool     `json:"phone_number_verified"`
+	Picture             string   `json:"picture"`
+	Roles               []string `json:"roles"`
+}
+
+// Error is the error of importing user at row (starting from 1) of the export
+type Error struct {
+	Row   int
+	Email string
+	Error string
+}
+
+// Result is the result of import
+type Result struct {
+	Imported int
+	Errors   []Error
+}
+
+// Parse parses the users export of given format (json or csv)
+func Parse(format string, data []byte) ([]User, error) {
+	switch strings.ToLower(format) {
+	case FormatJSON:
+		users := []User{}
+		if err := json.Unmarshal(data, &users); err != nil {
+			return nil, fmt.Errorf("invalid json users export: %s", err.Error())
+		}
+		return users, nil
+	case FormatCSV:
+		return parseCSV(data)
+	}
+
+	return nil, fmt.Errorf("invalid format %s, it should be json or csv", format)
+}
+
+// ImportFile imports the users export file, format is detected from the file extension when empty
+func ImportFile(path, format string) (*Result, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if format == "" {
+		format = strings.TrimPrefix(strings.ToLower(filepath.Ext(path)), ".")
+	}
+
+	users, err := Parse(format, data)
+	if err != nil {
+		return nil, err
+	}
+
+	return Import(users), nil
+}
+
+// Import adds the users to database. Users that already exist or have invalid
+// data are not imported and reported as errors, so that import can be run again.
+// Password policy (PASSWORD_MIN_LENGTH, PASSWORD_CHARACTER_CLASSES & breached passwords) can not be
+// checked for imported hashes, it is applied when the user changes or resets the password
+func Import(users []User) *Result {
+	result := &Result{
+		Errors: []Error{},
+	}
+
+	for i, user := range users {
+		if err := importUser(user); err != nil {
+			result.Errors = append(result.Errors, Error{
+				Row:   i + 1,
+				Email: user.Email,
+				Error: err.Error(),
+			})
+			continue
+		}
+		result.Imported++
+	}
+
+	return result
+}
+
+// importUser validates and adds the user to database
+func importUser(importedUser User) error {
+	email := strings.ToLower(strings.TrimSpace(importedUser.Email))
+	if !utils.IsValidEmail(email) {
+		return fmt.Errorf("invalid email address")
+	}
+
+	if _, err := db.Provider.GetUserByEmail(email); err == nil {
+		return fmt.Errorf("user with this email already exists")
+	}
+
+	roles := envstore.EnvInMemoryStoreObj.GetSliceStoreEnvVariable(constants.EnvKeyDefaultRoles)
+	if len(importedUser.Roles) > 0 {
+		if !utils.IsValidRoles(envstore.EnvInMemoryStoreObj.GetSliceStoreEnvVariable(constants.EnvKeyRoles), importedUser.Roles) {
+			return fmt.Errorf("invalid roles")
+		}
+		roles = importedUser.Roles
+	}
+
+	now := time.Now().Unix()
+	user := models.User{
+		Email:         email,
+		Roles:         strings.Join(roles, ","),
+		SignupMethods: constants.SignupMethodMagicLinkLogin,
+	}
+
+	// foreign hashes are verified on first login and then upgraded to the native hash
+	if importedUser.PasswordHash != "" {
+		if !crypto.IsSupportedPasswordHash(importedUser.PasswordHash) {
+			return fmt.Errorf("unsupported password hash format")
+		}
+		user.Password = &importedUser.PasswordHash
+		user.SignupMethods = constants.SignupMethodBasicAuth
+	}
+
+	if importedUser.EmailVerified {
+		user.EmailVerifiedAt = &now
+	}
+
+	if importedUser.PhoneNumber != "" {
+		if !utils.IsValidPhoneNumber(importedUser.PhoneNumber) {
+			return fmt.Errorf("invalid phone number, phone number should be in E.164 format")
+		}
+		user.PhoneNumber = &importedUser.PhoneNumber
+		if importedUser.PhoneNumberVerified {
+			// verified phone number identifies the user in phone login
+			if _, err := db.Provider.GetUserByVerifiedPhoneNumber(importedUser.PhoneNumber); err == nil {
+				return fmt.Errorf("phone number is already used by another user")
+			}
+			user.PhoneNumberVerifiedAt = &now
+		}
+	}
+
+	user.GivenName = optionalString(importedUser.GivenName)
+	user.FamilyName = optionalString(importedUser.FamilyName)
+	user.MiddleName = optionalString(importedUser.MiddleName)
+	user.Nickname = optionalString(importedUser.Nickname)
+	user.Gender = optionalString(importedUser.Gender)
+	user.Birthdate = optionalString(importedUser.Birthdate)
+	user.Picture = optionalString(importedUser.Picture)
+
+	_, err := db.Provider.AddUser(user)
+	return err
+}
+
+// parseCSV parses the csv users export with header row
+func parseCSV(data []byte) ([]User, error) {
+	reader := csv.NewReader(bytes.NewReader(data))
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("invalid csv users export: %s", err.Error())
+	}
+	for i, column := range header {
+		header[i] = strings.ToLower(strings.TrimSpace(column))
+	}
+
+	users := []User{}
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid csv users export: %s", err.Error())
+		}
+
+		user := User{}
+		for i, column := range header {
+			value := strings.TrimSpace(record[i])
+			switch column {
+			case "email":
+				user.Email = value
+			case "email_verified":
+				user.EmailVerified, _ = strconv.ParseBool(value)
+			case "password_hash":
+				user.PasswordHash = value
+			case "given_name":
+				user.GivenName = value
+			case "family_name":
+				user.FamilyName = value
+			case "middle_name":
+				user.MiddleName = value
+			case "nickname":
+				user.Nickname = value
+			case "gender":
+				user.Gender = value
+			case "birthdate":
+				user.Birthdate = value
+			case "phone_number":
+				user.PhoneNumber = value
+			case "phone_number_verified":
+				user.PhoneNumberVerified, _ = strconv.ParseBool(value)
+			case "picture":
+				user.Picture = value
+			case "roles":
+				for _, role := range strings.Split(value, ",") {
+					if strings.TrimSpace(role) != "" {
+						user.Roles = append(user.Roles, strings.TrimSpace(role))
+					}
+				}
+			}
+		}
+		users = append(users, user)
+	}
+
+	return users, nil
+}
+
+// optionalString returns nil for empty string
+func optionalString(value string) *string {
+	if value == "" {
+		return nil
+	}
+
+	return &value
+}
@@ -2,6 +2,7 @@ package utils
 
 import (
 	"log"
+	"time"
 
 	"github.com/authorizerdev/authorizer/server/db"
 	"github.com/authorizerdev/authorizer/server/db/models"
@@ -19,12 +20,15 @@ func StringSliceContains(s []string, e string) bool {
 }
 
 // SaveSessionInDB saves sessions generated for a given user with meta information
-// Do not store token here as that could be security breach
-func SaveSessionInDB(userId string, c *gin.Context) {
+// Do not store token here as that could be security breach,
+// refresh token family id is saved to identify the session in session store
+func SaveSessionInDB(userId, familyID string, c *gin.Context) {
 	sessionData := models.Session{
-		UserID:    userId,
-		UserAgent: GetUserAgent(c.Request),
-		IP:        GetIP(c.Request),
+		UserID:     userId,
+		FamilyID:   familyID,
+		UserAgent:  GetUserAgent(c.Request),
+		IP:         GetIP(c.Request),
+		LastUsedAt: time.Now().Unix(),
 	}
 
 	err := db.Provider.AddSession(sessionData)
@@ -34,3 +38,20 @@ func SaveSessionInDB(userId string, c *gin.Context) {
 		log.Println("=> session saved in db:", sessionData)
 	}
 }
+
+// UpdateSessionLastUsedInDB sets the last used time of the session identified by refresh token family
+func UpdateSessionLastUsedInDB(familyID string) {
+	if familyID == "" {
+		return
+	}
+
+	session, err := db.Provider.GetSessionByFamilyID(familyID)
+	if err != nil {
+		return
+	}
+
+	session.LastUsedAt = time.Now().Unix()
+	if _, err := db.Provider.UpdateSession(session); err != nil {
+		log.Println("=> error updating session in db:", err)
+	}
+}
@@ -3,12 +3,18 @@ package utils
 import (
 	"crypto/aes"
 	"crypto/cipher"
+	"crypto/hmac"
 	"crypto/rand"
+	"crypto/sha256"
 	"encoding/base64"
+	"encoding/hex"
 	"encoding/json"
+	"fmt"
 	"io"
+	"math/big"
 
 	"github.com/authorizerdev/authorizer/server/constants"
+	"github.com/authorizerdev/authorizer/server/crypto"
 	"github.com/authorizerdev/authorizer/server/envstore"
 	"golang.org/x/crypto/bcrypt"
 )
@@ -124,3 +130,56 @@ func EncryptPassword(password string) (string, error) {
 
 	return string(pw), nil
 }
+
+// HashPassword hashes the user password with PASSWORD_HASH_ALGORITHM (argon2id by default)
+func HashPassword(password string) (string, error) {
+	return crypto.HashPassword(GetPasswordHashAlgorithm(), password)
+}
+
+// VerifyPassword checks if the password matches the hash of user password
+func VerifyPassword(hash, password string) bool {
+	return crypto.VerifyPassword(hash, password)
+}
+
+// PasswordNeedsRehash checks if the hash of user password is created with outdated algorithm or parameters
+func PasswordNeedsRehash(hash string) bool {
+	return crypto.PasswordNeedsRehash(GetPasswordHashAlgorithm(), hash)
+}
+
+// GetPasswordHashAlgorithm returns the algorithm used for hashing user passwords
+func GetPasswordHashAlgorithm() string {
+	algorithm := envstore.EnvInMemoryStoreObj.GetStringStoreEnvVariable(constants.EnvKeyPasswordHashAlgorithm)
+	if algorithm == "" {
+		return crypto.PasswordHashAlgorithmArgon2id
+	}
+
+	return algorithm
+}
+
+// GenerateRandomString generates url safe random string from given number of random bytes
+func GenerateRandomString(length int) (string, error) {
+	b := make([]byte, length)
+	if _, err := io.ReadFull(rand.Reader, b); err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// GenerateOTP generates random numeric one time code of 6 digits
+func GenerateOTP() (string, error) {
+	n, err := rand.Int(rand.Reader, big.NewInt(1000000))
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%06d", n.Int64()), nil
+}
+
+// HashOTP returns the hash of one time code sent to the given recipient (email / phone number),
+// keyed with ENCRYPTION_KEY, so that codes are not stored as plain text
+func HashOTP(recipient, otp string) string {
+	mac := hmac.New(sha256.New, []byte(envstore.EnvInMemoryStoreObj.GetStringStoreEnvVariable(constants.EnvKeyEncryptionKey)))
+	mac.Write([]byte(recipient + ":" + otp))
+	return hex.EncodeToString(mac.Sum(nil))
+}
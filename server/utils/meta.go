@@ -1,20 +1,127 @@
 package utils
 
 import (
+	"log"
+	"sync"
+	"time"
+
 	"github.com/authorizerdev/authorizer/server/constants"
+	"github.com/authorizerdev/authorizer/server/db"
 	"github.com/authorizerdev/authorizer/server/envstore"
 	"github.com/authorizerdev/authorizer/server/graph/model"
+	"github.com/authorizerdev/authorizer/server/sms"
+)
+
+// metaOIDCProvidersCacheTTL is the time after which the cached OpenID Connect providers of meta are reloaded,
+// so that changes done on other instances are picked up
+const metaOIDCProvidersCacheTTL = time.Minute
+
+var (
+	// metaOIDCProviders caches the enabled OpenID Connect providers, as meta is queried on every page load
+	metaOIDCProviders          []*model.MetaOIDCProvider
+	metaOIDCProvidersExpiresAt time.Time
+	metaOIDCProvidersMutex     sync.Mutex
 )
 
 // GetMeta helps in getting the meta data about the deployment from EnvData
-func GetMetaInfo() model.Meta {
+// and the built in social login providers that are initialized
+func GetMetaInfo(oauthProviders []string) model.Meta {
+	oidcProviders := getMetaOIDCProviders()
+
 	return model.Meta{
 		Version:                      envstore.EnvInMemoryStoreObj.GetStringStoreEnvVariable(constants.EnvKeyVersion),
-		IsGoogleLoginEnabled:         envstore.EnvInMemoryStoreObj.GetStringStoreEnvVariable(constants.EnvKeyGoogleClientID) != "" && envstore.EnvInMemoryStoreObj.GetStringStoreEnvVariable(constants.EnvKeyGoogleClientSecret) != "",
-		IsGithubLoginEnabled:         envstore.EnvInMemoryStoreObj.GetStringStoreEnvVariable(constants.EnvKeyGithubClientID) != "" && envstore.EnvInMemoryStoreObj.GetStringStoreEnvVariable(constants.EnvKeyGithubClientSecret) != "",
-		IsFacebookLoginEnabled:       envstore.EnvInMemoryStoreObj.GetStringStoreEnvVariable(constants.EnvKeyFacebookClientID) != "" && envstore.EnvInMemoryStoreObj.GetStringStoreEnvVariable(constants.EnvKeyFacebookClientSecret) != "",
+		ClientID:                     envstore.EnvInMemoryStoreObj.GetStringStoreEnvVariable(constants.EnvKeyClientID),
+		IsGoogleLoginEnabled:         StringSliceContains(oauthProviders, constants.SignupMethodGoogle),
+		IsGithubLoginEnabled:         StringSliceContains(oauthProviders, constants.SignupMethodGithub),
+		IsFacebookLoginEnabled:       StringSliceContains(oauthProviders, constants.SignupMethodFacebook),
 		IsBasicAuthenticationEnabled: !envstore.EnvInMemoryStoreObj.GetBoolStoreEnvVariable(constants.EnvKeyDisableBasicAuthentication),
 		IsEmailVerificationEnabled:   !envstore.EnvInMemoryStoreObj.GetBoolStoreEnvVariable(constants.EnvKeyDisableEmailVerification),
 		IsMagicLinkLoginEnabled:      !envstore.EnvInMemoryStoreObj.GetBoolStoreEnvVariable(constants.EnvKeyDisableMagicLinkLogin),
+		IsWebauthnEnabled:            !envstore.EnvInMemoryStoreObj.GetBoolStoreEnvVariable(constants.EnvKeyDisableWebauthn),
+		IsPhoneLoginEnabled:          !envstore.EnvInMemoryStoreObj.GetBoolStoreEnvVariable(constants.EnvKeyDisablePhoneLogin) && sms.IsSenderConfigured(),
+		OauthProviders:               oauthProviders,
+		OidcProviders:                oidcProviders,
+	}
+}
+
+// getMetaOIDCProviders returns the enabled OpenID Connect providers from cache, or database if cache is expired
+func getMetaOIDCProviders() []*model.MetaOIDCProvider {
+	metaOIDCProvidersMutex.Lock()
+	defer metaOIDCProvidersMutex.Unlock()
+
+	if metaOIDCProviders != nil && time.Now().Before(metaOIDCProvidersExpiresAt) {
+		return metaOIDCProviders
+	}
+
+	oidcProviders := []*model.MetaOIDCProvider{}
+	enabledOIDCProviders, err := db.Provider.ListEnabledOIDCProviders()
+	if err != nil {
+		// not cached, so that it is retried on next meta query
+		log.Println("error getting enabled oidc providers:", err)
+		return oidcProviders
+	}
+	for i := range enabledOIDCProviders {
+		oidcProviders = append(oidcProviders, &model.MetaOIDCProvider{
+			Name:        enabledOIDCProviders[i].Name,
+			DisplayName: enabledOIDCProviders[i].GetDisplayName(),
+		})
 	}
+
+	metaOIDCProviders = oidcProviders
+	metaOIDCProvidersExpiresAt = time.Now().Add(metaOIDCProvidersCacheTTL)
+
+	return oidcProviders
+}
+
+// ResetMetaOIDCProviders clears the cached OpenID Connect providers of meta, it is called on provider change
+func ResetMetaOIDCProviders() {
+	metaOIDCProvidersMutex.Lock()
+	defer metaOIDCProvidersMutex.Unlock()
+
+	metaOIDCProviders = nil
+}
+
+// GetConfiguredOAuthProviders returns the built in social login providers configured with env
+func GetConfiguredOAuthProviders() []string {
+	isConfigured := func(keys ...string) bool {
+		for _, key := range keys {
+			if envstore.EnvInMemoryStoreObj.GetStringStoreEnvVariable(key) == "" {
+				return false
+			}
+		}
+		return true
+	}
+
+	providers := []string{}
+	if isConfigured(constants.EnvKeyGoogleClientID, constants.EnvKeyGoogleClientSecret) {
+		providers = append(providers, constants.SignupMethodGoogle)
+	}
+	if isConfigured(constants.EnvKeyGithubClientID, constants.EnvKeyGithubClientSecret) {
+		providers = append(providers, constants.SignupMethodGithub)
+	}
+	if isConfigured(constants.EnvKeyFacebookClientID, constants.EnvKeyFacebookClientSecret) {
+		providers = append(providers, constants.SignupMethodFacebook)
+	}
+	// apple client secret is the JWT signed with private key
+	if isConfigured(constants.EnvKeyAppleClientID, constants.EnvKeyAppleTeamID, constants.EnvKeyAppleKeyID, constants.EnvKeyApplePrivateKey) {
+		providers = append(providers, constants.SignupMethodApple)
+	}
+	// tenant is required, as emails of accounts from other organizations are not verified
+	if isConfigured(constants.EnvKeyMicrosoftClientID, constants.EnvKeyMicrosoftClientSecret, constants.EnvKeyMicrosoftTenantID) {
+		providers = append(providers, constants.SignupMethodMicrosoft)
+	}
+	if isConfigured(constants.EnvKeyGitlabClientID, constants.EnvKeyGitlabClientSecret) {
+		providers = append(providers, constants.SignupMethodGitlab)
+	}
+	if isConfigured(constants.EnvKeyLinkedinClientID, constants.EnvKeyLinkedinClientSecret) {
+		providers = append(providers, constants.SignupMethodLinkedin)
+	}
+	if isConfigured(constants.EnvKeyDiscordClientID, constants.EnvKeyDiscordClientSecret) {
+		providers = append(providers, constants.SignupMethodDiscord)
+	}
+	if isConfigured(constants.EnvKeyTwitterClientID, constants.EnvKeyTwitterClientSecret) {
+		providers = append(providers, constants.SignupMethodTwitter)
+	}
+
+	return providers
 }
@@ -0,0 +1,38 @@
+package utils
+
+import (
+	"github.com/authorizerdev/authorizer/server/constants"
+	"github.com/authorizerdev/authorizer/server/db"
+	"github.com/authorizerdev/authorizer/server/db/models"
+	"github.com/authorizerdev/authorizer/server/envstore"
+)
+
+// GetMfaMethods returns the second factors enabled by user.
+// Empty list means that second factor is not required for login
+func GetMfaMethods(user models.User) []string {
+	methods := []string{}
+	if user.IsTotpEnabled() {
+		methods = append(methods, constants.MfaMethodTotp)
+	}
+
+	if !envstore.EnvInMemoryStoreObj.GetBoolStoreEnvVariable(constants.EnvKeyDisableWebauthn) {
+		credentials, err := db.Provider.ListWebauthnCredentialsByUserID(user.ID)
+		if err == nil && len(GetMfaWebauthnCredentials(credentials)) > 0 {
+			methods = append(methods, constants.MfaMethodWebauthn)
+		}
+	}
+
+	return methods
+}
+
+// GetMfaWebauthnCredentials returns the passkeys which user has enabled as second factor
+func GetMfaWebauthnCredentials(credentials []models.WebauthnCredential) []models.WebauthnCredential {
+	mfaCredentials := []models.WebauthnCredential{}
+	for _, credential := range credentials {
+		if credential.MfaEnabled {
+			mfaCredentials = append(mfaCredentials, credential)
+		}
+	}
+
+	return mfaCredentials
+}
@@ -2,6 +2,7 @@ package utils
 
 import (
 	"net/mail"
+	"net/url"
 	"regexp"
 	"strings"
 
@@ -9,6 +10,12 @@ import (
 	"github.com/authorizerdev/authorizer/server/envstore"
 )
 
+// phoneNumberRegex matches phone number in E.164 format
+var phoneNumberRegex = regexp.MustCompile(`^\+[1-9][0-9]{6,14}$`)
+
+// oidcProviderNameRegex matches name of OpenID Connect provider, it is used in the oauth_login url
+var oidcProviderNameRegex = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]{0,49}$`)
+
 // IsValidEmail validates email
 func IsValidEmail(email string) bool {
 	_, err := mail.ParseAddress(email)
@@ -64,6 +71,11 @@ func IsValidRoles(userRoles []string, roles []string) bool {
 	return valid
 }
 
+// IsValidPhoneNumber validates phone number in E.164 format, e.g. +14155552671
+func IsValidPhoneNumber(phoneNumber string) bool {
+	return phoneNumberRegex.MatchString(phoneNumber)
+}
+
 // IsValidVerificationIdentifier validates verification identifier that is used to identify
 // the type of verification request
 func IsValidVerificationIdentifier(identifier string) bool {
@@ -73,6 +85,32 @@ func IsValidVerificationIdentifier(identifier string) bool {
 	return true
 }
 
+// IsValidClientRedirectURI validates redirect uri of oauth client.
+// It should be an absolute uri without fragment as per RFC 6749.
+// Custom schemes are allowed for native apps
+func IsValidClientRedirectURI(uri string) bool {
+	u, err := url.Parse(uri)
+	if err != nil || u.Scheme == "" || u.Fragment != "" {
+		return false
+	}
+
+	// redirect uris are matched exactly, hence wildcards are not allowed
+	if strings.Contains(uri, "*") {
+		return false
+	}
+
+	if (u.Scheme == "http" || u.Scheme == "https") && u.Host == "" {
+		return false
+	}
+
+	return true
+}
+
+// IsValidClientGrantType validates grant type that can be allowed for oauth client
+func IsValidClientGrantType(grantType string) bool {
+	return StringSliceContains(constants.ClientGrantTypes, grantType)
+}
+
 // IsStringArrayEqual validates if string array are equal.
 // This does check if the order is same
 func IsStringArrayEqual(a, b []string) bool {
@@ -86,3 +124,19 @@ func IsStringArrayEqual(a, b []string) bool {
 	}
 	return true
 }
+
+// IsValidWebhookEndpoint validates endpoint of webhook, it should be an absolute http or https url
+func IsValidWebhookEndpoint(endpoint string) bool {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return false
+	}
+
+	return (u.Scheme == "http" || u.Scheme == "https") && u.Host != ""
+}
+
+// IsValidOIDCProviderName validates name of OpenID Connect provider,
+// it should be url safe and should not be one of the built in signup methods
+func IsValidOIDCProviderName(name string) bool {
+	return oidcProviderNameRegex.MatchString(name) && !StringSliceContains(constants.SignupMethods, name)
+}
@@ -0,0 +1,148 @@
+package webauthn
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+// maxCBORDepth limits the nesting of decoded CBOR items
+const maxCBORDepth = 16
+
+var errCBORUnexpectedEnd = errors.New("unexpected end of cbor data")
+
+// decodeCBOR decodes the first CBOR item (RFC 8949) of data and returns the remaining bytes.
+// It supports the subset used by WebAuthn: integers, byte & text strings, arrays, maps and simple values.
+// Integers are decoded as int64, maps as map[interface{}]interface{}
+func decodeCBOR(data []byte) (interface{}, []byte, error) {
+	return decodeCBORItem(data, 0)
+}
+
+func decodeCBORItem(data []byte, depth int) (interface{}, []byte, error) {
+	if depth > maxCBORDepth {
+		return nil, nil, errors.New("cbor data is too deep")
+	}
+
+	if len(data) == 0 {
+		return nil, nil, errCBORUnexpectedEnd
+	}
+
+	majorType := data[0] >> 5
+	additionalInfo := data[0] & 0x1f
+	data = data[1:]
+
+	// simple values & floats
+	if majorType == 7 {
+		switch additionalInfo {
+		case 20:
+			return false, data, nil
+		case 21:
+			return true, data, nil
+		case 22, 23:
+			return nil, data, nil
+		default:
+			return nil, nil, fmt.Errorf("unsupported cbor simple value %d", additionalInfo)
+		}
+	}
+
+	argument, data, err := decodeCBORArgument(additionalInfo, data)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	switch majorType {
+	case 0:
+		if argument > 1<<63-1 {
+			return nil, nil, errors.New("cbor integer overflow")
+		}
+		return int64(argument), data, nil
+	case 1:
+		if argument > 1<<63-1 {
+			return nil, nil, errors.New("cbor integer overflow")
+		}
+		return -1 - int64(argument), data, nil
+	case 2, 3:
+		if uint64(len(data)) < argument {
+			return nil, nil, errCBORUnexpectedEnd
+		}
+		value := data[:argument]
+		if majorType == 3 {
+			return string(value), data[argument:], nil
+		}
+		return append([]byte{}, value...), data[argument:], nil
+	case 4:
+		if uint64(len(data)) < argument {
+			return nil, nil, errCBORUnexpectedEnd
+		}
+		items := []interface{}{}
+		for i := uint64(0); i < argument; i++ {
+			var item interface{}
+			item, data, err = decodeCBORItem(data, depth+1)
+			if err != nil {
+				return nil, nil, err
+			}
+			items = append(items, item)
+		}
+		return items, data, nil
+	case 5:
+		if uint64(len(data)) < argument {
+			return nil, nil, errCBORUnexpectedEnd
+		}
+		items := map[interface{}]interface{}{}
+		for i := uint64(0); i < argument; i++ {
+			var key, value interface{}
+			key, data, err = decodeCBORItem(data, depth+1)
+			if err != nil {
+				return nil, nil, err
+			}
+
+			switch key.(type) {
+			case int64, string:
+			default:
+				return nil, nil, errors.New("unsupported cbor map key")
+			}
+
+			value, data, err = decodeCBORItem(data, depth+1)
+			if err != nil {
+				return nil, nil, err
+			}
+			items[key] = value
+		}
+		return items, data, nil
+	case 6:
+		// tags are ignored, tagged item is returned as it is
+		return decodeCBORItem(data, depth+1)
+	}
+
+	return nil, nil, fmt.Errorf("unsupported cbor major type %d", majorType)
+}
+
+// decodeCBORArgument decodes the argument (length or value) of the item
+func decodeCBORArgument(additionalInfo byte, data []byte) (uint64, []byte, error) {
+	switch {
+	case additionalInfo < 24:
+		return uint64(additionalInfo), data, nil
+	case additionalInfo == 24:
+		if len(data) < 1 {
+			return 0, nil, errCBORUnexpectedEnd
+		}
+		return uint64(data[0]), data[1:], nil
+	case additionalInfo == 25:
+		if len(data) < 2 {
+			return 0, nil, errCBORUnexpectedEnd
+		}
+		return uint64(binary.BigEndian.Uint16(data)), data[2:], nil
+	case additionalInfo == 26:
+		if len(data) < 4 {
+			return 0, nil, errCBORUnexpectedEnd
+		}
+		return uint64(binary.BigEndian.Uint32(data)), data[4:], nil
+	case additionalInfo == 27:
+		if len(data) < 8 {
+			return 0, nil, errCBORUnexpectedEnd
+		}
+		return binary.BigEndian.Uint64(data), data[8:], nil
+	}
+
+	return 0, nil, errors.New("indefinite length cbor items are not supported")
+}
@@ -0,0 +1,316 @@
+package webauthn
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+const (
+	// ClientDataTypeCreate is the client data type of registration ceremony
+	ClientDataTypeCreate = "webauthn.create"
+	// ClientDataTypeGet is the client data type of authentication ceremony
+	ClientDataTypeGet = "webauthn.get"
+
+	// COSE algorithms supported for credential public keys
+	AlgES256 = -7
+	AlgEdDSA = -8
+	AlgRS256 = -257
+
+	flagUserPresent            = 0x01
+	flagUserVerified           = 0x04
+	flagAttestedCredentialData = 0x40
+)
+
+// SupportedAlgorithms is the list of COSE algorithms used in pubKeyCredParams
+var SupportedAlgorithms = []int{AlgES256, AlgEdDSA, AlgRS256}
+
+// Credential is the credential created by authenticator in registration ceremony
+type Credential struct {
+	ID        []byte
+	PublicKey []byte // COSE encoded public key
+	SignCount uint32
+	AAGUID    []byte
+}
+
+// ClientData is the client data collected by browser (clientDataJSON)
+type ClientData struct {
+	Type      string `json:"type"`
+	Challenge string `json:"challenge"`
+	Origin    string `json:"origin"`
+}
+
+// authenticatorData is the parsed authenticator data
+type authenticatorData struct {
+	RPIDHash     []byte
+	Flags        byte
+	SignCount    uint32
+	AAGUID       []byte
+	CredentialID []byte
+	PublicKey    []byte
+}
+
+// ParseClientData parses the clientDataJSON sent by browser
+func ParseClientData(clientDataJSON []byte) (*ClientData, error) {
+	var clientData ClientData
+	if err := json.Unmarshal(clientDataJSON, &clientData); err != nil {
+		return nil, fmt.Errorf("invalid client data: %s", err.Error())
+	}
+
+	return &clientData, nil
+}
+
+// VerifyRegistration verifies the response of navigator.credentials.create and returns the created credential.
+// Attestation statement is not verified, as "none" attestation is requested
+func VerifyRegistration(rpID, origin, challenge string, clientDataJSON, attestationObject []byte) (*Credential, error) {
+	if err := verifyClientData(clientDataJSON, ClientDataTypeCreate, origin, challenge); err != nil {
+		return nil, err
+	}
+
+	decoded, _, err := decodeCBOR(attestationObject)
+	if err != nil {
+		return nil, fmt.Errorf("invalid attestation object: %s", err.Error())
+	}
+
+	attestation, ok := decoded.(map[interface{}]interface{})
+	if !ok {
+		return nil, errors.New("invalid attestation object")
+	}
+
+	rawAuthData, ok := attestation["authData"].([]byte)
+	if !ok {
+		return nil, errors.New("invalid attestation object: authData is missing")
+	}
+
+	authData, err := parseAuthenticatorData(rawAuthData)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := verifyAuthenticatorData(authData, rpID, false); err != nil {
+		return nil, err
+	}
+
+	if authData.Flags&flagAttestedCredentialData == 0 {
+		return nil, errors.New("attested credential data is missing")
+	}
+
+	if _, err := parseCOSEKey(authData.PublicKey); err != nil {
+		return nil, err
+	}
+
+	return &Credential{
+		ID:        authData.CredentialID,
+		PublicKey: authData.PublicKey,
+		SignCount: authData.SignCount,
+		AAGUID:    authData.AAGUID,
+	}, nil
+}
+
+// VerifyAssertion verifies the response of navigator.credentials.get with the stored credential public key
+// and returns the new signature counter
+func VerifyAssertion(rpID, origin, challenge string, publicKey []byte, storedSignCount uint32, requireUserVerification bool, clientDataJSON, rawAuthData, signature []byte) (uint32, error) {
+	if err := verifyClientData(clientDataJSON, ClientDataTypeGet, origin, challenge); err != nil {
+		return 0, err
+	}
+
+	authData, err := parseAuthenticatorData(rawAuthData)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := verifyAuthenticatorData(authData, rpID, requireUserVerification); err != nil {
+		return 0, err
+	}
+
+	key, err := parseCOSEKey(publicKey)
+	if err != nil {
+		return 0, err
+	}
+
+	clientDataHash := sha256.Sum256(clientDataJSON)
+	signedData := append(append([]byte{}, rawAuthData...), clientDataHash[:]...)
+	if !verifySignature(key, signedData, signature) {
+		return 0, errors.New("invalid signature")
+	}
+
+	// counter should increase unless authenticator doesn't support it (always 0),
+	// otherwise the credential might have been cloned
+	if (authData.SignCount != 0 || storedSignCount != 0) && authData.SignCount <= storedSignCount {
+		return 0, errors.New("invalid signature counter, credential might be cloned")
+	}
+
+	return authData.SignCount, nil
+}
+
+// verifyClientData verifies the type, challenge & origin of client data.
+// Origin needs an exact match including scheme & port, so that other subdomains can not use the passkeys
+func verifyClientData(clientDataJSON []byte, clientDataType, origin, challenge string) error {
+	clientData, err := ParseClientData(clientDataJSON)
+	if err != nil {
+		return err
+	}
+
+	if clientData.Type != clientDataType {
+		return fmt.Errorf("invalid client data type %s", clientData.Type)
+	}
+
+	if challenge == "" || subtle.ConstantTimeCompare([]byte(clientData.Challenge), []byte(challenge)) != 1 {
+		return errors.New("invalid challenge")
+	}
+
+	if origin == "" || clientData.Origin != origin {
+		return fmt.Errorf("invalid origin %s", clientData.Origin)
+	}
+
+	return nil
+}
+
+// verifyAuthenticatorData verifies the rp id hash & user presence flags
+func verifyAuthenticatorData(authData *authenticatorData, rpID string, requireUserVerification bool) error {
+	rpIDHash := sha256.Sum256([]byte(rpID))
+	if !bytes.Equal(authData.RPIDHash, rpIDHash[:]) {
+		return errors.New("invalid rp id hash")
+	}
+
+	if authData.Flags&flagUserPresent == 0 {
+		return errors.New("user is not present")
+	}
+
+	if requireUserVerification && authData.Flags&flagUserVerified == 0 {
+		return errors.New("user is not verified")
+	}
+
+	return nil
+}
+
+// parseAuthenticatorData parses the authenticator data
+func parseAuthenticatorData(data []byte) (*authenticatorData, error) {
+	if len(data) < 37 {
+		return nil, errors.New("invalid authenticator data")
+	}
+
+	authData := &authenticatorData{
+		RPIDHash:  data[:32],
+		Flags:     data[32],
+		SignCount: binary.BigEndian.Uint32(data[33:37]),
+	}
+
+	if authData.Flags&flagAttestedCredentialData != 0 {
+		rest := data[37:]
+		if len(rest) < 18 {
+			return nil, errors.New("invalid attested credential data")
+		}
+
+		authData.AAGUID = rest[:16]
+		credentialIDLength := int(binary.BigEndian.Uint16(rest[16:18]))
+		rest = rest[18:]
+		if len(rest) < credentialIDLength {
+			return nil, errors.New("invalid attested credential data")
+		}
+
+		authData.CredentialID = rest[:credentialIDLength]
+		rest = rest[credentialIDLength:]
+
+		_, remaining, err := decodeCBOR(rest)
+		if err != nil {
+			return nil, fmt.Errorf("invalid credential public key: %s", err.Error())
+		}
+		authData.PublicKey = rest[:len(rest)-len(remaining)]
+	}
+
+	return authData, nil
+}
+
+// parseCOSEKey parses the COSE encoded public key (RFC 8152)
+func parseCOSEKey(data []byte) (interface{}, error) {
+	decoded, _, err := decodeCBOR(data)
+	if err != nil {
+		return nil, fmt.Errorf("invalid credential public key: %s", err.Error())
+	}
+
+	coseKey, ok := decoded.(map[interface{}]interface{})
+	if !ok {
+		return nil, errors.New("invalid credential public key")
+	}
+
+	keyType, _ := coseKey[int64(1)].(int64)
+	alg, _ := coseKey[int64(3)].(int64)
+	switch {
+	case keyType == 2 && alg == AlgES256:
+		curve, _ := coseKey[int64(-1)].(int64)
+		x, _ := coseKey[int64(-2)].([]byte)
+		y, _ := coseKey[int64(-3)].([]byte)
+		if curve != 1 || len(x) != 32 || len(y) != 32 {
+			return nil, errors.New("invalid ec2 credential public key")
+		}
+
+		key := &ecdsa.PublicKey{
+			Curve: elliptic.P256(),
+			X:     new(big.Int).SetBytes(x),
+			Y:     new(big.Int).SetBytes(y),
+		}
+		if !key.Curve.IsOnCurve(key.X, key.Y) {
+			return nil, errors.New("invalid ec2 credential public key")
+		}
+		return key, nil
+	case keyType == 3 && alg == AlgRS256:
+		n, _ := coseKey[int64(-1)].([]byte)
+		e, _ := coseKey[int64(-2)].([]byte)
+		if len(n) == 0 || len(e) == 0 || len(e) > 4 {
+			return nil, errors.New("invalid rsa credential public key")
+		}
+
+		return &rsa.PublicKey{
+			N: new(big.Int).SetBytes(n),
+			E: int(new(big.Int).SetBytes(e).Int64()),
+		}, nil
+	case keyType == 1 && alg == AlgEdDSA:
+		curve, _ := coseKey[int64(-1)].(int64)
+		x, _ := coseKey[int64(-2)].([]byte)
+		if curve != 6 || len(x) != ed25519.PublicKeySize {
+			return nil, errors.New("invalid okp credential public key")
+		}
+		return ed25519.PublicKey(x), nil
+	}
+
+	return nil, fmt.Errorf("unsupported credential public key type %d with algorithm %d", keyType, alg)
+}
+
+// verifySignature verifies the signature of data with the public key
+func verifySignature(key interface{}, data, signature []byte) bool {
+	switch k := key.(type) {
+	case *ecdsa.PublicKey:
+		hash := sha256.Sum256(data)
+		return ecdsa.VerifyASN1(k, hash[:], signature)
+	case *rsa.PublicKey:
+		hash := sha256.Sum256(data)
+		return rsa.VerifyPKCS1v15(k, crypto.SHA256, hash[:], signature) == nil
+	case ed25519.PublicKey:
+		return ed25519.Verify(k, data, signature)
+	}
+
+	return false
+}
+
+// EncodeToString encodes the binary data as base64url without padding, as used by WebAuthn json
+func EncodeToString(data []byte) string {
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// DecodeString decodes base64url data with or without padding
+func DecodeString(data string) ([]byte, error) {
+	return base64.RawURLEncoding.DecodeString(strings.TrimRight(data, "="))
+}
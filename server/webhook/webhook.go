@@ -0,0 +1,229 @@
+package webhook
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/authorizerdev/authorizer/server/constants"
+	"github.com/authorizerdev/authorizer/server/db"
+	"github.com/authorizerdev/authorizer/server/db/models"
+	"github.com/authorizerdev/authorizer/server/graph/model"
+	"github.com/authorizerdev/authorizer/server/utils"
+	"github.com/google/uuid"
+)
+
+const (
+	// MaxDeliveryAttempts is the number of attempts after which delivery is marked as failed
+	MaxDeliveryAttempts = 8
+	// retryBaseDelay is the delay before the first retry, it is doubled for every next retry
+	retryBaseDelay = 30 * time.Second
+	// retryInterval is the interval at which pending deliveries are checked for retry
+	retryInterval = 30 * time.Second
+	// retryBatchSize is the max number of pending deliveries retried at once
+	retryBatchSize = 100
+	// deliveryLease is the time for which delivery in flight is not picked for retry
+	deliveryLease = time.Minute
+	// responseBodyLimit is the max number of bytes of endpoint response saved with delivery
+	responseBodyLimit = 1024
+)
+
+// httpClient is the client of deliveries, endpoints should respond before timeout
+var httpClient = &http.Client{
+	Timeout: 10 * time.Second,
+}
+
+// Payload is the json body of webhook delivery
+type Payload struct {
+	// ID is the id of event, it is same for deliveries of the event to different webhooks
+	ID        string      `json:"id"`
+	EventName string      `json:"event_name"`
+	CreatedAt int64       `json:"created_at"`
+	User      *model.User `json:"user"`
+}
+
+// IsValidEvent checks if webhooks can be registered for the event
+func IsValidEvent(eventName string) bool {
+	for _, event := range constants.WebhookEvents {
+		if event == eventName {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Sign returns the HMAC-SHA256 signature of payload sent at timestamp.
+// It is sent as "sha256=<signature>" in X-Authorizer-Signature header,
+// endpoints should compute it with webhook secret to verify the delivery
+func Sign(secret, timestamp, payload string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp + "." + payload))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// Trigger sends the event of user to the enabled webhooks of event in background.
+// Deliveries are saved before sending, so that failed ones are retried with exponential backoff
+func Trigger(eventName string, user models.User) {
+	go func() {
+		webhooks, err := db.Provider.GetWebhooksByEventName(eventName)
+		if err != nil {
+			log.Println("error getting webhooks:", err)
+			return
+		}
+
+		if len(webhooks) == 0 {
+			return
+		}
+
+		payload, err := json.Marshal(Payload{
+			ID:        uuid.New().String(),
+			EventName: eventName,
+			CreatedAt: time.Now().Unix(),
+			User:      user.AsAPIUser(),
+		})
+		if err != nil {
+			log.Println("error creating webhook payload:", err)
+			return
+		}
+
+		for _, webhook := range webhooks {
+			if !webhook.Enabled {
+				continue
+			}
+
+			delivery, err := db.Provider.AddWebhookDelivery(models.WebhookDelivery{
+				WebhookID:     webhook.ID,
+				EventName:     eventName,
+				Endpoint:      webhook.Endpoint,
+				Payload:       string(payload),
+				Status:        constants.WebhookDeliveryStatusPending,
+				NextAttemptAt: time.Now().Add(deliveryLease).Unix(),
+			})
+			if err != nil {
+				log.Println("error adding webhook delivery:", err)
+				continue
+			}
+
+			go attempt(webhook, delivery)
+		}
+	}()
+}
+
+// StartRetryWorker retries the pending deliveries that are due, in background
+func StartRetryWorker() {
+	go func() {
+		ticker := time.NewTicker(retryInterval)
+		defer ticker.Stop()
+		for range ticker.C {
+			RetryPendingDeliveries()
+		}
+	}()
+}
+
+// RetryPendingDeliveries attempts the pending deliveries that are due for retry, and waits for them.
+// Deliveries of deleted or disabled webhooks are marked as failed
+func RetryPendingDeliveries() {
+	deliveries, err := db.Provider.ListPendingWebhookDeliveries(time.Now().Unix(), retryBatchSize)
+	if err != nil {
+		log.Println("error getting pending webhook deliveries:", err)
+		return
+	}
+
+	var wg sync.WaitGroup
+	for _, delivery := range deliveries {
+		webhook, err := db.Provider.GetWebhookByID(delivery.WebhookID)
+		if err != nil || !webhook.Enabled {
+			delivery.Status = constants.WebhookDeliveryStatusFailed
+			delivery.Error = "webhook is deleted or disabled"
+			if _, err := db.Provider.UpdateWebhookDelivery(delivery); err != nil {
+				log.Println("error updating webhook delivery:", err)
+			}
+			continue
+		}
+
+		// lease the delivery, so that it is not picked again while in flight
+		delivery.NextAttemptAt = time.Now().Add(deliveryLease).Unix()
+		delivery, err = db.Provider.UpdateWebhookDelivery(delivery)
+		if err != nil {
+			log.Println("error updating webhook delivery:", err)
+			continue
+		}
+
+		wg.Add(1)
+		go func(webhook models.Webhook, delivery models.WebhookDelivery) {
+			defer wg.Done()
+			attempt(webhook, delivery)
+		}(webhook, delivery)
+	}
+	wg.Wait()
+}
+
+// attempt sends the delivery to webhook endpoint and saves the result.
+// Failed delivery is scheduled for retry with exponential backoff until attempts are exhausted
+func attempt(webhook models.Webhook, delivery models.WebhookDelivery) {
+	delivery.Attempts++
+	var responseStatus int
+	var responseBody string
+	secret, err := utils.DecryptAES([]byte(webhook.Secret))
+	if err == nil {
+		responseStatus, responseBody, err = send(string(secret), delivery)
+	}
+	delivery.ResponseStatus = int64(responseStatus)
+	delivery.ResponseBody = responseBody
+	if err == nil && (responseStatus < http.StatusOK || responseStatus >= http.StatusMultipleChoices) {
+		err = fmt.Errorf("endpoint responded with status %d", responseStatus)
+	}
+
+	if err == nil {
+		delivery.Status = constants.WebhookDeliveryStatusSuccess
+		delivery.Error = ""
+		delivery.NextAttemptAt = 0
+	} else if delivery.Attempts >= MaxDeliveryAttempts {
+		delivery.Status = constants.WebhookDeliveryStatusFailed
+		delivery.Error = err.Error()
+		delivery.NextAttemptAt = 0
+	} else {
+		delivery.Error = err.Error()
+		delivery.NextAttemptAt = time.Now().Add(retryBaseDelay * time.Duration(1<<uint(delivery.Attempts-1))).Unix()
+	}
+
+	if _, err := db.Provider.UpdateWebhookDelivery(delivery); err != nil {
+		log.Println("error updating webhook delivery:", err)
+	}
+}
+
+// send posts the signed payload to endpoint, and returns the response status & body
+func send(secret string, delivery models.WebhookDelivery) (int, string, error) {
+	req, err := http.NewRequest(http.MethodPost, delivery.Endpoint, bytes.NewBufferString(delivery.Payload))
+	if err != nil {
+		return 0, "", err
+	}
+
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "authorizer-webhook")
+	req.Header.Set("X-Authorizer-Event", delivery.EventName)
+	req.Header.Set("X-Authorizer-Delivery", delivery.ID)
+	req.Header.Set("X-Authorizer-Timestamp", timestamp)
+	req.Header.Set("X-Authorizer-Signature", "sha256="+Sign(secret, timestamp, delivery.Payload))
+
+	res, err := httpClient.Do(req)
+	if err != nil {
+		return 0, "", err
+	}
+	defer res.Body.Close()
+
+	body, _ := ioutil.ReadAll(io.LimitReader(res.Body, responseBodyLimit))
+	return res.StatusCode, string(body), nil
+}